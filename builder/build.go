@@ -9,6 +9,7 @@ import (
 
 	"github.com/spdx/tools-golang/spdx"
 	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdxlib"
 )
 
 // Config is a collection of configuration settings for builder.
@@ -35,6 +36,11 @@ type Config struct {
 	// directory, regardless of where it is in the file tree.
 	PathsIgnored []string
 
+	// IDGenerator, if set, renames the package and files of the built
+	// document, e.g. spdxlib.ContentHashIDs() for file IDs that do not
+	// change when other files are added to or removed from the directory.
+	IDGenerator spdxlib.IDGenerator
+
 	// TestValues is used to pass fixed values for testing purposes
 	// only, and should be set to nil for production use. It is only
 	// exported so that it will be accessible within builder.
@@ -81,5 +87,11 @@ func Build(packageName string, dirRoot string, config *Config) (*spdx.Document,
 		Relationships:     []*spdx.Relationship{rln},
 	}
 
+	if config.IDGenerator != nil {
+		if _, err := spdxlib.RegenerateIDs(doc, config.IDGenerator); err != nil {
+			return nil, err
+		}
+	}
+
 	return doc, nil
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/spdx/tools-golang/spdx"
 	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdxlib"
 )
 
 func TestBuildCreatesDocument(t *testing.T) {
@@ -413,3 +414,35 @@ func TestBuildCanIgnoreFiles(t *testing.T) {
 		t.Errorf("expected %v, got %v", want, got)
 	}
 }
+
+func TestBuildCanGenerateIDs(t *testing.T) {
+	dirRoot := "../testdata/project1/"
+
+	config := &Config{
+		NamespacePrefix: "https://github.com/swinslow/spdx-docs/spdx-go/testdata-",
+		CreatorType:     "Person",
+		Creator:         "John Doe",
+		IDGenerator:     spdxlib.SequentialIDs(),
+		TestValues:      make(map[string]string),
+	}
+	config.TestValues["Created"] = "2018-10-19T04:38:00Z"
+
+	doc, err := Build("project1", dirRoot, config)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	pkg := doc.Packages[0]
+	if pkg.PackageSPDXIdentifier != common.ElementID("Package-1") {
+		t.Errorf("expected %v, got %v", "Package-1", pkg.PackageSPDXIdentifier)
+	}
+	for i, f := range pkg.Files {
+		want := common.ElementID(fmt.Sprintf("File-%d", i+1))
+		if f.FileSPDXIdentifier != want {
+			t.Errorf("expected %v, got %v", want, f.FileSPDXIdentifier)
+		}
+	}
+	rln := doc.Relationships[0]
+	if rln.RefB != common.MakeDocElementID("", "Package-1") {
+		t.Errorf("expected %v, got %v", "Package-1", rln.RefB)
+	}
+}
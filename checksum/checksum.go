@@ -0,0 +1,190 @@
+// Package checksum computes the checksums of serialized SPDX documents, as
+// recorded in the ExternalDocumentRefs of the documents that refer to them,
+// and verifies recorded checksums against the referenced documents.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+package checksum
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spdx/tools-golang/json"
+	"github.com/spdx/tools-golang/jsonld"
+	"github.com/spdx/tools-golang/rdf"
+	"github.com/spdx/tools-golang/spdx"
+	spdxcommon "github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spreadsheet"
+	"github.com/spdx/tools-golang/tagvalue"
+	"github.com/spdx/tools-golang/xml"
+	"github.com/spdx/tools-golang/yaml"
+)
+
+const documentRefPrefix = "DocumentRef-"
+
+// ErrMismatch is returned when a document does not have the checksum
+// recorded in the ExternalDocumentRef that refers to it
+var ErrMismatch = errors.New("checksum mismatch")
+
+// Format writes a document in a serialization format. The checksum of a
+// document is computed over the bytes its Format writes, so the Format must
+// be the one the document is published in, with the same writer options.
+type Format func(doc spdxcommon.AnyDocument, w io.Writer) error
+
+// JSON returns the Format of json.Write with the given options
+func JSON(opts ...json.WriteOption) Format {
+	return func(doc spdxcommon.AnyDocument, w io.Writer) error {
+		return json.Write(doc, w, opts...)
+	}
+}
+
+// JSONLD returns the Format of jsonld.Write with the given options
+func JSONLD(opts ...jsonld.WriteOption) Format {
+	return func(doc spdxcommon.AnyDocument, w io.Writer) error {
+		return jsonld.Write(doc, w, opts...)
+	}
+}
+
+// RDF returns the Format of rdf.Write with the given options
+func RDF(opts ...rdf.WriteOption) Format {
+	return func(doc spdxcommon.AnyDocument, w io.Writer) error {
+		return rdf.Write(doc, w, opts...)
+	}
+}
+
+// Spreadsheet returns the Format of spreadsheet.Write with the given options
+func Spreadsheet(opts ...spreadsheet.WriteOption) Format {
+	return func(doc spdxcommon.AnyDocument, w io.Writer) error {
+		return spreadsheet.Write(doc, w, opts...)
+	}
+}
+
+// TagValue returns the Format of tagvalue.Write with the given options
+func TagValue(opts ...tagvalue.WriteOption) Format {
+	return func(doc spdxcommon.AnyDocument, w io.Writer) error {
+		return tagvalue.Write(doc, w, opts...)
+	}
+}
+
+// XML returns the Format of xml.Write with the given options
+func XML(opts ...xml.WriteOption) Format {
+	return func(doc spdxcommon.AnyDocument, w io.Writer) error {
+		return xml.Write(doc, w, opts...)
+	}
+}
+
+// YAML returns the Format of yaml.Write with the given options
+func YAML(opts ...yaml.WriteOption) Format {
+	return func(doc spdxcommon.AnyDocument, w io.Writer) error {
+		return yaml.Write(doc, w, opts...)
+	}
+}
+
+// New returns a hash for the checksum algorithm. SHA1, SHA224, SHA256,
+// SHA384, SHA512 and MD5 are supported.
+func New(algorithm common.ChecksumAlgorithm) (hash.Hash, error) {
+	switch algorithm {
+	case common.SHA1:
+		return sha1.New(), nil
+	case common.SHA224:
+		return sha256.New224(), nil
+	case common.SHA256:
+		return sha256.New(), nil
+	case common.SHA384:
+		return sha512.New384(), nil
+	case common.SHA512:
+		return sha512.New(), nil
+	case common.MD5:
+		return md5.New(), nil
+	}
+	return nil, fmt.Errorf("unsupported checksum algorithm %q", algorithm)
+}
+
+// Bytes computes the checksum of a serialized document
+func Bytes(content []byte, algorithm common.ChecksumAlgorithm) (common.Checksum, error) {
+	h, err := New(algorithm)
+	if err != nil {
+		return common.Checksum{}, err
+	}
+	h.Write(content)
+	return sum(h, algorithm), nil
+}
+
+// Document computes the checksum of the document as written in the given format
+func Document(doc spdxcommon.AnyDocument, format Format, algorithm common.ChecksumAlgorithm) (common.Checksum, error) {
+	return Write(doc, io.Discard, format, algorithm)
+}
+
+// Write writes the document to w in the given format and returns the checksum
+// of the bytes written
+func Write(doc spdxcommon.AnyDocument, w io.Writer, format Format, algorithm common.ChecksumAlgorithm) (common.Checksum, error) {
+	h, err := New(algorithm)
+	if err != nil {
+		return common.Checksum{}, err
+	}
+	if err := format(doc, io.MultiWriter(w, h)); err != nil {
+		return common.Checksum{}, err
+	}
+	return sum(h, algorithm), nil
+}
+
+// ExternalDocumentRef returns an ExternalDocumentRef with the given ID, with
+// or without the "DocumentRef-" prefix, to the document as written in the
+// given format. The ID is stored with the prefix, as the readers return it.
+// The reference records the SHA1 checksum, which the specification requires.
+func ExternalDocumentRef(id string, doc *spdx.Document, format Format) (spdx.ExternalDocumentRef, error) {
+	if doc.DocumentNamespace == "" {
+		return spdx.ExternalDocumentRef{}, fmt.Errorf("document has no namespace to refer to")
+	}
+	checksum, err := Document(doc, format, common.SHA1)
+	if err != nil {
+		return spdx.ExternalDocumentRef{}, err
+	}
+	if !strings.HasPrefix(id, documentRefPrefix) {
+		id = documentRefPrefix + id
+	}
+	return spdx.ExternalDocumentRef{
+		DocumentRefID: id,
+		URI:           doc.DocumentNamespace,
+		Checksum:      checksum,
+	}, nil
+}
+
+// Verify returns an error wrapping ErrMismatch if the serialized document
+// does not have the checksum recorded in the ExternalDocumentRef
+func Verify(ref spdx.ExternalDocumentRef, content []byte) error {
+	actual, err := Bytes(content, ref.Checksum.Algorithm)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(actual.Value, ref.Checksum.Value) {
+		return fmt.Errorf("%w: %s has %s %s, not %s", ErrMismatch, ref.URI, actual.Algorithm, actual.Value, ref.Checksum.Value)
+	}
+	return nil
+}
+
+// VerifyFile returns an error wrapping ErrMismatch if the file at the given
+// path does not have the checksum recorded in the ExternalDocumentRef
+func VerifyFile(ref spdx.ExternalDocumentRef, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := Verify(ref, content); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+func sum(h hash.Hash, algorithm common.ChecksumAlgorithm) common.Checksum {
+	return common.Checksum{Algorithm: algorithm, Value: hex.EncodeToString(h.Sum(nil))}
+}
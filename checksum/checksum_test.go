@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package checksum
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/json"
+	spdxcommon "github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+	"github.com/spdx/tools-golang/tagvalue"
+)
+
+func Test_Write(t *testing.T) {
+	doc := example.Copy()
+
+	for name, format := range map[string]Format{
+		"json":     JSON(),
+		"indented": JSON(json.Indent("  ")),
+		"canonical": func(doc spdxcommon.AnyDocument, w io.Writer) error {
+			return json.WriteCanonical(doc, w)
+		},
+		"jsonld":      JSONLD(),
+		"rdf":         RDF(),
+		"tagvalue":    TagValue(),
+		"xml":         XML(),
+		"spreadsheet": Spreadsheet(),
+		"yaml":        YAML(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			checksum, err := Write(&doc, buf, format, common.SHA1)
+			require.NoError(t, err)
+
+			sum := sha1.Sum(buf.Bytes())
+			require.Equal(t, common.Checksum{Algorithm: common.SHA1, Value: hex.EncodeToString(sum[:])}, checksum)
+
+			computed, err := Document(&doc, format, common.SHA1)
+			require.NoError(t, err)
+			require.Equal(t, checksum, computed)
+		})
+	}
+
+	_, err := Document(&doc, JSON(), "SHA3-256")
+	require.Error(t, err)
+}
+
+func Test_DocumentCanonical(t *testing.T) {
+	doc := example.Copy()
+	reordered := example.Copy()
+	reordered.Packages[0], reordered.Packages[1] = reordered.Packages[1], reordered.Packages[0]
+
+	format := TagValue(tagvalue.Canonical())
+	want, err := Document(&doc, format, common.SHA256)
+	require.NoError(t, err)
+	got, err := Document(&reordered, format, common.SHA256)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	got, err = Document(&reordered, TagValue(), common.SHA256)
+	require.NoError(t, err)
+	require.NotEqual(t, want, got)
+}
+
+func Test_ExternalDocumentRef(t *testing.T) {
+	doc := example.Copy()
+	path := filepath.Join(t.TempDir(), "example.spdx.json")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	_, err = Write(&doc, f, JSON(), common.SHA1)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	ref, err := ExternalDocumentRef("DocumentRef-example", &doc, JSON())
+	require.NoError(t, err)
+	require.Equal(t, "DocumentRef-example", ref.DocumentRefID)
+	require.Equal(t, doc.DocumentNamespace, ref.URI)
+	require.Equal(t, common.SHA1, ref.Checksum.Algorithm)
+	require.NoError(t, VerifyFile(ref, path))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, Verify(ref, content))
+	err = Verify(ref, append(content, '\n'))
+	require.True(t, errors.Is(err, ErrMismatch), err)
+
+	// the document is published in a different format than the checksum was computed for
+	ref, err = ExternalDocumentRef("example", &doc, YAML())
+	require.NoError(t, err)
+	require.Equal(t, "DocumentRef-example", ref.DocumentRefID)
+	require.True(t, errors.Is(VerifyFile(ref, path), ErrMismatch))
+
+	doc.DocumentNamespace = ""
+	_, err = ExternalDocumentRef("example", &doc, JSON())
+	require.Error(t, err)
+}
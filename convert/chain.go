@@ -12,6 +12,7 @@ import (
 	"github.com/spdx/tools-golang/spdx/v2/v2_1"
 	"github.com/spdx/tools-golang/spdx/v2/v2_2"
 	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+	"github.com/spdx/tools-golang/spdx/v3/v3_0"
 )
 
 var DocumentChain = converter.NewChain(
@@ -26,6 +27,9 @@ var DocumentChain = converter.NewChain(
 // sourceDoc := // e.g. a v2_2.Document from somewhere
 // var targetDoc spdx.Document // this can be any document version
 // err := convert.Document(sourceDoc, &targetDoc) // the target must be passed as a pointer
+//
+// SPDX 3.0 documents (v3_0.Document) are converted to and from SPDX 2.3,
+// then through the DocumentChain to any other version.
 func Document(from common.AnyDocument, to common.AnyDocument) error {
 	if !IsPtr(to) {
 		return fmt.Errorf("struct to convert to must be a pointer")
@@ -35,5 +39,27 @@ func Document(from common.AnyDocument, to common.AnyDocument) error {
 		reflect.ValueOf(to).Elem().Set(reflect.ValueOf(from))
 		return nil
 	}
+
+	if v3doc, ok := from.(v3_0.Document); ok {
+		v2doc, err := v3_0ToV2_3(v3doc)
+		if err != nil {
+			return err
+		}
+		return Document(v2doc, to)
+	}
+
+	if v3doc, ok := to.(*v3_0.Document); ok {
+		v2doc := v2_3.Document{}
+		if err := Document(from, &v2doc); err != nil {
+			return err
+		}
+		converted, err := v2_3ToV3_0(v2doc)
+		if err != nil {
+			return err
+		}
+		*v3doc = converted
+		return nil
+	}
+
 	return DocumentChain.Convert(from, to)
 }
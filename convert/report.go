@@ -0,0 +1,334 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package convert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/spdx/tools-golang/spdx/common"
+	v2common "github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+// Loss describes a single value that could not be represented in the target document
+type Loss struct {
+	// ElementID is the rendered ID of the element holding the value, e.g. "SPDXRef-Package",
+	// or the LicenseRef- identifier of an extracted license. Values of the document itself,
+	// including its relationships and annotations, use "SPDXRef-DOCUMENT".
+	ElementID string `json:"elementId"`
+
+	// Field is the path of the value within the element, e.g. "PackageChecksums[1]"
+	Field string `json:"field"`
+
+	// Value is the original value
+	Value interface{} `json:"value"`
+
+	// Coerced is the value in the target document, or nil if the value was dropped
+	Coerced interface{} `json:"coerced,omitempty"`
+}
+
+// Dropped returns true if the value is missing from the target document
+// rather than changed to a different value
+func (l Loss) Dropped() bool {
+	return l.Coerced == nil
+}
+
+func (l Loss) String() string {
+	if l.Dropped() {
+		return fmt.Sprintf("%s %s: dropped %v", l.ElementID, l.Field, l.Value)
+	}
+	return fmt.Sprintf("%s %s: coerced %v to %v", l.ElementID, l.Field, l.Value, l.Coerced)
+}
+
+// Report lists the values lost by a document conversion
+type Report struct {
+	// From and To are the SPDX versions of the source and target documents, e.g. "SPDX-2.3"
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	Losses []Loss `json:"losses"`
+}
+
+// Lossless returns true if every value of the source document is present in the target
+func (r *Report) Lossless() bool {
+	return len(r.Losses) == 0
+}
+
+func (r *Report) String() string {
+	sb := strings.Builder{}
+	_, _ = fmt.Fprintf(&sb, "%s to %s: %d value(s) lost\n", r.From, r.To, len(r.Losses))
+	for _, l := range r.Losses {
+		sb.WriteString(l.String())
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// checksumAlgorithms lists the checksum algorithms each SPDX 2 version allows,
+// other algorithms are removed when converting to that version
+var checksumAlgorithms = map[reflect.Type][]v2common.ChecksumAlgorithm{
+	reflect.TypeOf(v2_1.Document{}): {
+		v2common.SHA1, v2common.SHA256, v2common.MD5,
+	},
+	reflect.TypeOf(v2_2.Document{}): {
+		v2common.SHA1, v2common.SHA224, v2common.SHA256, v2common.SHA384, v2common.SHA512,
+		v2common.MD2, v2common.MD4, v2common.MD5, v2common.MD6,
+	},
+}
+
+// DocumentWithReport converts from one document to another like Document, and returns
+// a report of every value that was dropped or coerced because the target version cannot
+// represent it. Checksums using an algorithm the target version does not allow are
+// removed from the target document and reported.
+//
+// The report is made by converting the target back to SPDX 2.3 and comparing it to the
+// SPDX 2.3 form of the source, so for an SPDX 3.0 source only the values that have an
+// SPDX 2.3 equivalent are compared.
+func DocumentWithReport(from common.AnyDocument, to common.AnyDocument) (*Report, error) {
+	var source v2_3.Document
+	if err := Document(from, &source); err != nil {
+		return nil, err
+	}
+	if err := Document(source, to); err != nil {
+		return nil, err
+	}
+
+	if allowed, ok := checksumAlgorithms[reflect.TypeOf(FromPtr(to))]; ok {
+		removeChecksums(reflect.ValueOf(to), allowed)
+	}
+
+	var target v2_3.Document
+	if err := Document(to, &target); err != nil {
+		return nil, err
+	}
+
+	r := &Report{
+		From: version(from),
+		To:   version(to),
+	}
+	c := comparer{report: r}
+	c.compare(documentID, "", reflect.ValueOf(source), reflect.ValueOf(target))
+	return r, nil
+}
+
+const documentID = "SPDXRef-DOCUMENT"
+
+// version returns the SPDXVersion of a v2 document, or the spec version of any other document
+func version(doc common.AnyDocument) string {
+	v := reflect.ValueOf(FromPtr(doc))
+	if f := v.FieldByName("SPDXVersion"); f.IsValid() {
+		return f.String()
+	}
+	if f := v.FieldByName("CreationInfo"); f.IsValid() && !f.IsNil() {
+		if spec := f.Elem().FieldByName("SpecVersion"); spec.IsValid() {
+			return "SPDX-" + spec.String()
+		}
+	}
+	return reflect.TypeOf(FromPtr(doc)).PkgPath()
+}
+
+var checksumsType = reflect.TypeOf([]v2common.Checksum{})
+
+// removeChecksums removes the checksums with algorithms that are not allowed from every
+// checksum list reachable from v
+func removeChecksums(v reflect.Value, allowed []v2common.ChecksumAlgorithm) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			removeChecksums(v.Elem(), allowed)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				removeChecksums(v.Field(i), allowed)
+			}
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			removeChecksums(v.MapIndex(key), allowed)
+		}
+	case reflect.Slice:
+		if v.Type() != checksumsType {
+			for i := 0; i < v.Len(); i++ {
+				removeChecksums(v.Index(i), allowed)
+			}
+			return
+		}
+		var kept []v2common.Checksum
+		for _, c := range v.Interface().([]v2common.Checksum) {
+			for _, a := range allowed {
+				if c.Algorithm == a {
+					kept = append(kept, c)
+					break
+				}
+			}
+		}
+		if v.CanSet() {
+			v.Set(reflect.ValueOf(kept))
+		}
+	}
+}
+
+// elementIDFields are the fields that identify the elements of a document
+var elementIDFields = []string{
+	"PackageSPDXIdentifier",
+	"FileSPDXIdentifier",
+	"SnippetSPDXIdentifier",
+	"LicenseIdentifier",
+}
+
+// comparer walks two SPDX 2.3 documents and records the values of the first
+// that are missing or different in the second
+type comparer struct {
+	report *Report
+}
+
+func (c *comparer) add(elementID, field string, value, coerced reflect.Value) {
+	l := Loss{
+		ElementID: elementID,
+		Field:     field,
+		Value:     value.Interface(),
+	}
+	if coerced.IsValid() && !coerced.IsZero() {
+		l.Coerced = coerced.Interface()
+	}
+	c.report.Losses = append(c.report.Losses, l)
+}
+
+func (c *comparer) compare(elementID, field string, a, b reflect.Value) {
+	if !a.IsValid() || a.IsZero() {
+		return
+	}
+	if a.Kind() == reflect.Slice {
+		// report each dropped item, even when none are left
+		if !b.IsValid() {
+			b = reflect.Zero(a.Type())
+		}
+		c.compareSlice(elementID, field, a, b)
+		return
+	}
+	if !b.IsValid() || b.IsZero() {
+		c.add(elementID, field, a, reflect.Value{})
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr:
+		c.compare(elementID, field, a.Elem(), b.Elem())
+	case reflect.Struct:
+		if id, ok := identify(a); ok {
+			elementID, field = id, ""
+		}
+		for i := 0; i < a.NumField(); i++ {
+			f := a.Type().Field(i)
+			// the version always differs, and unexported fields are bookkeeping, not document values
+			if f.PkgPath != "" || (f.Name == "SPDXVersion" && elementID == documentID && field == "") {
+				continue
+			}
+			c.compare(elementID, join(field, f.Name), a.Field(i), b.Field(i))
+		}
+	case reflect.Map:
+		keys := a.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, key := range keys {
+			c.compare(elementID, fmt.Sprintf("%s[%v]", field, key.Interface()), a.MapIndex(key), b.MapIndex(key))
+		}
+	default:
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			c.add(elementID, field, a, b)
+		}
+	}
+}
+
+// compareSlice matches elements by ID, other structs by position and
+// simple values by equality
+func (c *comparer) compareSlice(elementID, field string, a, b reflect.Value) {
+	elem := a.Type().Elem()
+	for elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+
+	if elem.Kind() == reflect.Struct {
+		if hasIDField(elem) {
+			found := map[string]reflect.Value{}
+			for i := 0; i < b.Len(); i++ {
+				if id, ok := identify(b.Index(i)); ok {
+					found[id] = b.Index(i)
+				}
+			}
+			for i := 0; i < a.Len(); i++ {
+				id, _ := identify(a.Index(i))
+				match, ok := found[id]
+				if !ok {
+					c.add(id, field, a.Index(i), reflect.Value{})
+					continue
+				}
+				c.compare(elementID, fmt.Sprintf("%s[%s]", field, id), a.Index(i), match)
+			}
+			return
+		}
+		if a.Len() == b.Len() && elem != reflect.TypeOf(v2common.Checksum{}) {
+			for i := 0; i < a.Len(); i++ {
+				c.compare(elementID, fmt.Sprintf("%s[%d]", field, i), a.Index(i), b.Index(i))
+			}
+			return
+		}
+	}
+
+	for i := 0; i < a.Len(); i++ {
+		found := false
+		for j := 0; j < b.Len() && !found; j++ {
+			found = reflect.DeepEqual(a.Index(i).Interface(), b.Index(j).Interface())
+		}
+		if !found {
+			c.add(elementID, fmt.Sprintf("%s[%d]", field, i), a.Index(i), reflect.Value{})
+		}
+	}
+}
+
+func hasIDField(t reflect.Type) bool {
+	for _, name := range elementIDFields {
+		if _, ok := t.FieldByName(name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// identify returns the rendered ID of an element struct, or false if the value is not an element
+func identify(v reflect.Value) (string, bool) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return "", false
+	}
+	for _, name := range elementIDFields {
+		f := v.FieldByName(name)
+		if !f.IsValid() {
+			continue
+		}
+		if id, ok := f.Interface().(v2common.ElementID); ok {
+			return v2common.RenderElementID(id), true
+		}
+		return f.String(), true
+	}
+	return "", false
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
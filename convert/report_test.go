@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package convert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+func Test_DocumentWithReport(t *testing.T) {
+	src := v2_3.Document{
+		SPDXVersion:    v2_3.Version,
+		SPDXIdentifier: "DOCUMENT",
+		Packages: []*v2_3.Package{
+			{
+				PackageName:           "p1",
+				PackageSPDXIdentifier: "p1",
+				PrimaryPackagePurpose: "LIBRARY",
+				ReleaseDate:           "2021-10-15T02:38:00Z",
+				PackageChecksums: []common.Checksum{
+					{Algorithm: common.SHA1, Value: "aaa"},
+					{Algorithm: common.BLAKE3, Value: "bbb"},
+				},
+			},
+		},
+		Files: []*v2_3.File{
+			{
+				FileName:           "f1",
+				FileSPDXIdentifier: "f1",
+				Checksums: []common.Checksum{
+					{Algorithm: common.SHA512, Value: "ccc"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		to       interface{}
+		expected []Loss
+	}{
+		{
+			name: "to 2.2",
+			to:   &v2_2.Document{},
+			expected: []Loss{
+				{ElementID: "SPDXRef-p1", Field: "PackageChecksums[1]", Value: common.Checksum{Algorithm: common.BLAKE3, Value: "bbb"}},
+				{ElementID: "SPDXRef-p1", Field: "PrimaryPackagePurpose", Value: "LIBRARY"},
+				{ElementID: "SPDXRef-p1", Field: "ReleaseDate", Value: "2021-10-15T02:38:00Z"},
+			},
+		},
+		{
+			name: "to 2.1",
+			to:   &v2_1.Document{},
+			expected: []Loss{
+				{ElementID: "SPDXRef-p1", Field: "PackageChecksums[1]", Value: common.Checksum{Algorithm: common.BLAKE3, Value: "bbb"}},
+				{ElementID: "SPDXRef-p1", Field: "PrimaryPackagePurpose", Value: "LIBRARY"},
+				{ElementID: "SPDXRef-p1", Field: "ReleaseDate", Value: "2021-10-15T02:38:00Z"},
+				{ElementID: "SPDXRef-f1", Field: "Checksums[0]", Value: common.Checksum{Algorithm: common.SHA512, Value: "ccc"}},
+			},
+		},
+		{
+			name: "to 2.3",
+			to:   &v2_3.Document{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			report, err := DocumentWithReport(src, test.to)
+			require.NoError(t, err)
+			require.Equal(t, "SPDX-2.3", report.From)
+			require.ElementsMatch(t, test.expected, report.Losses)
+			require.Equal(t, len(test.expected) == 0, report.Lossless())
+			for _, l := range report.Losses {
+				require.True(t, l.Dropped())
+			}
+		})
+	}
+
+	// the source document is not modified
+	require.Len(t, src.Packages[0].PackageChecksums, 2)
+}
+
+func Test_DocumentWithReportTarget(t *testing.T) {
+	src := v2_3.Document{
+		SPDXVersion: v2_3.Version,
+		Packages: []*v2_3.Package{
+			{
+				PackageSPDXIdentifier: "p1",
+				PackageChecksums: []common.Checksum{
+					{Algorithm: common.SHA1, Value: "aaa"},
+					{Algorithm: common.SHA3_256, Value: "bbb"},
+				},
+			},
+		},
+	}
+
+	var doc v2_2.Document
+	report, err := DocumentWithReport(&src, &doc)
+	require.NoError(t, err)
+	require.Equal(t, "SPDX-2.2", report.To)
+	require.Equal(t, v2_2.Version, doc.SPDXVersion)
+	require.Equal(t, []common.Checksum{{Algorithm: common.SHA1, Value: "aaa"}}, doc.Packages[0].PackageChecksums)
+	require.Len(t, report.Losses, 1)
+	require.Equal(t, "SPDXRef-p1 PackageChecksums[1]: dropped {SHA3-256 bbb}", report.Losses[0].String())
+}
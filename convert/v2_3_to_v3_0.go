@@ -0,0 +1,644 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package convert
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+	"github.com/spdx/tools-golang/spdx/v3/v3_0"
+)
+
+const (
+	noAssertion = "NOASSERTION"
+	none        = "NONE"
+
+	spdxRefPrefix     = "SPDXRef-"
+	documentRefPrefix = "DocumentRef-"
+)
+
+// licenseRefPattern matches the LicenseRef- identifiers in a license expression
+var licenseRefPattern = regexp.MustCompile(`(DocumentRef-[A-Za-z0-9.\-]+:)?LicenseRef-[A-Za-z0-9.\-]+`)
+
+// v3Builder holds the state of a single SPDX 2.3 to SPDX 3.0 conversion
+type v3Builder struct {
+	src    *v2_3.Document
+	prefix string
+	ci     *v3_0.CreationInfo
+
+	agents        []v3_0.AnyElement
+	artifacts     []v3_0.AnyElement
+	licenses      []v3_0.AnyElement
+	relationships []v3_0.AnyElement
+	annotations   []v3_0.AnyElement
+
+	agentIDs       map[string]string
+	expressionIDs  map[string]string
+	customLicenses map[string]string
+	externalDocs   map[string]string
+	relationshipID map[string]bool
+	fileIDs        map[common.ElementID]bool
+}
+
+// v2_3ToV3_0 converts an SPDX 2.3 document into an SPDX 3.0 element graph. SPDX 2
+// element IDs become IRIs in the document namespace, e.g. <namespace>#SPDXRef-Package.
+func v2_3ToV3_0(src v2_3.Document) (v3_0.Document, error) {
+	b := &v3Builder{
+		src:            &src,
+		prefix:         src.DocumentNamespace + "#",
+		agentIDs:       map[string]string{},
+		expressionIDs:  map[string]string{},
+		customLicenses: map[string]string{},
+		externalDocs:   map[string]string{},
+		relationshipID: map[string]bool{},
+		fileIDs:        map[common.ElementID]bool{},
+	}
+	return b.build()
+}
+
+func (b *v3Builder) build() (v3_0.Document, error) {
+	src := b.src
+	doc := v3_0.Document{}
+	doc.SpdxID = b.id(src.SPDXIdentifier)
+	doc.Name = src.DocumentName
+	doc.Comment = src.DocumentComment
+	doc.ProfileConformance = []string{v3_0.ProfileCore, v3_0.ProfileSoftware, v3_0.ProfileSimpleLicensing}
+
+	b.ci = &v3_0.CreationInfo{SpecVersion: v3_0.Version}
+	if src.CreationInfo != nil {
+		b.ci.Created = src.CreationInfo.Created
+		b.ci.Comment = src.CreationInfo.CreatorComment
+		for _, c := range src.CreationInfo.Creators {
+			id := b.agent(c.CreatorType, c.Creator)
+			if c.CreatorType == "Tool" {
+				b.ci.CreatedUsing = append(b.ci.CreatedUsing, id)
+			} else {
+				b.ci.CreatedBy = append(b.ci.CreatedBy, id)
+			}
+		}
+	}
+	doc.CreationInfo = b.ci
+
+	for _, ref := range src.ExternalDocumentReferences {
+		refID := strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)
+		namespace := ref.URI + "#"
+		b.externalDocs[refID] = namespace
+		doc.NamespaceMap = append(doc.NamespaceMap, v3_0.NamespaceMap{
+			Prefix:    documentRefPrefix + refID,
+			Namespace: namespace,
+		})
+		doc.Imports = append(doc.Imports, v3_0.ExternalMap{
+			ExternalSpdxID: namespace + spdxRefPrefix + "DOCUMENT",
+			VerifiedUsing:  b.hashes([]common.Checksum{ref.Checksum}),
+			LocationHint:   ref.URI,
+		})
+	}
+
+	for _, l := range src.OtherLicenses {
+		b.otherLicense(l)
+	}
+
+	if src.DataLicense != "" {
+		doc.DataLicense = b.expression(src.DataLicense)
+	}
+
+	for _, r := range src.Relationships {
+		if r != nil && r.Relationship == common.TypeRelationshipContains {
+			b.relationshipID[b.relationshipKey(r.RefA, r.RefB)] = true
+		}
+	}
+
+	for _, p := range src.Packages {
+		if p != nil {
+			b.pkg(p)
+		}
+	}
+	for _, f := range src.Files {
+		if f != nil {
+			b.file(f)
+		}
+	}
+	for i := range src.Snippets {
+		b.snippet(&src.Snippets[i])
+	}
+
+	for _, r := range src.Relationships {
+		if r == nil {
+			continue
+		}
+		if err := b.relationship(r); err != nil {
+			return doc, err
+		}
+		if r.RefA.ElementRefID == src.SPDXIdentifier && r.RefA.DocumentRefID == "" {
+			switch r.Relationship {
+			case common.TypeRelationshipDescribe:
+				doc.RootElementIDs = append(doc.RootElementIDs, b.docElementID(r.RefB))
+			}
+		}
+		if r.Relationship == common.TypeRelationshipDescribeBy && r.RefB.ElementRefID == src.SPDXIdentifier && r.RefB.DocumentRefID == "" {
+			doc.RootElementIDs = append(doc.RootElementIDs, b.docElementID(r.RefA))
+		}
+	}
+
+	for _, a := range src.Annotations {
+		if a == nil {
+			continue
+		}
+		subject := doc.SpdxID
+		if a.AnnotationSPDXIdentifier.ElementRefID != "" {
+			subject = b.docElementID(a.AnnotationSPDXIdentifier)
+		}
+		b.annotation(subject, *a)
+	}
+	for _, r := range src.Reviews {
+		if r == nil {
+			continue
+		}
+		b.annotation(doc.SpdxID, v2_3.Annotation{
+			Annotator:         common.Annotator{Annotator: r.Reviewer, AnnotatorType: r.ReviewerType},
+			AnnotationDate:    r.ReviewDate,
+			AnnotationType:    "REVIEW",
+			AnnotationComment: r.ReviewComment,
+		})
+	}
+
+	doc.Elements = append(doc.Elements, b.agents...)
+	doc.Elements = append(doc.Elements, b.artifacts...)
+	doc.Elements = append(doc.Elements, b.licenses...)
+	doc.Elements = append(doc.Elements, b.relationships...)
+	doc.Elements = append(doc.Elements, b.annotations...)
+
+	return doc, nil
+}
+
+func (b *v3Builder) id(id common.ElementID) string {
+	return b.prefix + common.RenderElementID(id)
+}
+
+func (b *v3Builder) docElementID(id common.DocElementID) string {
+	switch id.SpecialID {
+	case none:
+		return v3_0.NoneElement
+	case noAssertion:
+		return v3_0.NoAssertionElement
+	}
+	if id.DocumentRefID != "" {
+		if namespace, ok := b.externalDocs[strings.TrimPrefix(id.DocumentRefID, documentRefPrefix)]; ok {
+			return namespace + common.RenderElementID(id.ElementRefID)
+		}
+		return b.prefix + common.RenderDocElementID(id)
+	}
+	return b.id(id.ElementRefID)
+}
+
+func (b *v3Builder) element(id string) v3_0.Element {
+	return v3_0.Element{SpdxID: id, CreationInfo: b.ci}
+}
+
+// agent returns the ID of the agent with the given SPDX 2 type and name, adding it if needed
+func (b *v3Builder) agent(agentType string, name string) string {
+	key := agentType + ": " + name
+	if id, ok := b.agentIDs[key]; ok {
+		return id
+	}
+
+	id := fmt.Sprintf("%s%s-%d", b.prefix, "Agent", len(b.agentIDs)+1)
+	e := b.element(id)
+	e.Name = name
+
+	var agent v3_0.AnyElement
+	switch agentType {
+	case "Person":
+		agent = &v3_0.Person{Agent: v3_0.Agent{Element: e}}
+	case "Organization":
+		agent = &v3_0.Organization{Agent: v3_0.Agent{Element: e}}
+	case "Tool":
+		agent = &v3_0.Tool{Element: e}
+	default:
+		agent = &v3_0.Agent{Element: e}
+	}
+
+	b.agentIDs[key] = id
+	b.agents = append(b.agents, agent)
+	return id
+}
+
+func (b *v3Builder) hashes(checksums []common.Checksum) []v3_0.IntegrityMethod {
+	var out []v3_0.IntegrityMethod
+	for _, c := range checksums {
+		algorithm, ok := hashAlgorithms[c.Algorithm]
+		if !ok {
+			algorithm = v3_0.HashAlgorithmOther
+		}
+		out = append(out, v3_0.IntegrityMethod{
+			Type:      v3_0.TypeHash,
+			Algorithm: algorithm,
+			HashValue: c.Value,
+		})
+	}
+	return out
+}
+
+func (b *v3Builder) otherLicense(l *v2_3.OtherLicense) {
+	if l == nil {
+		return
+	}
+	id := b.prefix + l.LicenseIdentifier
+	license := &v3_0.CustomLicense{}
+	license.Element = b.element(id)
+	license.Name = l.LicenseName
+	license.Comment = l.LicenseComment
+	license.LicenseText = l.ExtractedText
+	license.SeeAlso = l.LicenseCrossReferences
+	b.customLicenses[l.LicenseIdentifier] = id
+	b.licenses = append(b.licenses, license)
+}
+
+// expression returns the ID of the license element for an SPDX 2 license field value
+func (b *v3Builder) expression(expression string) string {
+	switch expression {
+	case noAssertion:
+		return v3_0.NoAssertionLicense
+	case none:
+		return v3_0.NoneLicense
+	}
+	if id, ok := b.expressionIDs[expression]; ok {
+		return id
+	}
+
+	id := fmt.Sprintf("%sLicenseExpression-%d", b.prefix, len(b.expressionIDs)+1)
+	e := &v3_0.LicenseExpression{Element: b.element(id), LicenseExpression: expression}
+	if b.src.CreationInfo != nil {
+		e.LicenseListVersion = b.src.CreationInfo.LicenseListVersion
+	}
+
+	seen := map[string]bool{}
+	for _, ref := range licenseRefPattern.FindAllString(expression, -1) {
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		if uri, ok := b.customLicenses[ref]; ok {
+			e.CustomIDToURI = append(e.CustomIDToURI, v3_0.DictionaryEntry{Key: ref, Value: uri})
+		}
+	}
+
+	b.expressionIDs[expression] = id
+	b.licenses = append(b.licenses, e)
+	return id
+}
+
+// licenseRelationship relates an artifact to the license elements of the given SPDX 2 license field values
+func (b *v3Builder) licenseRelationship(from string, relationshipType string, comment string, expressions ...string) {
+	var to []string
+	for _, expression := range expressions {
+		if expression != "" {
+			to = append(to, b.expression(expression))
+		}
+	}
+	if len(to) == 0 {
+		return
+	}
+	r := &v3_0.Relationship{
+		Element:          b.element(b.nextRelationshipID()),
+		From:             from,
+		To:               to,
+		RelationshipType: relationshipType,
+	}
+	r.Comment = comment
+	b.relationships = append(b.relationships, r)
+}
+
+func (b *v3Builder) nextRelationshipID() string {
+	return fmt.Sprintf("%sRelationship-%d", b.prefix, len(b.relationships)+1)
+}
+
+func (b *v3Builder) relationshipKey(refA common.DocElementID, refB common.DocElementID) string {
+	return common.RenderDocElementID(refA) + "->" + common.RenderDocElementID(refB)
+}
+
+func (b *v3Builder) pkg(p *v2_3.Package) {
+	id := b.id(p.PackageSPDXIdentifier)
+	pkg := &v3_0.Package{}
+	pkg.Element = b.element(id)
+	pkg.Name = p.PackageName
+	pkg.Summary = p.PackageSummary
+	pkg.Description = p.PackageDescription
+	pkg.Comment = p.PackageComment
+	pkg.PackageVersion = p.PackageVersion
+	pkg.DownloadLocation = assertion(p.PackageDownloadLocation)
+	pkg.HomePage = assertion(p.PackageHomePage)
+	pkg.SourceInfo = p.PackageSourceInfo
+	pkg.CopyrightText = assertion(p.PackageCopyrightText)
+	pkg.AttributionTexts = p.PackageAttributionTexts
+	pkg.PrimaryPurpose = packagePurposes[p.PrimaryPackagePurpose]
+	pkg.ReleaseTime = p.ReleaseDate
+	pkg.BuiltTime = p.BuiltDate
+	pkg.ValidUntilTime = p.ValidUntilDate
+
+	if p.PackageSupplier != nil && p.PackageSupplier.Supplier != noAssertion && p.PackageSupplier.Supplier != "" {
+		pkg.SuppliedBy = b.agent(p.PackageSupplier.SupplierType, p.PackageSupplier.Supplier)
+	}
+	if p.PackageOriginator != nil && p.PackageOriginator.Originator != noAssertion && p.PackageOriginator.Originator != "" {
+		pkg.OriginatedBy = []string{b.agent(p.PackageOriginator.OriginatorType, p.PackageOriginator.Originator)}
+	}
+
+	pkg.VerifiedUsing = b.hashes(p.PackageChecksums)
+	if p.PackageVerificationCode != nil && p.PackageVerificationCode.Value != "" {
+		pkg.VerifiedUsing = append(pkg.VerifiedUsing, v3_0.IntegrityMethod{
+			Type:                                 v3_0.TypePackageVerificationCode,
+			Algorithm:                            v3_0.HashAlgorithmSHA1,
+			HashValue:                            p.PackageVerificationCode.Value,
+			PackageVerificationCodeExcludedFiles: p.PackageVerificationCode.ExcludedFiles,
+		})
+	}
+
+	for _, ref := range p.PackageExternalReferences {
+		if ref == nil {
+			continue
+		}
+		if ref.RefType == common.TypePackageManagerPURL {
+			if pkg.PackageURL == "" {
+				pkg.PackageURL = ref.Locator
+				continue
+			}
+			pkg.ExternalIdentifiers = append(pkg.ExternalIdentifiers, v3_0.ExternalIdentifier{
+				ExternalIdentifierType: v3_0.ExternalIdentifierPackageURL,
+				Identifier:             ref.Locator,
+				Comment:                ref.ExternalRefComment,
+			})
+			continue
+		}
+		if t, ok := externalIdentifierTypes[ref.RefType]; ok {
+			pkg.ExternalIdentifiers = append(pkg.ExternalIdentifiers, v3_0.ExternalIdentifier{
+				ExternalIdentifierType: t,
+				Identifier:             ref.Locator,
+				Comment:                ref.ExternalRefComment,
+			})
+			continue
+		}
+		r := v3_0.ExternalRef{
+			ExternalRefType: externalRefTypes[ref.RefType],
+			Locators:        []string{ref.Locator},
+			Comment:         ref.ExternalRefComment,
+		}
+		if r.ExternalRefType == "" {
+			// keep the original type so the reference can be converted back
+			r.ExternalRefType = v3_0.ExternalRefOther
+			r.ContentType = ref.RefType
+		}
+		pkg.ExternalRefs = append(pkg.ExternalRefs, r)
+	}
+
+	b.artifacts = append(b.artifacts, pkg)
+
+	if p.PackageFileName != "" {
+		// SPDX 3 describes the distributed file as an artifact of its own
+		f := &v3_0.File{}
+		f.Element = b.element(id + "-PackageFileName")
+		f.Name = p.PackageFileName
+		f.FileKind = v3_0.FileKindFile
+		b.artifacts = append(b.artifacts, f)
+		b.relationships = append(b.relationships, &v3_0.Relationship{
+			Element:          b.element(b.nextRelationshipID()),
+			From:             id,
+			To:               []string{f.SpdxID},
+			RelationshipType: v3_0.RelationshipHasDistributionArtifact,
+		})
+	}
+
+	b.filesAnalyzed(id, p)
+
+	b.licenseRelationship(id, v3_0.RelationshipHasConcludedLicense, p.PackageLicenseComments, p.PackageLicenseConcluded)
+	b.licenseRelationship(id, v3_0.RelationshipHasDeclaredLicense, "", p.PackageLicenseDeclared)
+
+	for _, a := range p.Annotations {
+		b.annotation(id, a)
+	}
+
+	for _, f := range p.Files {
+		if f == nil {
+			continue
+		}
+		b.file(f)
+		pkgRef := common.MakeDocElementID("", string(p.PackageSPDXIdentifier))
+		fileRef := common.MakeDocElementID("", string(f.FileSPDXIdentifier))
+		if !b.relationshipID[b.relationshipKey(pkgRef, fileRef)] {
+			b.relationshipID[b.relationshipKey(pkgRef, fileRef)] = true
+			b.relationships = append(b.relationships, &v3_0.Relationship{
+				Element:          b.element(b.nextRelationshipID()),
+				From:             id,
+				To:               []string{b.id(f.FileSPDXIdentifier)},
+				RelationshipType: v3_0.RelationshipContains,
+			})
+		}
+	}
+}
+
+// filesAnalyzed records the FilesAnalyzed value of a package, which SPDX 3 has
+// no property for, where it cannot be told from the verification code and the
+// files of the package: a package whose files were not analyzed makes no
+// assertion about the files it contains, and one whose files were analyzed
+// but has none contains no files.
+func (b *v3Builder) filesAnalyzed(id string, p *v2_3.Package) {
+	to, completeness := v3_0.NoAssertionElement, v3_0.CompletenessNoAssertion
+	if p.FilesAnalyzed {
+		if p.PackageVerificationCode != nil && p.PackageVerificationCode.Value != "" || b.hasFiles(p) {
+			return
+		}
+		to, completeness = v3_0.NoneElement, v3_0.CompletenessComplete
+	}
+	b.relationships = append(b.relationships, &v3_0.Relationship{
+		Element:          b.element(b.nextRelationshipID()),
+		From:             id,
+		To:               []string{to},
+		RelationshipType: v3_0.RelationshipContains,
+		Completeness:     completeness,
+	})
+}
+
+// hasFiles returns true if the package lists files or CONTAINS a file
+func (b *v3Builder) hasFiles(p *v2_3.Package) bool {
+	if len(p.Files) > 0 {
+		return true
+	}
+	for _, r := range b.src.Relationships {
+		if r == nil || r.RefA.DocumentRefID != "" || r.RefB.DocumentRefID != "" {
+			continue
+		}
+		pkgRef, fileRef := r.RefA, r.RefB
+		switch r.Relationship {
+		case common.TypeRelationshipContains:
+		case common.TypeRelationshipContainedBy:
+			pkgRef, fileRef = r.RefB, r.RefA
+		default:
+			continue
+		}
+		if pkgRef.ElementRefID == p.PackageSPDXIdentifier && b.isFile(fileRef.ElementRefID) {
+			return true
+		}
+	}
+	return false
+}
+
+// isFile returns true if the ID is that of a file of the source document
+func (b *v3Builder) isFile(id common.ElementID) bool {
+	for _, f := range b.src.Files {
+		if f != nil && f.FileSPDXIdentifier == id {
+			return true
+		}
+	}
+	for _, p := range b.src.Packages {
+		if p == nil {
+			continue
+		}
+		for _, f := range p.Files {
+			if f != nil && f.FileSPDXIdentifier == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b *v3Builder) file(f *v2_3.File) {
+	if b.fileIDs[f.FileSPDXIdentifier] {
+		return
+	}
+	b.fileIDs[f.FileSPDXIdentifier] = true
+
+	id := b.id(f.FileSPDXIdentifier)
+	file := &v3_0.File{}
+	file.Element = b.element(id)
+	file.Name = f.FileName
+	file.Comment = f.FileComment
+	file.FileKind = v3_0.FileKindFile
+	file.VerifiedUsing = b.hashes(f.Checksums)
+	file.CopyrightText = assertion(f.FileCopyrightText)
+	file.AttributionTexts = f.FileAttributionTexts
+	for i, t := range f.FileTypes {
+		purpose, ok := fileTypes[t]
+		if !ok {
+			purpose = v3_0.PurposeOther
+		}
+		if i == 0 {
+			file.PrimaryPurpose = purpose
+		} else {
+			file.AdditionalPurposes = append(file.AdditionalPurposes, purpose)
+		}
+	}
+	for _, c := range f.FileContributors {
+		file.OriginatedBy = append(file.OriginatedBy, b.agent("", c))
+	}
+	b.artifacts = append(b.artifacts, file)
+
+	b.licenseRelationship(id, v3_0.RelationshipHasConcludedLicense, f.LicenseComments, f.LicenseConcluded)
+	b.licenseRelationship(id, v3_0.RelationshipHasDeclaredLicense, "", f.LicenseInfoInFiles...)
+
+	for _, a := range f.Annotations {
+		b.annotation(id, a)
+	}
+
+	// sort the snippets, so that the conversion gives the same result every time
+	snippetIDs := make([]string, 0, len(f.Snippets))
+	for snippetID := range f.Snippets {
+		snippetIDs = append(snippetIDs, string(snippetID))
+	}
+	sort.Strings(snippetIDs)
+	for _, snippetID := range snippetIDs {
+		if s := f.Snippets[common.ElementID(snippetID)]; s != nil {
+			b.snippet(s)
+		}
+	}
+}
+
+func (b *v3Builder) snippet(s *v2_3.Snippet) {
+	id := b.id(s.SnippetSPDXIdentifier)
+	snippet := &v3_0.Snippet{}
+	snippet.Element = b.element(id)
+	snippet.Name = s.SnippetName
+	snippet.Comment = s.SnippetComment
+	snippet.CopyrightText = assertion(s.SnippetCopyrightText)
+	snippet.AttributionTexts = s.SnippetAttributionTexts
+	snippet.SnippetFromFile = b.id(s.SnippetFromFileSPDXIdentifier)
+	for _, r := range s.Ranges {
+		if r.StartPointer.Offset != 0 || r.EndPointer.Offset != 0 {
+			snippet.ByteRange = &v3_0.PositiveIntegerRange{Begin: r.StartPointer.Offset, End: r.EndPointer.Offset}
+		}
+		if r.StartPointer.LineNumber != 0 || r.EndPointer.LineNumber != 0 {
+			snippet.LineRange = &v3_0.PositiveIntegerRange{Begin: r.StartPointer.LineNumber, End: r.EndPointer.LineNumber}
+		}
+	}
+	b.artifacts = append(b.artifacts, snippet)
+
+	b.licenseRelationship(id, v3_0.RelationshipHasConcludedLicense, s.SnippetLicenseComments, s.SnippetLicenseConcluded)
+	b.licenseRelationship(id, v3_0.RelationshipHasDeclaredLicense, "", s.LicenseInfoInSnippet...)
+}
+
+func (b *v3Builder) relationship(r *v2_3.Relationship) error {
+	m, ok := v2RelationshipMapping(r.Relationship)
+	if !ok {
+		return fmt.Errorf("unable to convert relationship type %s to SPDX 3", r.Relationship)
+	}
+
+	from, to := b.docElementID(r.RefA), b.docElementID(r.RefB)
+	if m.reverse {
+		from, to = to, from
+	}
+
+	rel := v3_0.Relationship{
+		Element:          b.element(b.nextRelationshipID()),
+		From:             from,
+		To:               []string{to},
+		RelationshipType: m.v3Type,
+	}
+	rel.Comment = r.RelationshipComment
+
+	if m.scope != "" {
+		b.relationships = append(b.relationships, &v3_0.LifecycleScopedRelationship{Relationship: rel, Scope: m.scope})
+	} else {
+		b.relationships = append(b.relationships, &rel)
+	}
+	return nil
+}
+
+func (b *v3Builder) annotation(subject string, a v2_3.Annotation) {
+	ci := &v3_0.CreationInfo{
+		SpecVersion: v3_0.Version,
+		Created:     a.AnnotationDate,
+	}
+	annotator := b.agent(a.Annotator.AnnotatorType, a.Annotator.Annotator)
+	if a.Annotator.AnnotatorType == "Tool" {
+		ci.CreatedBy = b.ci.CreatedBy
+		ci.CreatedUsing = []string{annotator}
+	} else {
+		ci.CreatedBy = []string{annotator}
+	}
+
+	annotationType := v3_0.AnnotationTypeOther
+	if a.AnnotationType == "REVIEW" {
+		annotationType = v3_0.AnnotationTypeReview
+	}
+
+	id := fmt.Sprintf("%sAnnotation-%d", b.prefix, len(b.annotations)+1)
+	annotation := &v3_0.Annotation{
+		Element:        v3_0.Element{SpdxID: id, CreationInfo: ci},
+		AnnotationType: annotationType,
+		Subject:        subject,
+		Statement:      a.AnnotationComment,
+	}
+	b.annotations = append(b.annotations, annotation)
+}
+
+// assertion returns the value, or an empty string if it is NOASSERTION,
+// which SPDX 3 expresses by omitting the property
+func assertion(value string) string {
+	if value == noAssertion {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package convert
+
+import (
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v3/v3_0"
+)
+
+// relationshipMapping describes how an SPDX 2 relationship type is expressed in SPDX 3
+type relationshipMapping struct {
+	v2Type string
+	v3Type string
+	// reverse is true when the SPDX 3 relationship points from RefB to RefA
+	reverse bool
+	// scope is set when the SPDX 3 relationship is a LifecycleScopedRelationship
+	scope string
+}
+
+// relationshipMappings is ordered so that, when several SPDX 2 types map to
+// the same SPDX 3 type and scope, the first one is used when converting back
+var relationshipMappings = []relationshipMapping{
+	{common.TypeRelationshipDescribe, v3_0.RelationshipDescribes, false, ""},
+	{common.TypeRelationshipDescribeBy, v3_0.RelationshipDescribes, true, ""},
+	{common.TypeRelationshipContains, v3_0.RelationshipContains, false, ""},
+	{common.TypeRelationshipContainedBy, v3_0.RelationshipContains, true, ""},
+	{common.TypeRelationshipDependsOn, v3_0.RelationshipDependsOn, false, ""},
+	{common.TypeRelationshipDependencyOf, v3_0.RelationshipDependsOn, true, ""},
+	{common.TypeRelationshipBuildDependencyOf, v3_0.RelationshipDependsOn, true, v3_0.LifecycleScopeBuild},
+	{common.TypeRelationshipDevDependencyOf, v3_0.RelationshipDependsOn, true, v3_0.LifecycleScopeDevelopment},
+	{common.TypeRelationshipTestDependencyOf, v3_0.RelationshipDependsOn, true, v3_0.LifecycleScopeTest},
+	{common.TypeRelationshipRuntimeDependencyOf, v3_0.RelationshipDependsOn, true, v3_0.LifecycleScopeRuntime},
+	{common.TypeRelationshipOptionalDependencyOf, v3_0.RelationshipHasOptionalDependency, true, ""},
+	{common.TypeRelationshipProvidedDependencyOf, v3_0.RelationshipHasProvidedDependency, true, ""},
+	{common.TypeRelationshipExampleOf, v3_0.RelationshipHasExample, true, ""},
+	{common.TypeRelationshipGenerates, v3_0.RelationshipGenerates, false, ""},
+	{common.TypeRelationshipGeneratedFrom, v3_0.RelationshipGenerates, true, ""},
+	{common.TypeRelationshipAncestorOf, v3_0.RelationshipAncestorOf, false, ""},
+	{common.TypeRelationshipDescendantOf, v3_0.RelationshipDescendantOf, false, ""},
+	{common.TypeRelationshipVariantOf, v3_0.RelationshipHasVariant, true, ""},
+	{common.TypeRelationshipDistributionArtifact, v3_0.RelationshipHasDistributionArtifact, false, ""},
+	{common.TypeRelationshipPatchFor, v3_0.RelationshipPatchedBy, true, ""},
+	{common.TypeRelationshipPatchApplied, v3_0.RelationshipPatchedBy, true, ""},
+	{common.TypeRelationshipCopyOf, v3_0.RelationshipCopiedTo, true, ""},
+	{common.TypeRelationshipFileAdded, v3_0.RelationshipHasAddedFile, true, ""},
+	{common.TypeRelationshipFileDeleted, v3_0.RelationshipHasDeletedFile, true, ""},
+	{common.TypeRelationshipFileModified, v3_0.RelationshipModifiedBy, true, ""},
+	{common.TypeRelationshipExpandedFromArchive, v3_0.RelationshipExpandsTo, true, ""},
+	{common.TypeRelationshipDynamicLink, v3_0.RelationshipHasDynamicLink, false, ""},
+	{common.TypeRelationshipStaticLink, v3_0.RelationshipHasStaticLink, false, ""},
+	{common.TypeRelationshipDataFileOf, v3_0.RelationshipHasDataFile, true, ""},
+	{common.TypeRelationshipTestCaseOf, v3_0.RelationshipHasTestCase, true, ""},
+	{common.TypeRelationshipBuildToolOf, v3_0.RelationshipUsesTool, true, v3_0.LifecycleScopeBuild},
+	{common.TypeRelationshipDevToolOf, v3_0.RelationshipUsesTool, true, v3_0.LifecycleScopeDevelopment},
+	{common.TypeRelationshipTestToolOf, v3_0.RelationshipUsesTool, true, v3_0.LifecycleScopeTest},
+	{common.TypeRelationshipTestOf, v3_0.RelationshipHasTest, true, ""},
+	{common.TypeRelationshipDocumentationOf, v3_0.RelationshipHasDocumentation, true, ""},
+	{common.TypeRelationshipOptionalComponentOf, v3_0.RelationshipHasOptionalComponent, true, ""},
+	{common.TypeRelationshipMetafileOf, v3_0.RelationshipHasMetadata, true, ""},
+	{common.TypeRelationshipPackageOf, v3_0.RelationshipPackagedBy, true, ""},
+	{common.TypeRelationshipAmends, v3_0.RelationshipAmendedBy, true, ""},
+	{common.TypeRelationshipHasPrerequisite, v3_0.RelationshipHasPrerequisite, false, ""},
+	{common.TypeRelationshipPrerequisiteFor, v3_0.RelationshipHasPrerequisite, true, ""},
+	{common.TypeRelationshipRequirementDescriptionFor, v3_0.RelationshipHasRequirement, true, ""},
+	{common.TypeRelationshipSpecificationFor, v3_0.RelationshipHasSpecification, true, ""},
+	{common.TypeRelationshipOther, v3_0.RelationshipOther, false, ""},
+}
+
+func v2RelationshipMapping(v2Type string) (relationshipMapping, bool) {
+	for _, m := range relationshipMappings {
+		if m.v2Type == v2Type {
+			return m, true
+		}
+	}
+	return relationshipMapping{}, false
+}
+
+// v3RelationshipMapping finds the SPDX 2 relationship type for an SPDX 3 type and scope.
+// When reverse is true, an SPDX 2 type pointing from the target to the source is preferred.
+func v3RelationshipMapping(v3Type string, scope string, reverse bool) (relationshipMapping, bool) {
+	var found []relationshipMapping
+	for _, m := range relationshipMappings {
+		if m.v3Type == v3Type && m.scope == scope {
+			found = append(found, m)
+		}
+	}
+	for _, m := range found {
+		if m.reverse == reverse {
+			return m, true
+		}
+	}
+	if len(found) > 0 {
+		return found[0], true
+	}
+	// fall back to the unscoped relationship when the scope has no SPDX 2 equivalent
+	if scope != "" {
+		return v3RelationshipMapping(v3Type, "", reverse)
+	}
+	return relationshipMapping{}, false
+}
+
+var hashAlgorithms = map[common.ChecksumAlgorithm]string{
+	common.SHA1:        v3_0.HashAlgorithmSHA1,
+	common.SHA224:      v3_0.HashAlgorithmSHA224,
+	common.SHA256:      v3_0.HashAlgorithmSHA256,
+	common.SHA384:      v3_0.HashAlgorithmSHA384,
+	common.SHA512:      v3_0.HashAlgorithmSHA512,
+	common.MD2:         v3_0.HashAlgorithmMD2,
+	common.MD4:         v3_0.HashAlgorithmMD4,
+	common.MD5:         v3_0.HashAlgorithmMD5,
+	common.MD6:         v3_0.HashAlgorithmMD6,
+	common.SHA3_256:    v3_0.HashAlgorithmSHA3_256,
+	common.SHA3_384:    v3_0.HashAlgorithmSHA3_384,
+	common.SHA3_512:    v3_0.HashAlgorithmSHA3_512,
+	common.BLAKE2b_256: v3_0.HashAlgorithmBlake2b256,
+	common.BLAKE2b_384: v3_0.HashAlgorithmBlake2b384,
+	common.BLAKE2b_512: v3_0.HashAlgorithmBlake2b512,
+	common.BLAKE3:      v3_0.HashAlgorithmBlake3,
+	common.ADLER32:     v3_0.HashAlgorithmAdler32,
+}
+
+var packagePurposes = map[string]string{
+	"APPLICATION":      v3_0.PurposeApplication,
+	"FRAMEWORK":        v3_0.PurposeFramework,
+	"LIBRARY":          v3_0.PurposeLibrary,
+	"CONTAINER":        v3_0.PurposeContainer,
+	"OPERATING-SYSTEM": v3_0.PurposeOperatingSystem,
+	"DEVICE":           v3_0.PurposeDevice,
+	"FIRMWARE":         v3_0.PurposeFirmware,
+	"SOURCE":           v3_0.PurposeSource,
+	"ARCHIVE":          v3_0.PurposeArchive,
+	"FILE":             v3_0.PurposeFile,
+	"INSTALL":          v3_0.PurposeInstall,
+	"OTHER":            v3_0.PurposeOther,
+}
+
+// fileTypes maps SPDX 2 file types to software purposes. TEXT, AUDIO, IMAGE
+// and VIDEO describe the content type rather than the purpose, so they all
+// become "data", which converts back to OTHER.
+var fileTypes = map[string]string{
+	"SOURCE":        v3_0.PurposeSource,
+	"BINARY":        v3_0.PurposeExecutable,
+	"ARCHIVE":       v3_0.PurposeArchive,
+	"APPLICATION":   v3_0.PurposeApplication,
+	"DOCUMENTATION": v3_0.PurposeDocumentation,
+	"SPDX":          v3_0.PurposeBom,
+	"OTHER":         v3_0.PurposeOther,
+	"TEXT":          v3_0.PurposeData,
+	"AUDIO":         v3_0.PurposeData,
+	"IMAGE":         v3_0.PurposeData,
+	"VIDEO":         v3_0.PurposeData,
+}
+
+// externalIdentifierTypes maps SPDX 2 external reference types that identify
+// the package to SPDX 3 external identifier types
+var externalIdentifierTypes = map[string]string{
+	common.TypeSecurityCPE22Type:  v3_0.ExternalIdentifierCPE22,
+	common.TypeSecurityCPE23Type:  v3_0.ExternalIdentifierCPE23,
+	common.TypeSecuritySwid:       v3_0.ExternalIdentifierSwid,
+	common.TypePersistentIdSwh:    v3_0.ExternalIdentifierSwhid,
+	common.TypePersistentIdGitoid: v3_0.ExternalIdentifierGitoid,
+}
+
+// externalRefTypes maps the remaining SPDX 2 external reference types to SPDX 3 external reference types
+var externalRefTypes = map[string]string{
+	common.TypeSecurityAdvisory:           v3_0.ExternalRefSecurityAdvisory,
+	common.TypeSecurityFix:                v3_0.ExternalRefSecurityFix,
+	common.TypeSecurityUrl:                v3_0.ExternalRefSecurityOther,
+	common.TypePackageManagerMavenCentral: v3_0.ExternalRefMavenCentral,
+	common.TypePackageManagerNpm:          v3_0.ExternalRefNpm,
+	common.TypePackageManagerNuGet:        v3_0.ExternalRefNuget,
+	common.TypePackageManagerBower:        v3_0.ExternalRefBower,
+}
+
+// externalRefCategories gives the SPDX 2 category of each reference type
+var externalRefCategories = map[string]string{
+	common.TypeSecurityCPE22Type:          common.CategorySecurity,
+	common.TypeSecurityCPE23Type:          common.CategorySecurity,
+	common.TypeSecuritySwid:               common.CategorySecurity,
+	common.TypeSecurityAdvisory:           common.CategorySecurity,
+	common.TypeSecurityFix:                common.CategorySecurity,
+	common.TypeSecurityUrl:                common.CategorySecurity,
+	common.TypePersistentIdSwh:            common.CategoryPersistentId,
+	common.TypePersistentIdGitoid:         common.CategoryPersistentId,
+	common.TypePackageManagerMavenCentral: common.CategoryPackageManager,
+	common.TypePackageManagerNpm:          common.CategoryPackageManager,
+	common.TypePackageManagerNuGet:        common.CategoryPackageManager,
+	common.TypePackageManagerBower:        common.CategoryPackageManager,
+	common.TypePackageManagerPURL:         common.CategoryPackageManager,
+}
+
+// invert returns a reverse lookup of a mapping whose values are unique
+func invert(m map[string]string) map[string]string {
+	out := map[string]string{}
+	for k, v := range m {
+		out[v] = k
+	}
+	return out
+}
+
+var (
+	v2PackagePurposes   = invert(packagePurposes)
+	v2IdentifierTypes   = invert(externalIdentifierTypes)
+	v2ExternalRefTypes  = invert(externalRefTypes)
+	v2ChecksumAlgorithm = func() map[string]common.ChecksumAlgorithm {
+		out := map[string]common.ChecksumAlgorithm{}
+		for k, v := range hashAlgorithms {
+			out[v] = k
+		}
+		return out
+	}()
+	v2FileTypes = func() map[string]string {
+		out := map[string]string{}
+		for k, v := range fileTypes {
+			if v != v3_0.PurposeData {
+				out[v] = k
+			}
+		}
+		out[v3_0.PurposeData] = "OTHER"
+		return out
+	}()
+)
@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package convert
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+	"github.com/spdx/tools-golang/spdx/v3/v3_0"
+)
+
+func Test_ConvertV2_3ToV3_0(t *testing.T) {
+	src := example.Copy()
+
+	var doc v3_0.Document
+	require.NoError(t, Document(src, &doc))
+
+	prefix := src.DocumentNamespace + "#"
+	require.Equal(t, prefix+"SPDXRef-DOCUMENT", doc.SpdxID)
+	require.Equal(t, src.DocumentName, doc.Name)
+	require.Equal(t, v3_0.Version, doc.CreationInfo.SpecVersion)
+	require.Equal(t, src.CreationInfo.Created, doc.CreationInfo.Created)
+	require.Len(t, doc.CreationInfo.CreatedUsing, 1)
+	require.Len(t, doc.NamespaceMap, len(src.ExternalDocumentReferences))
+
+	dataLicense, ok := doc.Find(doc.DataLicense).(*v3_0.LicenseExpression)
+	require.True(t, ok)
+	require.Equal(t, "CC0-1.0", dataLicense.LicenseExpression)
+
+	pkg, ok := doc.Find(prefix + "SPDXRef-Package").(*v3_0.Package)
+	require.True(t, ok)
+	require.Equal(t, "glibc", pkg.Name)
+	require.Equal(t, "2.11.1", pkg.PackageVersion)
+	require.NotEmpty(t, pkg.SuppliedBy)
+	require.NotEmpty(t, pkg.VerifiedUsing)
+
+	var concluded *v3_0.Relationship
+	for _, e := range doc.Elements {
+		if r := relationshipOf(e); r != nil && r.From == pkg.SpdxID && r.RelationshipType == v3_0.RelationshipHasConcludedLicense {
+			concluded = r
+		}
+	}
+	require.NotNil(t, concluded)
+	expression, ok := doc.Find(concluded.To[0]).(*v3_0.LicenseExpression)
+	require.True(t, ok)
+	require.Equal(t, src.Packages[0].PackageLicenseConcluded, expression.LicenseExpression)
+	require.NotEmpty(t, expression.CustomIDToURI)
+
+	for _, f := range src.Files {
+		_, ok := doc.Find(prefix + common.RenderElementID(f.FileSPDXIdentifier)).(*v3_0.File)
+		require.True(t, ok, f.FileSPDXIdentifier)
+	}
+	for _, l := range src.OtherLicenses {
+		_, ok := doc.Find(prefix + l.LicenseIdentifier).(*v3_0.CustomLicense)
+		require.True(t, ok, l.LicenseIdentifier)
+	}
+}
+
+func Test_ConvertV3_0RoundTrip(t *testing.T) {
+	src := example.Copy()
+
+	var v3doc v3_0.Document
+	require.NoError(t, Document(src, &v3doc))
+
+	var got v2_3.Document
+	require.NoError(t, Document(v3doc, &got))
+
+	require.Equal(t, src.DocumentNamespace, got.DocumentNamespace)
+	require.Equal(t, src.DocumentName, got.DocumentName)
+	require.ElementsMatch(t, src.CreationInfo.Creators, got.CreationInfo.Creators)
+	require.Equal(t, src.CreationInfo.LicenseListVersion, got.CreationInfo.LicenseListVersion)
+	require.Len(t, got.ExternalDocumentReferences, len(src.ExternalDocumentReferences))
+	require.Len(t, got.Packages, len(src.Packages))
+	require.Len(t, got.OtherLicenses, len(src.OtherLicenses))
+	require.Len(t, got.Snippets, len(src.Snippets))
+
+	for i, want := range src.Packages {
+		p := got.Packages[i]
+		require.Equal(t, want.PackageSPDXIdentifier, p.PackageSPDXIdentifier)
+		require.Equal(t, want.PackageName, p.PackageName)
+		require.Equal(t, want.PackageVersion, p.PackageVersion)
+		require.Equal(t, want.PackageFileName, p.PackageFileName)
+		require.Equal(t, want.PackageLicenseConcluded, p.PackageLicenseConcluded)
+		require.Equal(t, want.PackageLicenseDeclared, p.PackageLicenseDeclared)
+		require.Equal(t, want.PackageChecksums, p.PackageChecksums)
+		require.Equal(t, want.FilesAnalyzed, p.FilesAnalyzed)
+		require.Equal(t, want.PrimaryPackagePurpose, p.PrimaryPackagePurpose)
+		require.Equal(t, want.ReleaseDate, p.ReleaseDate)
+		require.ElementsMatch(t, want.PackageExternalReferences, p.PackageExternalReferences)
+	}
+
+	// every relationship survives, although an inverse type may be used instead
+	normalize := func(r *v2_3.Relationship) string {
+		m, ok := v2RelationshipMapping(r.Relationship)
+		require.True(t, ok)
+		from, to := common.RenderDocElementID(r.RefA), common.RenderDocElementID(r.RefB)
+		if m.reverse {
+			from, to = to, from
+		}
+		return from + " " + m.v3Type + " " + m.scope + " " + to
+	}
+	var want, have []string
+	for _, r := range src.Relationships {
+		want = append(want, normalize(r))
+	}
+	for _, r := range got.Relationships {
+		have = append(have, normalize(r))
+	}
+	require.ElementsMatch(t, want, have)
+}
+
+func Test_ConvertV3_0ToV2(t *testing.T) {
+	// SPDX 3 JSON-LD is read by the jsonld package; decode directly to avoid an import cycle
+	b, err := os.ReadFile("../examples/sample-docs/jsonld/SPDXJSONLDExample-v3.0.1.spdx.json")
+	require.NoError(t, err)
+	var src v3_0.Document
+	require.NoError(t, src.UnmarshalJSON(b))
+
+	var doc v2_2.Document
+	require.NoError(t, Document(src, &doc))
+
+	require.Equal(t, "https://spdx.org/spdxdocs/example-3.0.1/Document", doc.DocumentNamespace)
+	require.Equal(t, "SPDX-Tools-v3.0", doc.DocumentName)
+	require.Equal(t, "CC0-1.0", doc.DataLicense)
+	require.Equal(t, "3.24.0", doc.CreationInfo.LicenseListVersion)
+	require.Len(t, doc.CreationInfo.Creators, 2)
+
+	require.Len(t, doc.Packages, 1)
+	pkg := doc.Packages[0]
+	require.Equal(t, common.ElementID("glibc"), pkg.PackageSPDXIdentifier)
+	require.Equal(t, "LGPL-2.0-only OR LicenseRef-3", pkg.PackageLicenseConcluded)
+	require.Equal(t, "Jane Doe", pkg.PackageSupplier.Supplier)
+	require.Equal(t, "Person", pkg.PackageSupplier.SupplierType)
+	require.True(t, pkg.FilesAnalyzed)
+	require.Equal(t, "d6a770ba38583ed4bb4525bd96e50461655d2758", pkg.PackageVerificationCode.Value)
+	require.Len(t, pkg.Annotations, 1)
+
+	require.Len(t, doc.Files, 1)
+	require.Equal(t, "./src/foo.c", doc.Files[0].FileName)
+	require.Equal(t, []string{"SOURCE"}, doc.Files[0].FileTypes)
+	require.Len(t, doc.Snippets, 1)
+	require.Len(t, doc.OtherLicenses, 1)
+	require.Equal(t, "LicenseRef-3", doc.OtherLicenses[0].LicenseIdentifier)
+
+	require.Len(t, doc.ExternalDocumentReferences, 1)
+	ref := doc.ExternalDocumentReferences[0]
+	require.Equal(t, "https://spdx.org/spdxdocs/spdx-tools-v1.2.spdx.json", ref.URI)
+
+	var types []string
+	for _, r := range doc.Relationships {
+		types = append(types, r.Relationship)
+		if r.Relationship == common.TypeRelationshipBuildDependencyOf {
+			require.Equal(t, ref.DocumentRefID, r.RefA.DocumentRefID)
+			require.Equal(t, common.ElementID("glibc"), r.RefB.ElementRefID)
+		}
+	}
+	require.ElementsMatch(t, []string{
+		common.TypeRelationshipContains,
+		common.TypeRelationshipBuildDependencyOf,
+		common.TypeRelationshipDescribe,
+	}, types)
+}
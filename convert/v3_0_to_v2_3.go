@@ -0,0 +1,678 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package convert
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+	"github.com/spdx/tools-golang/spdx/v3/v3_0"
+)
+
+// invalidIDChars matches the characters that are not allowed in an SPDX 2 element ID
+var invalidIDChars = regexp.MustCompile(`[^A-Za-z0-9.\-]+`)
+
+// v2Builder holds the state of a single SPDX 3.0 to SPDX 2.3 conversion
+type v2Builder struct {
+	src    *v3_0.Document
+	dst    *v2_3.Document
+	prefix string
+
+	elements map[string]v3_0.AnyElement
+	ids      map[string]common.ElementID
+	usedIDs  map[common.ElementID]bool
+	external map[string]common.DocElementID
+
+	packages map[string]*v2_3.Package
+	files    map[string]*v2_3.File
+	snippets map[string]int
+
+	// distributionFiles are the files only used to hold a PackageFileName
+	distributionFiles map[string]bool
+}
+
+// v3_0ToV2_3 converts an SPDX 3.0 element graph into an SPDX 2.3 document.
+// Elements and properties without an SPDX 2.3 equivalent are dropped.
+func v3_0ToV2_3(src v3_0.Document) (v2_3.Document, error) {
+	b := &v2Builder{
+		src:               &src,
+		dst:               &v2_3.Document{},
+		elements:          map[string]v3_0.AnyElement{},
+		ids:               map[string]common.ElementID{},
+		usedIDs:           map[common.ElementID]bool{},
+		external:          map[string]common.DocElementID{},
+		packages:          map[string]*v2_3.Package{},
+		files:             map[string]*v2_3.File{},
+		snippets:          map[string]int{},
+		distributionFiles: map[string]bool{},
+	}
+	return b.build()
+}
+
+func (b *v2Builder) build() (v2_3.Document, error) {
+	src, dst := b.src, b.dst
+
+	namespace := src.SpdxID
+	if i := strings.Index(namespace, "#"); i >= 0 {
+		namespace = namespace[:i]
+	}
+	b.prefix = namespace + "#"
+
+	dst.SPDXVersion = v2_3.Version
+	dst.DataLicense = v2_3.DataLicense
+	dst.SPDXIdentifier = "DOCUMENT"
+	dst.DocumentName = src.Name
+	dst.DocumentNamespace = namespace
+	dst.DocumentComment = src.Comment
+	b.ids[src.SpdxID] = dst.SPDXIdentifier
+	b.usedIDs[dst.SPDXIdentifier] = true
+
+	for _, e := range src.Elements {
+		if e != nil {
+			b.elements[e.Base().SpdxID] = e
+		}
+	}
+
+	b.externalDocuments()
+
+	if src.DataLicense != "" {
+		dst.DataLicense = b.license(src.DataLicense)
+	}
+
+	dst.CreationInfo = &v2_3.CreationInfo{}
+	if ci := src.CreationInfo; ci != nil {
+		dst.CreationInfo.Created = ci.Created
+		dst.CreationInfo.CreatorComment = ci.Comment
+		for _, id := range ci.CreatedBy {
+			name, agentType := b.agent(id)
+			dst.CreationInfo.Creators = append(dst.CreationInfo.Creators, common.Creator{Creator: name, CreatorType: agentType})
+		}
+		for _, id := range ci.CreatedUsing {
+			name, _ := b.agent(id)
+			dst.CreationInfo.Creators = append(dst.CreationInfo.Creators, common.Creator{Creator: name, CreatorType: "Tool"})
+		}
+	}
+
+	// distribution artifacts become the PackageFileName, not files of their own
+	for _, e := range src.Elements {
+		if r := relationshipOf(e); r != nil && r.RelationshipType == v3_0.RelationshipHasDistributionArtifact {
+			if _, ok := b.elements[r.From].(*v3_0.Package); !ok {
+				continue
+			}
+			for _, to := range r.To {
+				if f, ok := b.elements[to].(*v3_0.File); ok && to == r.From+"-PackageFileName" {
+					b.distributionFiles[f.SpdxID] = true
+				}
+			}
+		}
+	}
+
+	for _, e := range src.Elements {
+		switch e := e.(type) {
+		case *v3_0.Package:
+			b.pkg(e)
+		case *v3_0.File:
+			if !b.distributionFiles[e.SpdxID] {
+				b.file(e)
+			}
+		case *v3_0.Snippet:
+			b.snippet(e)
+		case *v3_0.CustomLicense:
+			b.otherLicense(e)
+		case *v3_0.LicenseExpression:
+			if dst.CreationInfo.LicenseListVersion == "" {
+				dst.CreationInfo.LicenseListVersion = e.LicenseListVersion
+			}
+		}
+	}
+
+	described := map[string]bool{}
+	for _, e := range src.Elements {
+		r := relationshipOf(e)
+		if r == nil {
+			continue
+		}
+		scope := ""
+		if s, ok := e.(*v3_0.LifecycleScopedRelationship); ok {
+			scope = s.Scope
+		}
+		if r.RelationshipType == v3_0.RelationshipDescribes && r.From == src.SpdxID {
+			for _, to := range r.To {
+				described[to] = true
+			}
+		}
+		if err := b.relationship(r, scope); err != nil {
+			return *dst, err
+		}
+	}
+	for _, root := range src.RootElementIDs {
+		if !described[root] {
+			dst.Relationships = append(dst.Relationships, &v2_3.Relationship{
+				RefA:         common.MakeDocElementID("", string(dst.SPDXIdentifier)),
+				RefB:         b.docElementID(root),
+				Relationship: common.TypeRelationshipDescribe,
+			})
+		}
+	}
+
+	for _, e := range src.Elements {
+		if a, ok := e.(*v3_0.Annotation); ok {
+			b.annotation(a)
+		}
+	}
+
+	return *dst, nil
+}
+
+// externalDocuments creates the ExternalDocumentRefs for the namespace map
+// entries and imported elements
+func (b *v2Builder) externalDocuments() {
+	imports := map[string]v3_0.ExternalMap{}
+	for _, m := range b.src.Imports {
+		imports[m.ExternalSpdxID] = m
+	}
+
+	for _, m := range b.src.NamespaceMap {
+		if !strings.HasPrefix(m.Prefix, documentRefPrefix) {
+			continue
+		}
+		refID := strings.TrimPrefix(m.Prefix, documentRefPrefix)
+
+		ref := v2_3.ExternalDocumentRef{
+			DocumentRefID: refID,
+			URI:           strings.TrimSuffix(m.Namespace, "#"),
+		}
+		if document, ok := imports[m.Namespace+spdxRefPrefix+"DOCUMENT"]; ok {
+			ref.Checksum = b.checksum(document.VerifiedUsing)
+			delete(imports, document.ExternalSpdxID)
+		}
+		b.dst.ExternalDocumentReferences = append(b.dst.ExternalDocumentReferences, ref)
+		b.external[m.Namespace] = common.MakeDocElementID(refID, "")
+	}
+
+	for _, m := range b.src.Imports {
+		if _, ok := imports[m.ExternalSpdxID]; !ok || b.inExternalNamespace(m.ExternalSpdxID) {
+			continue
+		}
+
+		// an element imported without a namespace map entry gets a reference of its own
+		uri := m.LocationHint
+		if uri == "" {
+			uri = m.ExternalSpdxID
+		}
+		refID := fmt.Sprintf("external-%d", len(b.dst.ExternalDocumentReferences)+1)
+		b.dst.ExternalDocumentReferences = append(b.dst.ExternalDocumentReferences, v2_3.ExternalDocumentRef{
+			DocumentRefID: refID,
+			URI:           uri,
+			Checksum:      b.checksum(m.VerifiedUsing),
+		})
+		b.external[m.ExternalSpdxID] = common.MakeDocElementID(refID, b.sanitize(m.ExternalSpdxID))
+	}
+}
+
+func (b *v2Builder) inExternalNamespace(iri string) bool {
+	for namespace, ref := range b.external {
+		if ref.ElementRefID == "" && strings.HasPrefix(iri, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// elementID returns the SPDX 2 ID for the element with the given IRI
+func (b *v2Builder) elementID(iri string) common.ElementID {
+	if id, ok := b.ids[iri]; ok {
+		return id
+	}
+
+	var id common.ElementID
+	if rest := strings.TrimPrefix(iri, b.prefix); rest != iri && strings.HasPrefix(rest, spdxRefPrefix) {
+		id = common.ElementID(strings.TrimPrefix(rest, spdxRefPrefix))
+	} else {
+		id = common.ElementID(b.sanitize(iri))
+	}
+
+	unique := id
+	for i := 2; b.usedIDs[unique]; i++ {
+		unique = common.ElementID(fmt.Sprintf("%s-%d", id, i))
+	}
+	b.ids[iri] = unique
+	b.usedIDs[unique] = true
+	return unique
+}
+
+// sanitize turns the last segment of an IRI into a valid SPDX 2 ID string
+func (b *v2Builder) sanitize(iri string) string {
+	name := iri
+	if i := strings.LastIndexAny(name, "/#:"); i >= 0 && i < len(name)-1 {
+		name = name[i+1:]
+	}
+	name = strings.TrimPrefix(name, spdxRefPrefix)
+	name = strings.Trim(invalidIDChars.ReplaceAllString(name, "-"), "-")
+	if name == "" {
+		name = "Element"
+	}
+	return name
+}
+
+func (b *v2Builder) docElementID(iri string) common.DocElementID {
+	switch iri {
+	case v3_0.NoneElement:
+		return common.MakeDocElementSpecial(none)
+	case v3_0.NoAssertionElement:
+		return common.MakeDocElementSpecial(noAssertion)
+	}
+	if _, ok := b.elements[iri]; !ok && iri != b.src.SpdxID {
+		if id, ok := b.external[iri]; ok {
+			return id
+		}
+		for namespace, ref := range b.external {
+			if ref.ElementRefID == "" && strings.HasPrefix(iri, namespace) {
+				return common.MakeDocElementID(ref.DocumentRefID, strings.TrimPrefix(strings.TrimPrefix(iri, namespace), spdxRefPrefix))
+			}
+		}
+	}
+	return common.MakeDocElementID("", string(b.elementID(iri)))
+}
+
+// agent returns the name and SPDX 2 agent type of the agent element with the given IRI
+func (b *v2Builder) agent(iri string) (string, string) {
+	switch e := b.elements[iri].(type) {
+	case *v3_0.Person:
+		return e.Name, "Person"
+	case *v3_0.Organization:
+		return e.Name, "Organization"
+	case *v3_0.Tool:
+		return e.Name, "Tool"
+	case *v3_0.SoftwareAgent:
+		return e.Name, "Tool"
+	case *v3_0.Agent:
+		return e.Name, "Organization"
+	}
+	if iri == v3_0.SpdxOrganization {
+		return "SPDX", "Organization"
+	}
+	return b.sanitize(iri), "Organization"
+}
+
+func (b *v2Builder) checksum(methods []v3_0.IntegrityMethod) common.Checksum {
+	checksums := b.checksums(methods)
+	if len(checksums) == 0 {
+		return common.Checksum{}
+	}
+	return checksums[0]
+}
+
+func (b *v2Builder) checksums(methods []v3_0.IntegrityMethod) []common.Checksum {
+	var out []common.Checksum
+	for _, m := range methods {
+		if m.Type != v3_0.TypeHash {
+			continue
+		}
+		if algorithm, ok := v2ChecksumAlgorithm[m.Algorithm]; ok {
+			out = append(out, common.Checksum{Algorithm: algorithm, Value: m.HashValue})
+		}
+	}
+	return out
+}
+
+// license returns the SPDX 2 license field value for the license element with the given IRI
+func (b *v2Builder) license(iri string) string {
+	switch iri {
+	case v3_0.NoneLicense:
+		return none
+	case v3_0.NoAssertionLicense:
+		return noAssertion
+	}
+	switch e := b.elements[iri].(type) {
+	case *v3_0.LicenseExpression:
+		return e.LicenseExpression
+	case *v3_0.CustomLicense:
+		return b.licenseRef(e)
+	}
+	// listed licenses are identified by the last segment of their IRI, e.g. https://spdx.org/licenses/MIT
+	return b.sanitize(iri)
+}
+
+// licenseRef returns the LicenseRef- identifier of a custom license
+func (b *v2Builder) licenseRef(l *v3_0.CustomLicense) string {
+	if rest := strings.TrimPrefix(l.SpdxID, b.prefix); strings.HasPrefix(rest, "LicenseRef-") {
+		return rest
+	}
+	for _, e := range b.src.Elements {
+		if expression, ok := e.(*v3_0.LicenseExpression); ok {
+			for _, entry := range expression.CustomIDToURI {
+				if entry.Value == l.SpdxID {
+					return entry.Key
+				}
+			}
+		}
+	}
+	return "LicenseRef-" + b.sanitize(l.SpdxID)
+}
+
+func (b *v2Builder) otherLicense(l *v3_0.CustomLicense) {
+	b.dst.OtherLicenses = append(b.dst.OtherLicenses, &v2_3.OtherLicense{
+		LicenseIdentifier:      b.licenseRef(l),
+		ExtractedText:          l.LicenseText,
+		LicenseName:            l.Name,
+		LicenseCrossReferences: l.SeeAlso,
+		LicenseComment:         l.Comment,
+	})
+}
+
+func (b *v2Builder) pkg(p *v3_0.Package) {
+	pkg := &v2_3.Package{
+		PackageName:               p.Name,
+		PackageSPDXIdentifier:     b.elementID(p.SpdxID),
+		PackageVersion:            p.PackageVersion,
+		PackageDownloadLocation:   p.DownloadLocation,
+		IsFilesAnalyzedTagPresent: true,
+		PackageChecksums:          b.checksums(p.VerifiedUsing),
+		PackageHomePage:           p.HomePage,
+		PackageSourceInfo:         p.SourceInfo,
+		PackageCopyrightText:      p.CopyrightText,
+		PackageSummary:            p.Summary,
+		PackageDescription:        p.Description,
+		PackageComment:            p.Comment,
+		PackageAttributionTexts:   p.AttributionTexts,
+		PrimaryPackagePurpose:     v2PackagePurposes[p.PrimaryPurpose],
+		ReleaseDate:               p.ReleaseTime,
+		BuiltDate:                 p.BuiltTime,
+		ValidUntilDate:            p.ValidUntilTime,
+	}
+	if pkg.PackageDownloadLocation == "" {
+		pkg.PackageDownloadLocation = noAssertion
+	}
+
+	if p.SuppliedBy != "" {
+		name, agentType := b.agent(p.SuppliedBy)
+		pkg.PackageSupplier = &common.Supplier{Supplier: name, SupplierType: agentType}
+	}
+	if len(p.OriginatedBy) > 0 {
+		name, agentType := b.agent(p.OriginatedBy[0])
+		pkg.PackageOriginator = &common.Originator{Originator: name, OriginatorType: agentType}
+	}
+
+	for _, m := range p.VerifiedUsing {
+		if m.Type == v3_0.TypePackageVerificationCode {
+			pkg.PackageVerificationCode = &common.PackageVerificationCode{
+				Value:         m.HashValue,
+				ExcludedFiles: m.PackageVerificationCodeExcludedFiles,
+			}
+			pkg.FilesAnalyzed = true
+		}
+	}
+	for _, e := range b.src.Elements {
+		if r := relationshipOf(e); r != nil && r.From == p.SpdxID && r.RelationshipType == v3_0.RelationshipContains {
+			for _, to := range r.To {
+				if _, ok := b.elements[to].(*v3_0.File); ok {
+					pkg.FilesAnalyzed = true
+				}
+			}
+		}
+	}
+
+	if p.PackageURL != "" {
+		pkg.PackageExternalReferences = append(pkg.PackageExternalReferences, &v2_3.PackageExternalReference{
+			Category: common.CategoryPackageManager,
+			RefType:  common.TypePackageManagerPURL,
+			Locator:  p.PackageURL,
+		})
+	}
+	for _, id := range p.ExternalIdentifiers {
+		refType, ok := v2IdentifierTypes[id.ExternalIdentifierType]
+		if id.ExternalIdentifierType == v3_0.ExternalIdentifierPackageURL {
+			refType, ok = common.TypePackageManagerPURL, true
+		}
+		if !ok {
+			refType = id.ExternalIdentifierType
+		}
+		pkg.PackageExternalReferences = append(pkg.PackageExternalReferences, b.externalReference(refType, id.Identifier, id.Comment))
+	}
+	for _, ref := range p.ExternalRefs {
+		refType, ok := v2ExternalRefTypes[ref.ExternalRefType]
+		if !ok {
+			refType = ref.ExternalRefType
+			if ref.ExternalRefType == v3_0.ExternalRefOther && ref.ContentType != "" {
+				refType = ref.ContentType
+			}
+		}
+		for _, locator := range ref.Locators {
+			pkg.PackageExternalReferences = append(pkg.PackageExternalReferences, b.externalReference(refType, locator, ref.Comment))
+		}
+	}
+
+	b.packages[p.SpdxID] = pkg
+	b.dst.Packages = append(b.dst.Packages, pkg)
+}
+
+func (b *v2Builder) externalReference(refType string, locator string, comment string) *v2_3.PackageExternalReference {
+	category, ok := externalRefCategories[refType]
+	if !ok {
+		category = common.CategoryOther
+	}
+	return &v2_3.PackageExternalReference{
+		Category:           category,
+		RefType:            refType,
+		Locator:            locator,
+		ExternalRefComment: comment,
+	}
+}
+
+func (b *v2Builder) file(f *v3_0.File) {
+	file := &v2_3.File{
+		FileName:             f.Name,
+		FileSPDXIdentifier:   b.elementID(f.SpdxID),
+		Checksums:            b.checksums(f.VerifiedUsing),
+		FileCopyrightText:    f.CopyrightText,
+		FileComment:          f.Comment,
+		FileAttributionTexts: f.AttributionTexts,
+	}
+	for _, purpose := range append([]string{f.PrimaryPurpose}, f.AdditionalPurposes...) {
+		if purpose == "" {
+			continue
+		}
+		fileType, ok := v2FileTypes[purpose]
+		if !ok {
+			fileType = "OTHER"
+		}
+		file.FileTypes = appendUnique(file.FileTypes, fileType)
+	}
+	for _, id := range f.OriginatedBy {
+		name, _ := b.agent(id)
+		file.FileContributors = append(file.FileContributors, name)
+	}
+
+	b.files[f.SpdxID] = file
+	b.dst.Files = append(b.dst.Files, file)
+}
+
+func (b *v2Builder) snippet(s *v3_0.Snippet) {
+	fromFile := b.elementID(s.SnippetFromFile)
+	snippet := v2_3.Snippet{
+		SnippetSPDXIdentifier:         b.elementID(s.SpdxID),
+		SnippetFromFileSPDXIdentifier: fromFile,
+		SnippetCopyrightText:          s.CopyrightText,
+		SnippetComment:                s.Comment,
+		SnippetName:                   s.Name,
+		SnippetAttributionTexts:       s.AttributionTexts,
+	}
+	if s.ByteRange != nil {
+		snippet.Ranges = append(snippet.Ranges, common.SnippetRange{
+			StartPointer: common.SnippetRangePointer{Offset: s.ByteRange.Begin, FileSPDXIdentifier: fromFile},
+			EndPointer:   common.SnippetRangePointer{Offset: s.ByteRange.End, FileSPDXIdentifier: fromFile},
+		})
+	}
+	if s.LineRange != nil {
+		snippet.Ranges = append(snippet.Ranges, common.SnippetRange{
+			StartPointer: common.SnippetRangePointer{LineNumber: s.LineRange.Begin, FileSPDXIdentifier: fromFile},
+			EndPointer:   common.SnippetRangePointer{LineNumber: s.LineRange.End, FileSPDXIdentifier: fromFile},
+		})
+	}
+
+	b.dst.Snippets = append(b.dst.Snippets, snippet)
+	b.snippets[s.SpdxID] = len(b.dst.Snippets) - 1
+}
+
+func (b *v2Builder) relationship(r *v3_0.Relationship, scope string) error {
+	switch r.RelationshipType {
+	case v3_0.RelationshipHasConcludedLicense, v3_0.RelationshipHasDeclaredLicense:
+		b.licenseRelationship(r)
+		return nil
+	case v3_0.RelationshipContains:
+		// the markers written for the FilesAnalyzed value of SPDX 2 packages,
+		// see v3Builder.filesAnalyzed
+		if pkg, ok := b.packages[r.From]; ok && len(r.To) == 1 {
+			switch {
+			case r.To[0] == v3_0.NoAssertionElement && r.Completeness == v3_0.CompletenessNoAssertion:
+				pkg.FilesAnalyzed = false
+				return nil
+			case r.To[0] == v3_0.NoneElement && r.Completeness == v3_0.CompletenessComplete:
+				pkg.FilesAnalyzed = true
+				return nil
+			}
+		}
+	case v3_0.RelationshipHasDistributionArtifact:
+		if pkg, ok := b.packages[r.From]; ok {
+			for _, to := range r.To {
+				if b.distributionFiles[to] {
+					pkg.PackageFileName = b.elements[to].Base().Name
+				}
+			}
+		}
+	}
+
+	// SPDX 2 only allows NONE and NOASSERTION as the related element, so use the inverse type if there is one
+	special := r.From == v3_0.NoneElement || r.From == v3_0.NoAssertionElement
+	m, ok := v3RelationshipMapping(r.RelationshipType, scope, special)
+	comment := r.Comment
+	if !ok {
+		// keep the SPDX 3 relationship type, which has no SPDX 2 equivalent, in the comment
+		m = relationshipMapping{v2Type: common.TypeRelationshipOther}
+		comment = strings.TrimSpace(r.RelationshipType + " " + comment)
+	}
+
+	for _, to := range r.To {
+		if b.distributionFiles[to] {
+			continue
+		}
+		refA, refB := b.docElementID(r.From), b.docElementID(to)
+		if m.reverse {
+			refA, refB = refB, refA
+		}
+		b.dst.Relationships = append(b.dst.Relationships, &v2_3.Relationship{
+			RefA:                refA,
+			RefB:                refB,
+			Relationship:        m.v2Type,
+			RelationshipComment: comment,
+		})
+	}
+	return nil
+}
+
+// licenseRelationship sets the SPDX 2 license fields of the artifact the relationship is from
+func (b *v2Builder) licenseRelationship(r *v3_0.Relationship) {
+	var licenses []string
+	for _, to := range r.To {
+		licenses = append(licenses, b.license(to))
+	}
+	concluded := r.RelationshipType == v3_0.RelationshipHasConcludedLicense
+
+	if pkg, ok := b.packages[r.From]; ok {
+		if concluded {
+			pkg.PackageLicenseConcluded = joinLicenses(pkg.PackageLicenseConcluded, licenses)
+			pkg.PackageLicenseComments = r.Comment
+		} else {
+			pkg.PackageLicenseDeclared = joinLicenses(pkg.PackageLicenseDeclared, licenses)
+		}
+	}
+	if file, ok := b.files[r.From]; ok {
+		if concluded {
+			file.LicenseConcluded = joinLicenses(file.LicenseConcluded, licenses)
+			file.LicenseComments = r.Comment
+		} else {
+			file.LicenseInfoInFiles = append(file.LicenseInfoInFiles, licenses...)
+		}
+	}
+	if i, ok := b.snippets[r.From]; ok {
+		snippet := &b.dst.Snippets[i]
+		if concluded {
+			snippet.SnippetLicenseConcluded = joinLicenses(snippet.SnippetLicenseConcluded, licenses)
+			snippet.SnippetLicenseComments = r.Comment
+		} else {
+			snippet.LicenseInfoInSnippet = append(snippet.LicenseInfoInSnippet, licenses...)
+		}
+	}
+}
+
+func (b *v2Builder) annotation(a *v3_0.Annotation) {
+	annotation := v2_3.Annotation{
+		AnnotationType:    "OTHER",
+		AnnotationComment: a.Statement,
+	}
+	if a.AnnotationType == v3_0.AnnotationTypeReview {
+		annotation.AnnotationType = "REVIEW"
+	}
+	if ci := a.CreationInfo; ci != nil {
+		annotation.AnnotationDate = ci.Created
+		switch {
+		case len(ci.CreatedUsing) > 0:
+			name, _ := b.agent(ci.CreatedUsing[0])
+			annotation.Annotator = common.Annotator{Annotator: name, AnnotatorType: "Tool"}
+		case len(ci.CreatedBy) > 0:
+			name, agentType := b.agent(ci.CreatedBy[0])
+			annotation.Annotator = common.Annotator{Annotator: name, AnnotatorType: agentType}
+		}
+	}
+
+	annotation.AnnotationSPDXIdentifier = b.docElementID(a.Subject)
+	if pkg, ok := b.packages[a.Subject]; ok {
+		pkg.Annotations = append(pkg.Annotations, annotation)
+		return
+	}
+	if file, ok := b.files[a.Subject]; ok {
+		file.Annotations = append(file.Annotations, annotation)
+		return
+	}
+	b.dst.Annotations = append(b.dst.Annotations, &annotation)
+}
+
+// relationshipOf returns the relationship properties of either kind of relationship element
+func relationshipOf(e v3_0.AnyElement) *v3_0.Relationship {
+	switch r := e.(type) {
+	case *v3_0.Relationship:
+		return r
+	case *v3_0.LifecycleScopedRelationship:
+		return &r.Relationship
+	}
+	return nil
+}
+
+// joinLicenses combines several license values into a single expression
+func joinLicenses(existing string, licenses []string) string {
+	if existing != "" {
+		licenses = append([]string{existing}, licenses...)
+	}
+	if len(licenses) == 1 {
+		return licenses[0]
+	}
+	for i, l := range licenses {
+		if strings.Contains(l, " ") {
+			licenses[i] = "(" + l + ")"
+		}
+	}
+	return strings.Join(licenses, " AND ")
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
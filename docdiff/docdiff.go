@@ -0,0 +1,312 @@
+// Package docdiff compares two SPDX documents, such as the SBOMs of two
+// releases of a product. Packages are matched by purl, checksum or name, and
+// the differences are reported as a list of typed changes that can be
+// rendered as text or marshaled to JSON.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+package docdiff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+// Kind is the kind of a change
+type Kind string
+
+// The kinds of changes Compare reports
+const (
+	PackageAdded            Kind = "PACKAGE_ADDED"
+	PackageRemoved          Kind = "PACKAGE_REMOVED"
+	VersionChanged          Kind = "VERSION_CHANGED"
+	SupplierChanged         Kind = "SUPPLIER_CHANGED"
+	LicenseConcludedChanged Kind = "LICENSE_CONCLUDED_CHANGED"
+	LicenseDeclaredChanged  Kind = "LICENSE_DECLARED_CHANGED"
+	ExternalRefAdded        Kind = "EXTERNAL_REF_ADDED"
+	ExternalRefRemoved      Kind = "EXTERNAL_REF_REMOVED"
+	RelationshipAdded       Kind = "RELATIONSHIP_ADDED"
+	RelationshipRemoved     Kind = "RELATIONSHIP_REMOVED"
+	ExternalDocumentAdded   Kind = "EXTERNAL_DOCUMENT_ADDED"
+	ExternalDocumentRemoved Kind = "EXTERNAL_DOCUMENT_REMOVED"
+)
+
+// Change is a difference between two documents
+type Change struct {
+	Kind Kind `json:"kind"`
+
+	// Package is the name of the package the change is about, and PackageID
+	// its ID in the old document, or in the new document for added packages
+	Package   string `json:"package,omitempty"`
+	PackageID string `json:"packageId,omitempty"`
+
+	// Old and New are the value before and after the change; Old is empty
+	// for additions and New for removals
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+}
+
+// String renders the change on one line, e.g.
+// "~ glibc (SPDXRef-Package): version 2.11.1 -> 2.12"
+func (c Change) String() string {
+	subject := ""
+	if c.Package != "" || c.PackageID != "" {
+		subject = fmt.Sprintf("%s (%s)", c.Package, c.PackageID)
+	}
+	switch c.Kind {
+	case PackageAdded:
+		return strings.TrimSpace(fmt.Sprintf("+ package %s %s", subject, c.New))
+	case PackageRemoved:
+		return strings.TrimSpace(fmt.Sprintf("- package %s %s", subject, c.Old))
+	case VersionChanged:
+		return fmt.Sprintf("~ %s: version %s -> %s", subject, c.Old, c.New)
+	case SupplierChanged:
+		return fmt.Sprintf("~ %s: supplier %s -> %s", subject, c.Old, c.New)
+	case LicenseConcludedChanged:
+		return fmt.Sprintf("~ %s: concluded license %s -> %s", subject, c.Old, c.New)
+	case LicenseDeclaredChanged:
+		return fmt.Sprintf("~ %s: declared license %s -> %s", subject, c.Old, c.New)
+	case ExternalRefAdded:
+		return fmt.Sprintf("+ %s: external reference %s", subject, c.New)
+	case ExternalRefRemoved:
+		return fmt.Sprintf("- %s: external reference %s", subject, c.Old)
+	case RelationshipAdded:
+		return fmt.Sprintf("+ relationship %s", c.New)
+	case RelationshipRemoved:
+		return fmt.Sprintf("- relationship %s", c.Old)
+	case ExternalDocumentAdded:
+		return fmt.Sprintf("+ external document %s", c.New)
+	case ExternalDocumentRemoved:
+		return fmt.Sprintf("- external document %s", c.Old)
+	}
+	return fmt.Sprintf("%s %s: %s -> %s", c.Kind, subject, c.Old, c.New)
+}
+
+// Changes is the result of Compare
+type Changes []Change
+
+// String renders the changes one per line
+func (c Changes) String() string {
+	var b strings.Builder
+	for _, change := range c {
+		fmt.Fprintf(&b, "%s\n", change)
+	}
+	return b.String()
+}
+
+// Kind returns the changes of the given kind
+func (c Changes) Kind(kind Kind) Changes {
+	var out Changes
+	for _, change := range c {
+		if change.Kind == kind {
+			out = append(out, change)
+		}
+	}
+	return out
+}
+
+// Compare returns the changes from the old document to the new one.
+//
+// A package of the old document matches the package of the new document with
+// the same purl, the same checksum, the same purl apart from its version, or
+// the same name, tried in that order. Relationships are compared in canonical
+// direction, with the IDs of matched packages unified, so renamed packages
+// and relationships written as their inverse are not reported. Changes are
+// listed in the order of the old document, followed by additions.
+func Compare(old *spdx.Document, new *spdx.Document) Changes {
+	var changes Changes
+	matches := match(old.Packages, new.Packages)
+
+	matched := map[*spdx.Package]bool{}
+	for _, pkg := range old.Packages {
+		if pkg == nil {
+			continue
+		}
+		other, ok := matches[pkg]
+		if !ok {
+			changes = append(changes, Change{Kind: PackageRemoved, Package: pkg.PackageName, PackageID: renderID(pkg), Old: pkg.PackageVersion})
+			continue
+		}
+		matched[other] = true
+		changes = append(changes, comparePackages(pkg, other)...)
+	}
+	for _, pkg := range new.Packages {
+		if pkg != nil && !matched[pkg] {
+			changes = append(changes, Change{Kind: PackageAdded, Package: pkg.PackageName, PackageID: renderID(pkg), New: pkg.PackageVersion})
+		}
+	}
+
+	changes = append(changes, compareRelationships(old, new, matches)...)
+	changes = append(changes, compareExternalDocuments(old, new)...)
+	return changes
+}
+
+func comparePackages(old *spdx.Package, new *spdx.Package) Changes {
+	var changes Changes
+	changed := func(kind Kind, before string, after string) {
+		if before != after {
+			changes = append(changes, Change{Kind: kind, Package: old.PackageName, PackageID: renderID(old), Old: before, New: after})
+		}
+	}
+	changed(VersionChanged, old.PackageVersion, new.PackageVersion)
+	changed(SupplierChanged, supplier(old), supplier(new))
+	changed(LicenseConcludedChanged, old.PackageLicenseConcluded, new.PackageLicenseConcluded)
+	changed(LicenseDeclaredChanged, old.PackageLicenseDeclared, new.PackageLicenseDeclared)
+
+	oldRefs, newRefs := externalRefs(old), externalRefs(new)
+	for _, ref := range oldRefs {
+		if !contains(newRefs, ref) {
+			changes = append(changes, Change{Kind: ExternalRefRemoved, Package: old.PackageName, PackageID: renderID(old), Old: ref})
+		}
+	}
+	for _, ref := range newRefs {
+		if !contains(oldRefs, ref) {
+			changes = append(changes, Change{Kind: ExternalRefAdded, Package: old.PackageName, PackageID: renderID(old), New: ref})
+		}
+	}
+	return changes
+}
+
+// newIDPrefix moves IDs of the new document out of the namespace of the old
+// one; it cannot occur in an SPDX ID
+const newIDPrefix = "new:"
+
+// compareRelationships reports the relationships of one document that the
+// other does not have. The relationships of the new document are compared
+// with the IDs of the old document for matched packages, and with prefixed
+// IDs for unmatched packages and for other elements that reuse the ID of a
+// matched package, so they are not taken for an old element with the same ID.
+func compareRelationships(old *spdx.Document, new *spdx.Document, matches map[*spdx.Package]*spdx.Package) Changes {
+	ids := map[common.ElementID]common.ElementID{}
+	oldIDs := map[common.ElementID]bool{}
+	for oldPkg, newPkg := range matches {
+		ids[newPkg.PackageSPDXIdentifier] = oldPkg.PackageSPDXIdentifier
+		oldIDs[oldPkg.PackageSPDXIdentifier] = true
+	}
+	for _, pkg := range new.Packages {
+		if pkg == nil {
+			continue
+		}
+		if _, ok := ids[pkg.PackageSPDXIdentifier]; !ok {
+			ids[pkg.PackageSPDXIdentifier] = newIDPrefix + pkg.PackageSPDXIdentifier
+		}
+	}
+	unify := func(ref common.DocElementID) common.DocElementID {
+		if ref.DocumentRefID == "" && ref.SpecialID == "" {
+			if id, ok := ids[ref.ElementRefID]; ok {
+				ref.ElementRefID = id
+			} else if oldIDs[ref.ElementRefID] {
+				ref.ElementRefID = newIDPrefix + ref.ElementRefID
+			}
+		}
+		return ref
+	}
+
+	key := func(r *spdx.Relationship, unified bool) string {
+		c := spdxlib.CanonicalRelationship(r)
+		if unified {
+			c.RefA, c.RefB = unify(c.RefA), unify(c.RefB)
+		}
+		return fmt.Sprintf("%s %s %s", common.RenderDocElementID(c.RefA), c.Relationship, common.RenderDocElementID(c.RefB))
+	}
+
+	oldKeys, newKeys := map[string]bool{}, map[string]bool{}
+	for _, r := range old.Relationships {
+		if r != nil {
+			oldKeys[key(r, false)] = true
+		}
+	}
+	for _, r := range new.Relationships {
+		if r != nil {
+			newKeys[key(r, true)] = true
+		}
+	}
+
+	var changes Changes
+	reported := map[string]bool{}
+	for _, r := range old.Relationships {
+		if r != nil && !newKeys[key(r, false)] && !reported[key(r, false)] {
+			reported[key(r, false)] = true
+			changes = append(changes, Change{Kind: RelationshipRemoved, Old: renderRelationship(r)})
+		}
+	}
+	for _, r := range new.Relationships {
+		if r != nil && !oldKeys[key(r, true)] && !reported[key(r, true)] {
+			reported[key(r, true)] = true
+			changes = append(changes, Change{Kind: RelationshipAdded, New: renderRelationship(r)})
+		}
+	}
+	return changes
+}
+
+// compareExternalDocuments matches external document references by URI, since their IDs are local to each document
+func compareExternalDocuments(old *spdx.Document, new *spdx.Document) Changes {
+	uris := func(doc *spdx.Document) map[string]bool {
+		out := map[string]bool{}
+		for _, ref := range doc.ExternalDocumentReferences {
+			out[ref.URI] = true
+		}
+		return out
+	}
+	oldURIs, newURIs := uris(old), uris(new)
+
+	var changes Changes
+	for _, ref := range old.ExternalDocumentReferences {
+		if !newURIs[ref.URI] {
+			changes = append(changes, Change{Kind: ExternalDocumentRemoved, Old: renderExternalDocument(ref)})
+		}
+	}
+	for _, ref := range new.ExternalDocumentReferences {
+		if !oldURIs[ref.URI] {
+			changes = append(changes, Change{Kind: ExternalDocumentAdded, New: renderExternalDocument(ref)})
+		}
+	}
+	return changes
+}
+
+func renderID(pkg *spdx.Package) string {
+	return common.RenderElementID(pkg.PackageSPDXIdentifier)
+}
+
+func renderRelationship(r *spdx.Relationship) string {
+	return fmt.Sprintf("%s %s %s", common.RenderDocElementID(r.RefA), r.Relationship, common.RenderDocElementID(r.RefB))
+}
+
+func renderExternalDocument(ref spdx.ExternalDocumentRef) string {
+	id := ref.DocumentRefID
+	if !strings.HasPrefix(id, "DocumentRef-") {
+		id = "DocumentRef-" + id
+	}
+	return fmt.Sprintf("%s %s", id, ref.URI)
+}
+
+func supplier(pkg *spdx.Package) string {
+	if pkg.PackageSupplier == nil || pkg.PackageSupplier.Supplier == "" {
+		return ""
+	}
+	if pkg.PackageSupplier.SupplierType == "" {
+		return pkg.PackageSupplier.Supplier
+	}
+	return pkg.PackageSupplier.SupplierType + ": " + pkg.PackageSupplier.Supplier
+}
+
+func externalRefs(pkg *spdx.Package) []string {
+	var refs []string
+	for _, ref := range pkg.PackageExternalReferences {
+		if ref != nil {
+			refs = append(refs, fmt.Sprintf("%s %s %s", ref.Category, ref.RefType, ref.Locator))
+		}
+	}
+	return refs
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package docdiff
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+)
+
+func Test_CompareIdentical(t *testing.T) {
+	old, new := example.Copy(), example.Copy()
+	require.Empty(t, Compare(&old, &new))
+}
+
+func Test_Compare(t *testing.T) {
+	old, new := example.Copy(), example.Copy()
+
+	// glibc is upgraded
+	glibc := new.Packages[0]
+	glibc.PackageVersion = "2.12"
+	glibc.PackageSupplier = &common.Supplier{SupplierType: "Organization", Supplier: "GNU"}
+	glibc.PackageLicenseDeclared = "LGPL-2.1-or-later"
+	glibc.PackageExternalReferences = glibc.PackageExternalReferences[1:]
+
+	// Jena is matched by purl apart from its version, Saxon by checksum
+	// despite their new IDs; Apache Commons Lang is removed
+	jena := new.Packages[2]
+	jena.PackageSPDXIdentifier = "Jena-3.17.0"
+	jena.PackageVersion = "3.17.0"
+	jena.PackageName = "Apache Jena"
+	jena.PackageExternalReferences[0].Locator = "pkg:maven/org.apache.jena/apache-jena@3.17.0"
+	saxon := new.Packages[3]
+	saxon.PackageSPDXIdentifier = "Saxon-8.8"
+	saxon.PackageName = "Saxon-B"
+	new.Packages = append(new.Packages[:1], new.Packages[2:]...)
+	new.Packages = append(new.Packages, &spdx.Package{PackageName: "zlib", PackageSPDXIdentifier: "zlib", PackageVersion: "1.3"})
+
+	new.Relationships = []*spdx.Relationship{
+		new.Relationships[0],
+		new.Relationships[1],
+		new.Relationships[2],
+		new.Relationships[3],
+		// written as its inverse
+		{RefA: common.MakeDocElementID("", "JenaLib"), RefB: common.MakeDocElementID("", "Package"), Relationship: common.TypeRelationshipContainedBy},
+		{RefA: common.MakeDocElementID("", "Package"), RefB: common.MakeDocElementID("", "Saxon-8.8"), Relationship: common.TypeRelationshipDynamicLink},
+		new.Relationships[6],
+		{RefA: common.MakeDocElementID("", "File"), RefB: common.MakeDocElementID("", "Jena-3.17.0"), Relationship: common.TypeRelationshipGeneratedFrom},
+		{RefA: common.MakeDocElementID("", "Package"), RefB: common.MakeDocElementID("", "zlib"), Relationship: common.TypeRelationshipDependsOn},
+	}
+	new.ExternalDocumentReferences[0].URI = "http://spdx.org/spdxdocs/spdx-tools-v1.3"
+
+	changes := Compare(&old, &new)
+	require.Equal(t, Changes{
+		{Kind: VersionChanged, Package: "glibc", PackageID: "SPDXRef-Package", Old: "2.11.1", New: "2.12"},
+		{Kind: SupplierChanged, Package: "glibc", PackageID: "SPDXRef-Package", Old: "Person: Jane Doe (jane.doe@example.com)", New: "Organization: GNU"},
+		{Kind: LicenseDeclaredChanged, Package: "glibc", PackageID: "SPDXRef-Package", Old: "(LGPL-2.0-only AND LicenseRef-3)", New: "LGPL-2.1-or-later"},
+		{Kind: ExternalRefRemoved, Package: "glibc", PackageID: "SPDXRef-Package", Old: "SECURITY cpe23Type cpe:2.3:a:pivotal_software:spring_framework:4.1.0:*:*:*:*:*:*:*"},
+		{Kind: PackageRemoved, Package: "Apache Commons Lang", PackageID: "SPDXRef-fromDoap-1"},
+		{Kind: VersionChanged, Package: "Jena", PackageID: "SPDXRef-fromDoap-0", Old: "3.12.0", New: "3.17.0"},
+		{Kind: ExternalRefRemoved, Package: "Jena", PackageID: "SPDXRef-fromDoap-0", Old: "PACKAGE-MANAGER purl pkg:maven/org.apache.jena/apache-jena@3.12.0"},
+		{Kind: ExternalRefAdded, Package: "Jena", PackageID: "SPDXRef-fromDoap-0", New: "PACKAGE-MANAGER purl pkg:maven/org.apache.jena/apache-jena@3.17.0"},
+		{Kind: PackageAdded, Package: "zlib", PackageID: "SPDXRef-zlib", New: "1.3"},
+		{Kind: RelationshipRemoved, Old: "SPDXRef-JenaLib CONTAINS SPDXRef-Package"},
+		{Kind: RelationshipAdded, New: "SPDXRef-Package DEPENDS_ON SPDXRef-zlib"},
+		{Kind: ExternalDocumentRemoved, Old: "DocumentRef-spdx-tool-1.2 http://spdx.org/spdxdocs/spdx-tools-v1.2-3F2504E0-4F89-41D3-9A0C-0305E82C3301"},
+		{Kind: ExternalDocumentAdded, New: "DocumentRef-spdx-tool-1.2 http://spdx.org/spdxdocs/spdx-tools-v1.3"},
+	}, changes)
+
+	require.Len(t, changes.Kind(VersionChanged), 2)
+	require.Equal(t, "~ glibc (SPDXRef-Package): version 2.11.1 -> 2.12\n", changes[:1].String())
+	require.Equal(t, "- package Apache Commons Lang (SPDXRef-fromDoap-1)\n"+
+		"+ package zlib (SPDXRef-zlib) 1.3\n"+
+		"- relationship SPDXRef-JenaLib CONTAINS SPDXRef-Package\n", Changes{changes[4], changes[8], changes[9]}.String())
+
+	out, err := json.Marshal(changes[8:10])
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"kind": "PACKAGE_ADDED", "package": "zlib", "packageId": "SPDXRef-zlib", "new": "1.3"},
+		{"kind": "RELATIONSHIP_REMOVED", "old": "SPDXRef-JenaLib CONTAINS SPDXRef-Package"}
+	]`, string(out))
+}
+
+func Test_CompareRelationshipsOfReusedIDs(t *testing.T) {
+	old := &spdx.Document{
+		Packages: []*spdx.Package{{PackageName: "a", PackageSPDXIdentifier: "a"}},
+		Relationships: []*spdx.Relationship{
+			{RefA: common.MakeDocElementID("", "DOCUMENT"), RefB: common.MakeDocElementID("", "a"), Relationship: common.TypeRelationshipDescribe},
+		},
+	}
+	// a is renamed to x, and its old ID is given to the new package b
+	new := &spdx.Document{
+		Packages: []*spdx.Package{
+			{PackageName: "a", PackageSPDXIdentifier: "x"},
+			{PackageName: "b", PackageSPDXIdentifier: "a"},
+		},
+		Relationships: []*spdx.Relationship{
+			{RefA: common.MakeDocElementID("", "DOCUMENT"), RefB: common.MakeDocElementID("", "a"), Relationship: common.TypeRelationshipDescribe},
+		},
+	}
+
+	require.Equal(t, Changes{
+		{Kind: PackageAdded, Package: "b", PackageID: "SPDXRef-a"},
+		{Kind: RelationshipRemoved, Old: "SPDXRef-DOCUMENT DESCRIBES SPDXRef-a"},
+		{Kind: RelationshipAdded, New: "SPDXRef-DOCUMENT DESCRIBES SPDXRef-a"},
+	}, Compare(old, new))
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package docdiff
+
+import (
+	"strings"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// match pairs the packages of two documents. Each pass pairs the packages
+// left unpaired by the previous passes, in document order.
+func match(old []*spdx.Package, new []*spdx.Package) map[*spdx.Package]*spdx.Package {
+	matches := map[*spdx.Package]*spdx.Package{}
+	taken := map[*spdx.Package]bool{}
+
+	for _, keys := range []func(*spdx.Package) []string{purls, checksums, unversionedPurls, names} {
+		index := map[string][]*spdx.Package{}
+		for _, pkg := range new {
+			if pkg == nil || taken[pkg] {
+				continue
+			}
+			for _, k := range keys(pkg) {
+				index[k] = append(index[k], pkg)
+			}
+		}
+		for _, pkg := range old {
+			if pkg == nil {
+				continue
+			}
+			if _, ok := matches[pkg]; ok {
+				continue
+			}
+		candidates:
+			for _, k := range keys(pkg) {
+				for _, other := range index[k] {
+					if !taken[other] {
+						matches[pkg] = other
+						taken[other] = true
+						break candidates
+					}
+				}
+			}
+		}
+	}
+	return matches
+}
+
+func purls(pkg *spdx.Package) []string {
+	var out []string
+	for _, ref := range pkg.PackageExternalReferences {
+		if ref != nil && ref.RefType == common.TypePackageManagerPURL && ref.Locator != "" {
+			out = append(out, ref.Locator)
+		}
+	}
+	return out
+}
+
+// unversionedPurls returns the purls of the package without version, qualifiers and subpath
+func unversionedPurls(pkg *spdx.Package) []string {
+	var out []string
+	for _, purl := range purls(pkg) {
+		if i := strings.IndexAny(purl, "?#"); i >= 0 {
+			purl = purl[:i]
+		}
+		if i := strings.LastIndex(purl, "@"); i >= 0 {
+			purl = purl[:i]
+		}
+		out = append(out, purl)
+	}
+	return out
+}
+
+func checksums(pkg *spdx.Package) []string {
+	var out []string
+	for _, c := range pkg.PackageChecksums {
+		if c.Value != "" {
+			out = append(out, string(c.Algorithm)+":"+strings.ToLower(c.Value))
+		}
+	}
+	return out
+}
+
+func names(pkg *spdx.Package) []string {
+	if pkg.PackageName == "" {
+		return nil
+	}
+	return []string{pkg.PackageName}
+}
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/spdx/tools-golang/builder"
+	"github.com/spdx/tools-golang/licensing/licenselist"
 	"github.com/spdx/tools-golang/spdx"
 	"github.com/spdx/tools-golang/utils"
 )
@@ -135,6 +136,11 @@ func BuildIDsDocument(packageName string, dirRoot string, idconfig *Config) (*sp
 			pkg.PackageLicenseInfoFromFiles = append(pkg.PackageLicenseInfoFromFiles, lic)
 		}
 		sort.Strings(pkg.PackageLicenseInfoFromFiles)
+
+		// the IDs found refer to the license list in use
+		if doc.CreationInfo != nil {
+			doc.CreationInfo.LicenseListVersion = licenselist.Default().Version
+		}
 	}
 
 	return doc, nil
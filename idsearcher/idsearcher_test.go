@@ -3,7 +3,11 @@
 package idsearcher
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/spdx/tools-golang/licensing/licenselist"
 )
 
 func TestSearcherCanFillInIDs(t *testing.T) {
@@ -281,6 +285,39 @@ func TestSearcherCanFillInIDsAndIgnorePaths(t *testing.T) {
 	}
 }
 
+func TestSearcherSetsLicenseListVersion(t *testing.T) {
+	config := &Config{
+		NamespacePrefix: "https://github.com/swinslow/spdx-docs/spdx-go/testdata-",
+	}
+
+	// the IDs found refer to the embedded license list
+	doc, err := BuildIDsDocument("project2", "../testdata/project2/", config)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	want := licenselist.Default().Version
+	if want == "" {
+		t.Fatalf("expected non-empty license list version")
+	}
+	if doc.CreationInfo.LicenseListVersion != want {
+		t.Errorf("expected %v, got %v", want, doc.CreationInfo.LicenseListVersion)
+	}
+
+	// no license list is used if no IDs are found
+	dirRoot := t.TempDir()
+	err = os.WriteFile(filepath.Join(dirRoot, "noid.txt"), []byte("no license here\n"), 0644)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	doc, err = BuildIDsDocument("noids", dirRoot, config)
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if doc.CreationInfo.LicenseListVersion != "" {
+		t.Errorf("expected empty license list version, got %v", doc.CreationInfo.LicenseListVersion)
+	}
+}
+
 func TestSearcherFailsWithInvalidPath(t *testing.T) {
 	packageName := "project2"
 	dirRoot := "./oops/invalid"
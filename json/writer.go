@@ -7,6 +7,7 @@ import (
 	"io"
 
 	"github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdxlib"
 )
 
 type WriteOption func(*json.Encoder)
@@ -31,3 +32,13 @@ func Write(doc common.AnyDocument, w io.Writer, opts ...WriteOption) error {
 	}
 	return e.Encode(doc)
 }
+
+// WriteCanonical writes the document as returned by spdxlib.Canonical, in JSON format.
+// The document passed in is not changed.
+func WriteCanonical(doc common.AnyDocument, w io.Writer, opts ...WriteOption) error {
+	doc, err := spdxlib.Canonical(doc)
+	if err != nil {
+		return err
+	}
+	return Write(doc, w, opts...)
+}
@@ -2,13 +2,17 @@ package json_test
 
 import (
 	"bytes"
+	jsonenc "encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/spdx/tools-golang/json"
 	"github.com/spdx/tools-golang/spdx/common"
+	v2common "github.com/spdx/tools-golang/spdx/v2/common"
 	spdx "github.com/spdx/tools-golang/spdx/v2/v2_3"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
 )
 
 func Test_Write(t *testing.T) {
@@ -82,3 +86,25 @@ func Test_Write(t *testing.T) {
 		})
 	}
 }
+
+func Test_WriteCanonical(t *testing.T) {
+	doc := example.Copy()
+	reordered := example.Copy()
+	reordered.Packages[0], reordered.Packages[1] = reordered.Packages[1], reordered.Packages[0]
+	reordered.Relationships[0], reordered.Relationships[1] = reordered.Relationships[1], reordered.Relationships[0]
+	reordered.Files[0].Checksums[0].Value = strings.ToUpper(reordered.Files[0].Checksums[0].Value)
+
+	want := new(bytes.Buffer)
+	assert.NoError(t, json.WriteCanonical(doc, want))
+	got := new(bytes.Buffer)
+	assert.NoError(t, json.WriteCanonical(&reordered, got))
+	assert.Equal(t, want.String(), got.String())
+
+	// the document passed in is written in canonical form, but not changed
+	assert.Equal(t, v2common.ElementID("fromDoap-1"), reordered.Packages[0].PackageSPDXIdentifier)
+
+	// options written against json.Encoder apply to WriteCanonical
+	indented := new(bytes.Buffer)
+	assert.NoError(t, json.WriteCanonical(&reordered, indented, func(e *jsonenc.Encoder) { e.SetIndent("", " ") }))
+	assert.Contains(t, indented.String(), "\n \"spdxVersion\"")
+}
@@ -0,0 +1,54 @@
+// Package jsonld reads and writes SPDX 3.0 documents serialized as JSON-LD.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+package jsonld
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spdx/tools-golang/convert"
+	"github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdx/v3/v3_0"
+)
+
+// Read takes an io.Reader and returns a fully-parsed SPDX 3.0 Document
+// or an error if any error is encountered.
+func Read(content io.Reader) (*v3_0.Document, error) {
+	doc := v3_0.Document{}
+	err := ReadInto(content, &doc)
+	return &doc, err
+}
+
+// ReadInto takes an io.Reader, reads in the SPDX 3.0 JSON-LD document
+// and converts to the doc version
+func ReadInto(content io.Reader, doc common.AnyDocument) error {
+	if !convert.IsPtr(doc) {
+		return fmt.Errorf("doc to read into must be a pointer")
+	}
+
+	buf := new(bytes.Buffer)
+	_, err := buf.ReadFrom(content)
+	if err != nil {
+		return err
+	}
+
+	var data map[string]interface{}
+	err = json.Unmarshal(buf.Bytes(), &data)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := data["@context"]; !ok {
+		return fmt.Errorf("not a valid SPDX JSON-LD document: missing @context")
+	}
+
+	var v3doc v3_0.Document
+	err = json.Unmarshal(buf.Bytes(), &v3doc)
+	if err != nil {
+		return err
+	}
+
+	return convert.Document(v3doc, doc)
+}
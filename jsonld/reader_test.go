@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package jsonld
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx/v3/v3_0"
+)
+
+const exampleFile = "../examples/sample-docs/jsonld/SPDXJSONLDExample-v3.0.1.spdx.json"
+
+func Test_ReadExample(t *testing.T) {
+	file, err := os.Open(exampleFile)
+	require.NoError(t, err)
+	defer file.Close()
+
+	doc, err := Read(file)
+	require.NoError(t, err)
+
+	require.Equal(t, "https://spdx.org/spdxdocs/example-3.0.1/Document", doc.SpdxID)
+	require.Equal(t, "SPDX-Tools-v3.0", doc.Name)
+	require.Equal(t, []string{"https://spdx.org/spdxdocs/example-3.0.1/Package/glibc"}, doc.RootElementIDs)
+	require.Len(t, doc.Imports, 1)
+	require.Equal(t, "sha1", doc.Imports[0].VerifiedUsing[0].Algorithm)
+	require.Len(t, doc.Elements, 15)
+
+	require.NotNil(t, doc.CreationInfo)
+	require.Equal(t, "3.0.1", doc.CreationInfo.SpecVersion)
+
+	pkg, ok := doc.Find("https://spdx.org/spdxdocs/example-3.0.1/Package/glibc").(*v3_0.Package)
+	require.True(t, ok)
+	require.Equal(t, "2.11.1", pkg.PackageVersion)
+	require.Equal(t, "pkg:generic/glibc@2.11.1", pkg.PackageURL)
+	require.Equal(t, v3_0.PurposeLibrary, pkg.PrimaryPurpose)
+	require.Equal(t, "https://spdx.org/spdxdocs/example-3.0.1/Person/JaneDoe", pkg.SuppliedBy)
+	require.Len(t, pkg.VerifiedUsing, 2)
+	require.Equal(t, v3_0.TypePackageVerificationCode, pkg.VerifiedUsing[1].Type)
+	// all elements share the same creation info node
+	require.True(t, pkg.CreationInfo == doc.CreationInfo)
+
+	snippet, ok := doc.Find("https://spdx.org/spdxdocs/example-3.0.1/Snippet/foo.c-1").(*v3_0.Snippet)
+	require.True(t, ok)
+	require.Equal(t, 310, snippet.ByteRange.Begin)
+	require.Equal(t, 23, snippet.LineRange.End)
+
+	rel, ok := doc.Find("https://spdx.org/spdxdocs/example-3.0.1/Relationship/glibc-saxon").(*v3_0.LifecycleScopedRelationship)
+	require.True(t, ok)
+	require.Equal(t, v3_0.RelationshipDependsOn, rel.RelationshipType)
+	require.Equal(t, v3_0.LifecycleScopeBuild, rel.Scope)
+
+	build, ok := doc.Find("https://spdx.org/spdxdocs/example-3.0.1/Build/glibc").(*v3_0.Build)
+	require.True(t, ok)
+	require.Equal(t, "-O2", build.Parameters[0].Value)
+
+	unknown, ok := doc.Find("https://spdx.org/spdxdocs/example-3.0.1/Vulnerability/CVE-2016-1234").(*v3_0.UnknownElement)
+	require.True(t, ok)
+	require.Equal(t, "security_Vulnerability", unknown.Type())
+}
+
+func Test_ReadCompactedValues(t *testing.T) {
+	// single values of multi-valued properties may be written without an array,
+	// and the JSON-LD keywords may be used in place of their aliases
+	input := `{
+  "@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+  "@graph": [
+    {"@type": "SpdxDocument", "@id": "urn:doc", "rootElement": "urn:pkg",
+     "creationInfo": {"specVersion": "3.0.1", "created": "2024-01-01T00:00:00Z", "createdBy": "urn:agent"}},
+    {"@type": "software_Package", "@id": "urn:pkg", "name": "pkg"},
+    {"type": "Relationship", "spdxId": "urn:rel", "from": "urn:doc", "to": "urn:pkg", "relationshipType": "describes"}
+  ]
+}`
+
+	doc, err := Read(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Equal(t, "urn:doc", doc.SpdxID)
+	require.Equal(t, []string{"urn:pkg"}, doc.RootElementIDs)
+	require.Equal(t, []string{"urn:agent"}, doc.CreationInfo.CreatedBy)
+	require.Equal(t, "pkg", doc.Find("urn:pkg").Base().Name)
+	require.Equal(t, []string{"urn:pkg"}, doc.Find("urn:rel").(*v3_0.Relationship).To)
+}
+
+func Test_ReadErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "no context",
+			input: `{"@graph": []}`,
+		},
+		{
+			name:  "wrong context",
+			input: `{"@context": "https://schema.org", "@graph": []}`,
+		},
+		{
+			name:  "no SpdxDocument",
+			input: `{"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld", "@graph": [{"type": "software_Package", "spdxId": "urn:pkg"}]}`,
+		},
+		{
+			name:  "unknown creation info",
+			input: `{"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld", "@graph": [{"type": "SpdxDocument", "spdxId": "urn:doc", "creationInfo": "_:missing"}]}`,
+		},
+		{
+			name:  "element without spdxId",
+			input: `{"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld", "@graph": [{"type": "SpdxDocument", "spdxId": "urn:doc"}, {"type": "software_File", "name": "f"}]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(test.input))
+			require.Error(t, err)
+		})
+	}
+}
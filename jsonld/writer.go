@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package jsonld
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/spdx/tools-golang/convert"
+	"github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdx/v3/v3_0"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+type WriteOption func(*json.Encoder)
+
+func Indent(indent string) WriteOption {
+	return func(e *json.Encoder) {
+		e.SetIndent("", indent)
+	}
+}
+
+func EscapeHTML(escape bool) WriteOption {
+	return func(e *json.Encoder) {
+		e.SetEscapeHTML(escape)
+	}
+}
+
+// Write takes an SPDX Document and an io.Writer, and writes the document to the writer
+// as an SPDX 3.0 JSON-LD graph.
+func Write(doc common.AnyDocument, w io.Writer, opts ...WriteOption) error {
+	return write(doc, w, false, opts)
+}
+
+// WriteCanonical writes the document like Write, in canonical form, so that
+// documents with the same content are written as the same bytes. SPDX 2
+// documents are put into canonical form by spdxlib.Canonical before they are
+// converted, and the elements of SPDX 3 documents are sorted by SPDX ID. The
+// document passed in is not changed.
+func WriteCanonical(doc common.AnyDocument, w io.Writer, opts ...WriteOption) error {
+	return write(doc, w, true, opts)
+}
+
+func write(doc common.AnyDocument, w io.Writer, isCanonical bool, opts []WriteOption) error {
+	e := json.NewEncoder(w)
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	_, isV3 := convert.FromPtr(doc).(v3_0.Document)
+	if isCanonical && !isV3 {
+		var err error
+		if doc, err = spdxlib.Canonical(doc); err != nil {
+			return err
+		}
+	}
+
+	var v3doc v3_0.Document
+	if err := convert.Document(doc, &v3doc); err != nil {
+		return err
+	}
+	if isCanonical && isV3 {
+		elements := make([]v3_0.AnyElement, len(v3doc.Elements))
+		copy(elements, v3doc.Elements)
+		sort.SliceStable(elements, func(i, j int) bool {
+			a, b := elements[i], elements[j]
+			if a == nil || b == nil {
+				return b == nil && a != nil
+			}
+			return a.Base().SpdxID < b.Base().SpdxID
+		})
+		v3doc.Elements = elements
+	}
+
+	return e.Encode(v3doc)
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package jsonld
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+	"github.com/spdx/tools-golang/spdx/v3/v3_0"
+)
+
+func Test_WriteRoundTrip(t *testing.T) {
+	file, err := os.Open(exampleFile)
+	require.NoError(t, err)
+	defer file.Close()
+
+	want, err := Read(file)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(want, buf, Indent("  ")))
+
+	got, err := Read(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("document changed after writing and reading back: %s", diff)
+	}
+}
+
+func Test_WriteCreationInfoNodes(t *testing.T) {
+	shared := &v3_0.CreationInfo{SpecVersion: v3_0.Version, Created: "2024-01-01T00:00:00Z", CreatedBy: []string{"urn:agent"}}
+	other := &v3_0.CreationInfo{SpecVersion: v3_0.Version, Created: "2024-02-01T00:00:00Z", CreatedBy: []string{"urn:agent"}}
+
+	doc := v3_0.Document{}
+	doc.SpdxID = "urn:doc"
+	doc.CreationInfo = shared
+
+	pkg := &v3_0.Package{PackageVersion: "1.0"}
+	pkg.SpdxID = "urn:pkg"
+	pkg.CreationInfo = shared
+
+	file := &v3_0.File{}
+	file.SpdxID = "urn:file"
+	file.CreationInfo = other
+
+	doc.Elements = []v3_0.AnyElement{pkg, file}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(doc, buf))
+
+	var out struct {
+		Context string                   `json:"@context"`
+		Graph   []map[string]interface{} `json:"@graph"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+
+	require.Equal(t, v3_0.Context, out.Context)
+	require.Len(t, out.Graph, 5)
+	require.Equal(t, "CreationInfo", out.Graph[0]["type"])
+	require.Equal(t, "_:creationinfo", out.Graph[0]["@id"])
+	require.Equal(t, "_:creationinfo1", out.Graph[1]["@id"])
+	require.Equal(t, "SpdxDocument", out.Graph[2]["type"])
+	require.Equal(t, []interface{}{"urn:pkg", "urn:file"}, out.Graph[2]["element"])
+	require.Equal(t, "software_Package", out.Graph[3]["type"])
+	require.Equal(t, "_:creationinfo", out.Graph[3]["creationInfo"])
+	require.Equal(t, "_:creationinfo1", out.Graph[4]["creationInfo"])
+
+	got, err := Read(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.True(t, got.CreationInfo == got.Elements[0].Base().CreationInfo)
+	require.Equal(t, "2024-02-01T00:00:00Z", got.Elements[1].Base().CreationInfo.Created)
+}
+
+func Test_WriteV2Document(t *testing.T) {
+	src := example.Copy()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(&src, buf))
+
+	var got v2_3.Document
+	require.NoError(t, ReadInto(bytes.NewReader(buf.Bytes()), &got))
+
+	require.Equal(t, src.DocumentNamespace, got.DocumentNamespace)
+	require.Len(t, got.Packages, len(src.Packages))
+	require.Len(t, got.Files, len(src.Files))
+	require.Equal(t, src.Packages[0].PackageLicenseConcluded, got.Packages[0].PackageLicenseConcluded)
+}
+
+func Test_WriteCanonical(t *testing.T) {
+	file, err := os.Open(exampleFile)
+	require.NoError(t, err)
+	defer file.Close()
+
+	doc, err := Read(file)
+	require.NoError(t, err)
+	reversed := *doc
+	reversed.Elements = make([]v3_0.AnyElement, len(doc.Elements))
+	for i, e := range doc.Elements {
+		reversed.Elements[len(doc.Elements)-1-i] = e
+	}
+
+	// options written against json.Encoder apply to WriteCanonical
+	indent := func(e *json.Encoder) { e.SetIndent("", " ") }
+	want := &bytes.Buffer{}
+	require.NoError(t, WriteCanonical(doc, want, indent))
+	got := &bytes.Buffer{}
+	require.NoError(t, WriteCanonical(&reversed, got, indent))
+	require.Equal(t, want.String(), got.String())
+	require.Contains(t, got.String(), "\n \"@context\"")
+}
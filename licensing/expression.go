@@ -0,0 +1,197 @@
+// Package licensing parses SPDX license expressions, as defined in Annex D
+// of the SPDX specification, into an abstract syntax tree.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+package licensing
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	licenseRefPrefix  = "LicenseRef-"
+	documentRefPrefix = "DocumentRef-"
+	additionRefPrefix = "AdditionRef-"
+)
+
+// Expression is a node of a parsed license expression: a *License,
+// *LicenseRef, *With, *And, *Or or Special value.
+type Expression interface {
+	// String renders the expression in canonical form: operators in upper
+	// case, single spaces and only the parentheses required by precedence.
+	String() string
+
+	expression()
+}
+
+// License is a license from the SPDX License List, e.g. "MIT" or "GPL-2.0+"
+type License struct {
+	// ID is the short identifier, without the "+" operator
+	ID string
+
+	// OrLater is true if the license is followed by the "+" operator
+	OrLater bool
+}
+
+func (l *License) String() string {
+	if l.OrLater {
+		return l.ID + "+"
+	}
+	return l.ID
+}
+
+// LicenseRef is a reference to a license not on the SPDX License List,
+// e.g. "LicenseRef-1" or "DocumentRef-other:LicenseRef-1"
+type LicenseRef struct {
+	// DocumentRef is the ID of the external document defining the license,
+	// without the "DocumentRef-" prefix; empty if defined in this document
+	DocumentRef string
+
+	// LicenseRef is the ID of the license, including the "LicenseRef-" prefix
+	LicenseRef string
+}
+
+func (r *LicenseRef) String() string {
+	if r.DocumentRef != "" {
+		return documentRefPrefix + r.DocumentRef + ":" + r.LicenseRef
+	}
+	return r.LicenseRef
+}
+
+// With is a license with an exception or addition, e.g. "GPL-2.0-or-later WITH Classpath-exception-2.0"
+type With struct {
+	// License is a *License or *LicenseRef
+	License Expression
+
+	// Exception is a license exception ID, or an AdditionRef- reference
+	// which may be prefixed by "DocumentRef-<id>:"
+	Exception string
+}
+
+func (w *With) String() string {
+	return w.License.String() + " WITH " + w.Exception
+}
+
+// And is the conjunction of two expressions: both licenses apply
+type And struct {
+	Left  Expression
+	Right Expression
+}
+
+func (a *And) String() string {
+	return group(a.Left, isOr) + " AND " + group(a.Right, isOr)
+}
+
+// Or is the disjunction of two expressions: a choice of licenses
+type Or struct {
+	Left  Expression
+	Right Expression
+}
+
+func (o *Or) String() string {
+	return o.Left.String() + " OR " + o.Right.String()
+}
+
+// Special is one of the values allowed in place of a license expression
+type Special string
+
+const (
+	// None indicates that there is no license
+	None Special = "NONE"
+
+	// NoAssertion indicates that no assertion is made about the license
+	NoAssertion Special = "NOASSERTION"
+)
+
+func (s Special) String() string {
+	return string(s)
+}
+
+func (*License) expression()    {}
+func (*LicenseRef) expression() {}
+func (*With) expression()       {}
+func (*And) expression()        {}
+func (*Or) expression()         {}
+func (Special) expression()     {}
+
+func isOr(e Expression) bool {
+	_, ok := e.(*Or)
+	return ok
+}
+
+// group renders the expression, in parentheses if needed
+func group(e Expression, needed func(Expression) bool) string {
+	if needed(e) {
+		return "(" + e.String() + ")"
+	}
+	return e.String()
+}
+
+// Walk calls fn for the expression and each of its sub-expressions, depth
+// first, in the order they appear. The children of an expression are not
+// visited if fn returns false.
+func Walk(e Expression, fn func(Expression) bool) {
+	if e == nil || !fn(e) {
+		return
+	}
+	switch e := e.(type) {
+	case *With:
+		Walk(e.License, fn)
+	case *And:
+		Walk(e.Left, fn)
+		Walk(e.Right, fn)
+	case *Or:
+		Walk(e.Left, fn)
+		Walk(e.Right, fn)
+	}
+}
+
+// Licenses returns the sorted, unique licenses and license references of
+// the expression, rendered without the "+" operator or exceptions
+func Licenses(e Expression) []string {
+	seen := map[string]bool{}
+	Walk(e, func(e Expression) bool {
+		switch e := e.(type) {
+		case *License:
+			seen[e.ID] = true
+		case *LicenseRef:
+			seen[e.String()] = true
+		}
+		return true
+	})
+
+	ids := make([]string, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Exceptions returns the sorted, unique exceptions of the expression
+func Exceptions(e Expression) []string {
+	seen := map[string]bool{}
+	Walk(e, func(e Expression) bool {
+		if w, ok := e.(*With); ok {
+			seen[w.Exception] = true
+		}
+		return true
+	})
+
+	ids := make([]string, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// IsLicenseRef returns true if the ID is a license reference, with or without a document reference
+func IsLicenseRef(id string) bool {
+	if strings.HasPrefix(id, documentRefPrefix) {
+		if i := strings.Index(id, ":"); i >= 0 {
+			id = id[i+1:]
+		}
+	}
+	return strings.HasPrefix(id, licenseRefPrefix)
+}
@@ -0,0 +1,235 @@
+// Package licenselist provides the SPDX License List: the licenses and license
+// exceptions with short identifiers defined at https://spdx.org/licenses/.
+// A copy of the official license-list-data JSON is embedded, and a newer
+// version can be loaded at runtime.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+package licenselist
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//go:embed data/licenses.json
+var embeddedLicenses []byte
+
+//go:embed data/exceptions.json
+var embeddedExceptions []byte
+
+// License is an entry of the license list
+type License struct {
+	// ID is the short identifier, e.g. "Apache-2.0"
+	ID string `json:"licenseId"`
+
+	// Name is the full name, e.g. "Apache License 2.0"
+	Name string `json:"name"`
+
+	// Reference is the URL of the license page on spdx.org
+	Reference string `json:"reference"`
+
+	// DetailsURL is the URL of the JSON document with the license text
+	DetailsURL string `json:"detailsUrl"`
+
+	// SeeAlso lists other URLs of the license text
+	SeeAlso []string `json:"seeAlso"`
+
+	// IsOSIApproved is true if the license is approved by the Open Source Initiative
+	IsOSIApproved bool `json:"isOsiApproved"`
+
+	// IsFSFLibre is true if the Free Software Foundation considers the license free
+	IsFSFLibre bool `json:"isFsfLibre"`
+
+	// IsDeprecated is true if the ID should no longer be used, e.g. "GPL-2.0"
+	IsDeprecated bool `json:"isDeprecatedLicenseId"`
+}
+
+// Exception is an entry of the license exception list
+type Exception struct {
+	// ID is the short identifier, e.g. "Classpath-exception-2.0"
+	ID string `json:"licenseExceptionId"`
+
+	// Name is the full name, e.g. "Classpath exception 2.0"
+	Name string `json:"name"`
+
+	// Reference is the URL of the exception page on spdx.org
+	Reference string `json:"reference"`
+
+	// DetailsURL is the URL of the JSON document with the exception text
+	DetailsURL string `json:"detailsUrl"`
+
+	// SeeAlso lists other URLs of the exception text
+	SeeAlso []string `json:"seeAlso"`
+
+	// IsDeprecated is true if the ID should no longer be used
+	IsDeprecated bool `json:"isDeprecatedLicenseId"`
+}
+
+// List is a version of the license list. Lookups by ID are case-insensitive,
+// as license IDs are in license expressions.
+type List struct {
+	// Version is the license list version, e.g. "3.24.0"
+	Version string
+
+	// ReleaseDate is the date the list version was published, e.g. "2024-05-22"
+	ReleaseDate string
+
+	// Licenses and Exceptions are sorted by ID
+	Licenses   []License
+	Exceptions []Exception
+
+	licenses   map[string]*License
+	exceptions map[string]*Exception
+}
+
+type licensesFile struct {
+	Version     string    `json:"licenseListVersion"`
+	ReleaseDate string    `json:"releaseDate"`
+	Licenses    []License `json:"licenses"`
+}
+
+type exceptionsFile struct {
+	Version     string      `json:"licenseListVersion"`
+	ReleaseDate string      `json:"releaseDate"`
+	Exceptions  []Exception `json:"exceptions"`
+}
+
+// Read reads a license list from the licenses.json and exceptions.json files
+// of the license-list-data repository (https://github.com/spdx/license-list-data/tree/main/json).
+// The exceptions reader may be nil, in which case the list has no exceptions.
+func Read(licenses io.Reader, exceptions io.Reader) (*List, error) {
+	var lf licensesFile
+	if err := json.NewDecoder(licenses).Decode(&lf); err != nil {
+		return nil, fmt.Errorf("failed to read license list: %w", err)
+	}
+	if lf.Version == "" {
+		return nil, fmt.Errorf("license list does not have a licenseListVersion")
+	}
+
+	var ef exceptionsFile
+	if exceptions != nil {
+		if err := json.NewDecoder(exceptions).Decode(&ef); err != nil {
+			return nil, fmt.Errorf("failed to read license exception list: %w", err)
+		}
+		if ef.Version != "" && ef.Version != lf.Version {
+			return nil, fmt.Errorf("license list version %s does not match exception list version %s", lf.Version, ef.Version)
+		}
+	}
+
+	l := &List{
+		Version:     lf.Version,
+		ReleaseDate: lf.ReleaseDate,
+		Licenses:    lf.Licenses,
+		Exceptions:  ef.Exceptions,
+		licenses:    map[string]*License{},
+		exceptions:  map[string]*Exception{},
+	}
+	sort.Slice(l.Licenses, func(i, j int) bool { return l.Licenses[i].ID < l.Licenses[j].ID })
+	sort.Slice(l.Exceptions, func(i, j int) bool { return l.Exceptions[i].ID < l.Exceptions[j].ID })
+	for i := range l.Licenses {
+		l.licenses[strings.ToLower(l.Licenses[i].ID)] = &l.Licenses[i]
+	}
+	for i := range l.Exceptions {
+		l.exceptions[strings.ToLower(l.Exceptions[i].ID)] = &l.Exceptions[i]
+	}
+	return l, nil
+}
+
+// Load reads a license list from local copies of licenses.json and
+// exceptions.json; exceptionsPath may be empty
+func Load(licensesPath string, exceptionsPath string) (*List, error) {
+	licenses, err := os.Open(licensesPath)
+	if err != nil {
+		return nil, err
+	}
+	defer licenses.Close()
+
+	if exceptionsPath == "" {
+		return Read(licenses, nil)
+	}
+
+	exceptions, err := os.Open(exceptionsPath)
+	if err != nil {
+		return nil, err
+	}
+	defer exceptions.Close()
+
+	return Read(licenses, exceptions)
+}
+
+// License returns the license with the given ID, ignoring case
+func (l *List) License(id string) (*License, bool) {
+	license, ok := l.licenses[strings.ToLower(id)]
+	return license, ok
+}
+
+// Exception returns the exception with the given ID, ignoring case
+func (l *List) Exception(id string) (*Exception, bool) {
+	exception, ok := l.exceptions[strings.ToLower(id)]
+	return exception, ok
+}
+
+// LicenseIDs returns the IDs of all licenses, including deprecated ones, sorted
+func (l *List) LicenseIDs() []string {
+	ids := make([]string, 0, len(l.Licenses))
+	for _, license := range l.Licenses {
+		ids = append(ids, license.ID)
+	}
+	return ids
+}
+
+// ExceptionIDs returns the IDs of all exceptions, including deprecated ones, sorted
+func (l *List) ExceptionIDs() []string {
+	ids := make([]string, 0, len(l.Exceptions))
+	for _, exception := range l.Exceptions {
+		ids = append(ids, exception.ID)
+	}
+	return ids
+}
+
+var (
+	embeddedOnce sync.Once
+	embedded     *List
+
+	defaultLock sync.RWMutex
+	defaultList *List
+)
+
+// Embedded returns the license list embedded in this package
+func Embedded() *List {
+	embeddedOnce.Do(func() {
+		l, err := Read(bytes.NewReader(embeddedLicenses), bytes.NewReader(embeddedExceptions))
+		if err != nil {
+			// the embedded data is part of the source, so this can only be a build problem
+			panic(err)
+		}
+		embedded = l
+	})
+	return embedded
+}
+
+// Default returns the list used by the other tools-golang packages: the
+// embedded list, unless another one has been set with SetDefault
+func Default() *List {
+	defaultLock.RLock()
+	l := defaultList
+	defaultLock.RUnlock()
+	if l != nil {
+		return l
+	}
+	return Embedded()
+}
+
+// SetDefault replaces the list returned by Default, e.g. with a newer
+// version read with Load. Passing nil restores the embedded list.
+func SetDefault(l *List) {
+	defaultLock.Lock()
+	defer defaultLock.Unlock()
+	defaultList = l
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package licenselist
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Embedded(t *testing.T) {
+	list := Embedded()
+	require.Equal(t, "3.24.0", list.Version)
+	require.NotEmpty(t, list.ReleaseDate)
+
+	apache, ok := list.License("apache-2.0")
+	require.True(t, ok)
+	require.Equal(t, "Apache-2.0", apache.ID)
+	require.Equal(t, "Apache License 2.0", apache.Name)
+	require.True(t, apache.IsOSIApproved)
+	require.True(t, apache.IsFSFLibre)
+	require.False(t, apache.IsDeprecated)
+	require.NotEmpty(t, apache.SeeAlso)
+
+	gpl, ok := list.License("GPL-2.0")
+	require.True(t, ok)
+	require.True(t, gpl.IsDeprecated)
+
+	classpath, ok := list.Exception("Classpath-exception-2.0")
+	require.True(t, ok)
+	require.Equal(t, "Classpath exception 2.0", classpath.Name)
+
+	_, ok = list.License("Classpath-exception-2.0")
+	require.False(t, ok)
+	_, ok = list.License("LicenseRef-1")
+	require.False(t, ok)
+
+	for _, id := range append(list.LicenseIDs(), list.ExceptionIDs()...) {
+		require.NotEmpty(t, id)
+	}
+}
+
+func Test_LoadAndSetDefault(t *testing.T) {
+	dir := t.TempDir()
+	licenses := filepath.Join(dir, "licenses.json")
+	require.NoError(t, os.WriteFile(licenses, []byte(`{
+  "licenseListVersion": "9.99",
+  "releaseDate": "2099-01-01",
+  "licenses": [
+    {"licenseId": "New-License-1.0", "name": "New License", "isOsiApproved": true, "seeAlso": ["https://example.com"]},
+    {"licenseId": "MIT", "name": "MIT License", "isOsiApproved": true}
+  ]
+}`), 0600))
+
+	list, err := Load(licenses, "")
+	require.NoError(t, err)
+	require.Equal(t, "9.99", list.Version)
+	require.Equal(t, []string{"MIT", "New-License-1.0"}, list.LicenseIDs())
+	require.Empty(t, list.ExceptionIDs())
+
+	SetDefault(list)
+	require.Equal(t, "9.99", Default().Version)
+	SetDefault(nil)
+	require.Equal(t, Embedded(), Default())
+}
+
+func Test_ReadErrors(t *testing.T) {
+	_, err := Read(strings.NewReader(`{"licenses": []}`), nil)
+	require.Error(t, err)
+
+	_, err = Read(strings.NewReader(`{"licenseListVersion": "3.1", "licenses": []}`), strings.NewReader(`{"licenseListVersion": "3.2", "exceptions": []}`))
+	require.Error(t, err)
+
+	_, err = Read(strings.NewReader(`not json`), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,295 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package licensing
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError describes a syntax error in a license expression
+type ParseError struct {
+	// Expression is the text being parsed
+	Expression string
+
+	// Offset is the byte offset of the error in Expression
+	Offset int
+
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid license expression %q at offset %d: %s", e.Expression, e.Offset, e.Message)
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenOpen
+	tokenClose
+	tokenPlus
+	tokenAnd
+	tokenOr
+	tokenWith
+	tokenID
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+}
+
+func (t token) describe() string {
+	if t.kind == tokenEnd {
+		return "end of expression"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+func isIDChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '.' || c == ':'
+}
+
+// tokenize splits the expression into tokens; operators must be upper case,
+// other casings are license IDs
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokenOpen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokenClose, ")", i})
+			i++
+		case c == '+':
+			tokens = append(tokens, token{tokenPlus, "+", i})
+			i++
+		case isIDChar(c):
+			start := i
+			for i < len(s) && isIDChar(s[i]) {
+				i++
+			}
+			text := s[start:i]
+			kind := tokenID
+			// the operators are case sensitive: "and" is a license ID
+			switch text {
+			case "AND":
+				kind = tokenAnd
+			case "OR":
+				kind = tokenOr
+			case "WITH":
+				kind = tokenWith
+			}
+			tokens = append(tokens, token{kind, text, start})
+		default:
+			return nil, &ParseError{Expression: s, Offset: i, Message: fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+	return append(tokens, token{tokenEnd, "", len(s)}), nil
+}
+
+type parser struct {
+	text   string
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEnd {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...interface{}) error {
+	return &ParseError{Expression: p.text, Offset: t.offset, Message: fmt.Sprintf(format, args...)}
+}
+
+// Parse parses an SPDX license expression. The operators have the precedence
+// "+", then WITH, then AND, then OR; AND and OR are left-associative. The
+// values NONE and NOASSERTION are accepted as the whole expression.
+func Parse(expression string) (Expression, error) {
+	switch strings.TrimSpace(expression) {
+	case string(None):
+		return None, nil
+	case string(NoAssertion):
+		return NoAssertion, nil
+	}
+
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{text: expression, tokens: tokens}
+	if p.peek().kind == tokenEnd {
+		return nil, p.errorf(p.peek(), "empty expression")
+	}
+
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEnd {
+		return nil, p.errorf(t, "unexpected %s", t.describe())
+	}
+	return e, nil
+}
+
+// MustParse is like Parse but panics if the expression is invalid
+func MustParse(expression string) Expression {
+	e, err := Parse(expression)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Canonical parses the expression and renders it in canonical form
+func Canonical(expression string) (string, error) {
+	e, err := Parse(expression)
+	if err != nil {
+		return "", err
+	}
+	return e.String(), nil
+}
+
+func (p *parser) parseOr() (Expression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expression, error) {
+	left, err := p.parseWith()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseWith()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseWith() (Expression, error) {
+	if p.peek().kind == tokenOpen {
+		p.next()
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokenClose {
+			return nil, p.errorf(t, "expected \")\" but found %s", t.describe())
+		}
+		if t := p.peek(); t.kind == tokenWith {
+			return nil, p.errorf(t, "WITH must follow a license, not a parenthesized expression")
+		}
+		return e, nil
+	}
+
+	license, err := p.parseSimple()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenWith {
+		return license, nil
+	}
+
+	p.next()
+	t := p.next()
+	if t.kind != tokenID {
+		return nil, p.errorf(t, "expected an exception after WITH but found %s", t.describe())
+	}
+	if err := p.checkException(t); err != nil {
+		return nil, err
+	}
+	return &With{License: license, Exception: t.text}, nil
+}
+
+// parseSimple parses a license ID, optionally followed by "+", or a license reference
+func (p *parser) parseSimple() (Expression, error) {
+	t := p.next()
+	if t.kind != tokenID {
+		return nil, p.errorf(t, "expected a license but found %s", t.describe())
+	}
+
+	if strings.HasPrefix(t.text, documentRefPrefix) || strings.HasPrefix(t.text, licenseRefPrefix) {
+		ref, err := p.licenseRef(t)
+		if err != nil {
+			return nil, err
+		}
+		if plus := p.peek(); plus.kind == tokenPlus {
+			return nil, p.errorf(plus, "\"+\" cannot follow a license reference")
+		}
+		return ref, nil
+	}
+
+	if strings.Contains(t.text, ":") {
+		return nil, p.errorf(t, "invalid license ID %q", t.text)
+	}
+	license := &License{ID: t.text}
+	if p.peek().kind == tokenPlus {
+		p.next()
+		license.OrLater = true
+	}
+	return license, nil
+}
+
+func (p *parser) licenseRef(t token) (*LicenseRef, error) {
+	ref := &LicenseRef{LicenseRef: t.text}
+	if strings.HasPrefix(t.text, documentRefPrefix) {
+		parts := strings.SplitN(strings.TrimPrefix(t.text, documentRefPrefix), ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, p.errorf(t, "%q must have the form DocumentRef-<id>:LicenseRef-<id>", t.text)
+		}
+		ref.DocumentRef, ref.LicenseRef = parts[0], parts[1]
+	}
+	if !strings.HasPrefix(ref.LicenseRef, licenseRefPrefix) || len(ref.LicenseRef) == len(licenseRefPrefix) || strings.Contains(ref.LicenseRef, ":") {
+		return nil, p.errorf(t, "invalid license reference %q", t.text)
+	}
+	return ref, nil
+}
+
+// checkException checks the form of an exception ID or addition reference
+func (p *parser) checkException(t token) error {
+	id := t.text
+	if strings.HasPrefix(id, documentRefPrefix) {
+		parts := strings.SplitN(strings.TrimPrefix(id, documentRefPrefix), ":", 2)
+		if len(parts) != 2 || parts[0] == "" || !strings.HasPrefix(parts[1], additionRefPrefix) {
+			return p.errorf(t, "%q must have the form DocumentRef-<id>:AdditionRef-<id>", id)
+		}
+		id = parts[1]
+	}
+	if strings.Contains(id, ":") {
+		return p.errorf(t, "invalid exception %q", t.text)
+	}
+	if plus := p.peek(); plus.kind == tokenPlus {
+		return p.errorf(plus, "\"+\" cannot follow an exception")
+	}
+	return nil
+}
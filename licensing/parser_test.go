@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package licensing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   Expression
+		canonical  string
+	}{
+		{
+			expression: "MIT",
+			expected:   &License{ID: "MIT"},
+			canonical:  "MIT",
+		},
+		{
+			expression: "GPL-2.0+",
+			expected:   &License{ID: "GPL-2.0", OrLater: true},
+			canonical:  "GPL-2.0+",
+		},
+		{
+			expression: "LicenseRef-1",
+			expected:   &LicenseRef{LicenseRef: "LicenseRef-1"},
+			canonical:  "LicenseRef-1",
+		},
+		{
+			expression: "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2",
+			expected:   &LicenseRef{DocumentRef: "spdx-tool-1.2", LicenseRef: "LicenseRef-MIT-Style-2"},
+			canonical:  "DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2",
+		},
+		{
+			expression: "GPL-2.0-or-later WITH Classpath-exception-2.0",
+			expected:   &With{License: &License{ID: "GPL-2.0-or-later"}, Exception: "Classpath-exception-2.0"},
+			canonical:  "GPL-2.0-or-later WITH Classpath-exception-2.0",
+		},
+		{
+			expression: "LicenseRef-1 WITH DocumentRef-x:AdditionRef-2",
+			expected:   &With{License: &LicenseRef{LicenseRef: "LicenseRef-1"}, Exception: "DocumentRef-x:AdditionRef-2"},
+			canonical:  "LicenseRef-1 WITH DocumentRef-x:AdditionRef-2",
+		},
+		{
+			// AND binds tighter than OR
+			expression: "MIT OR Apache-2.0 AND BSD-3-Clause",
+			expected: &Or{
+				Left:  &License{ID: "MIT"},
+				Right: &And{Left: &License{ID: "Apache-2.0"}, Right: &License{ID: "BSD-3-Clause"}},
+			},
+			canonical: "MIT OR Apache-2.0 AND BSD-3-Clause",
+		},
+		{
+			expression: "(MIT OR Apache-2.0) AND BSD-3-Clause",
+			expected: &And{
+				Left:  &Or{Left: &License{ID: "MIT"}, Right: &License{ID: "Apache-2.0"}},
+				Right: &License{ID: "BSD-3-Clause"},
+			},
+			canonical: "(MIT OR Apache-2.0) AND BSD-3-Clause",
+		},
+		{
+			// WITH binds tighter than AND
+			expression: "GPL-2.0-only WITH Classpath-exception-2.0 AND MIT",
+			expected: &And{
+				Left:  &With{License: &License{ID: "GPL-2.0-only"}, Exception: "Classpath-exception-2.0"},
+				Right: &License{ID: "MIT"},
+			},
+			canonical: "GPL-2.0-only WITH Classpath-exception-2.0 AND MIT",
+		},
+		{
+			// operators are left-associative
+			expression: "MIT AND ISC AND Zlib",
+			expected: &And{
+				Left:  &And{Left: &License{ID: "MIT"}, Right: &License{ID: "ISC"}},
+				Right: &License{ID: "Zlib"},
+			},
+			canonical: "MIT AND ISC AND Zlib",
+		},
+		{
+			// extra parentheses and spacing are normalized
+			expression: " ((MIT)  OR (Apache-2.0 AND ISC))",
+			expected: &Or{
+				Left:  &License{ID: "MIT"},
+				Right: &And{Left: &License{ID: "Apache-2.0"}, Right: &License{ID: "ISC"}},
+			},
+			canonical: "MIT OR Apache-2.0 AND ISC",
+		},
+		{
+			expression: "NOASSERTION",
+			expected:   NoAssertion,
+			canonical:  "NOASSERTION",
+		},
+		{
+			expression: "NONE",
+			expected:   None,
+			canonical:  "NONE",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			e, err := Parse(test.expression)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, e)
+			require.Equal(t, test.canonical, e.String())
+
+			// the canonical form parses to the same tree
+			again, err := Parse(e.String())
+			require.NoError(t, err)
+			require.Equal(t, e.String(), again.String())
+		})
+	}
+}
+
+func Test_ParseErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		offset     int
+	}{
+		{"", 0},
+		{"MIT AND", 7},
+		{"MIT OR OR ISC", 7},
+		{"(MIT", 4},
+		{"MIT)", 3},
+		{"MIT ISC", 4},
+		{"LicenseRef-1+", 12},
+		{"DocumentRef-x", 0},
+		{"DocumentRef-:LicenseRef-1", 0},
+		{"MIT WITH", 8},
+		{"(MIT) WITH Classpath-exception-2.0", 6},
+		{"MIT WITH DocumentRef-x:LicenseRef-1", 9},
+		{"MIT / ISC", 4},
+		// the operators are case sensitive, so these are two license IDs in a row
+		{"MIT and Apache-2.0", 4},
+		{"MIT or Apache-2.0", 4},
+		{"GPL-2.0-only with Classpath-exception-2.0", 13},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			_, err := Parse(test.expression)
+			require.Error(t, err)
+			parseErr, ok := err.(*ParseError)
+			require.True(t, ok)
+			require.Equal(t, test.offset, parseErr.Offset)
+		})
+	}
+}
+
+func Test_Licenses(t *testing.T) {
+	e := MustParse("(GPL-2.0+ WITH Bison-exception-2.2 OR MIT) AND DocumentRef-x:LicenseRef-1 AND MIT")
+	require.Equal(t, []string{"DocumentRef-x:LicenseRef-1", "GPL-2.0", "MIT"}, Licenses(e))
+	require.Equal(t, []string{"Bison-exception-2.2"}, Exceptions(e))
+	require.Equal(t, "(GPL-2.0+ WITH Bison-exception-2.2 OR MIT) AND DocumentRef-x:LicenseRef-1 AND MIT", e.String())
+}
+
+func Test_IsLicenseRef(t *testing.T) {
+	require.True(t, IsLicenseRef("LicenseRef-1"))
+	require.True(t, IsLicenseRef("DocumentRef-x:LicenseRef-1"))
+	require.False(t, IsLicenseRef("MIT"))
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package licensing
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// Policy decides which licenses are acceptable. IDs are compared case-insensitively.
+type Policy struct {
+	// Allow lists the acceptable licenses, exceptions and "<license> WITH <exception>"
+	// combinations. If it is empty, every license that is not denied is acceptable.
+	Allow []string
+
+	// Deny lists the unacceptable licenses and exceptions. It takes precedence over Allow.
+	Deny []string
+}
+
+// Result is the outcome of evaluating a license expression against a Policy
+type Result struct {
+	// Satisfied is true if some choice of licenses in the expression is acceptable
+	Satisfied bool
+
+	// Choice is the expression with each OR replaced by the chosen alternative,
+	// the left-most acceptable one; nil if the policy is not satisfied
+	Choice Expression
+
+	// Violations lists the sorted, unique licenses and exceptions that prevented
+	// the policy from being satisfied; empty if it is satisfied
+	Violations []string
+}
+
+// Evaluate determines whether the licensee can choose licenses from the
+// expression that are all acceptable. A license with the "+" operator is
+// acceptable if either the license or the license with "+" is allowed, and
+// neither is denied. A license with an exception is acceptable if the
+// combination is allowed, or if the license is acceptable and the exception
+// is not denied; a denied license or exception is not acceptable in any
+// combination.
+func (p *Policy) Evaluate(e Expression) Result {
+	ok, choice, violations := p.evaluate(e)
+	if ok {
+		return Result{Satisfied: true, Choice: choice}
+	}
+	return Result{Violations: unique(violations)}
+}
+
+// EvaluateString parses the expression and evaluates it
+func (p *Policy) EvaluateString(expression string) (Result, error) {
+	e, err := Parse(expression)
+	if err != nil {
+		return Result{}, err
+	}
+	return p.Evaluate(e), nil
+}
+
+func (p *Policy) evaluate(e Expression) (bool, Expression, []string) {
+	switch e := e.(type) {
+	case *Or:
+		lok, lchoice, lviolations := p.evaluate(e.Left)
+		if lok {
+			return true, lchoice, nil
+		}
+		rok, rchoice, rviolations := p.evaluate(e.Right)
+		if rok {
+			return true, rchoice, nil
+		}
+		return false, nil, append(lviolations, rviolations...)
+	case *And:
+		lok, lchoice, lviolations := p.evaluate(e.Left)
+		rok, rchoice, rviolations := p.evaluate(e.Right)
+		if lok && rok {
+			return true, &And{Left: lchoice, Right: rchoice}, nil
+		}
+		return false, nil, append(lviolations, rviolations...)
+	case *With:
+		denied := p.denied(e.String()) || p.denied(e.Exception) || p.deniedLicense(e.License)
+		if contains(p.Allow, e.String()) && !denied {
+			return true, e, nil
+		}
+		var violations []string
+		if ok, _, v := p.evaluate(e.License); !ok {
+			violations = append(violations, v...)
+		}
+		if p.denied(e.Exception) || p.denied(e.String()) {
+			violations = append(violations, e.Exception)
+		}
+		return len(violations) == 0, e, violations
+	case *License:
+		ids := []string{e.String()}
+		if e.OrLater {
+			ids = append(ids, e.ID)
+		}
+		allowed := false
+		for _, id := range ids {
+			if p.denied(id) {
+				return false, nil, []string{e.String()}
+			}
+			allowed = allowed || p.allowed(id)
+		}
+		if !allowed {
+			return false, nil, []string{e.String()}
+		}
+		return true, e, nil
+	case nil:
+		return false, nil, nil
+	}
+
+	id := e.String()
+	if p.denied(id) || !p.allowed(id) {
+		return false, nil, []string{id}
+	}
+	return true, e, nil
+}
+
+func (p *Policy) allowed(id string) bool {
+	return len(p.Allow) == 0 || contains(p.Allow, id)
+}
+
+func (p *Policy) denied(id string) bool {
+	return contains(p.Deny, id)
+}
+
+// deniedLicense reports whether the license of a WITH expression is denied
+func (p *Policy) deniedLicense(e Expression) bool {
+	if e == nil {
+		return false
+	}
+	if l, ok := e.(*License); ok && l.OrLater && p.denied(l.ID) {
+		return true
+	}
+	return p.denied(e.String())
+}
+
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if strings.EqualFold(i, id) {
+			return true
+		}
+	}
+	return false
+}
+
+func unique(ids []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Verdict is the result of evaluating the concluded license of one Package or File
+type Verdict struct {
+	// ElementID is the SPDX identifier of the Package or File
+	ElementID common.ElementID
+
+	// Name is the package or file name
+	Name string
+
+	// Expression is the concluded license being evaluated
+	Expression string
+
+	Result Result
+
+	// Err is set if Expression is not a valid license expression
+	Err error
+}
+
+// EvaluateDocument evaluates the concluded license of every Package and File
+// in the document, including the files of each package. The verdicts are in
+// document order: each package followed by its files, then the other files.
+// Packages and files without a concluded license are skipped.
+func (p *Policy) EvaluateDocument(doc *spdx.Document) []Verdict {
+	var verdicts []Verdict
+	seen := map[common.ElementID]bool{}
+
+	add := func(id common.ElementID, name string, expression string) {
+		if seen[id] || expression == "" {
+			return
+		}
+		seen[id] = true
+		v := Verdict{ElementID: id, Name: name, Expression: expression}
+		v.Result, v.Err = p.EvaluateString(expression)
+		verdicts = append(verdicts, v)
+	}
+
+	for _, pkg := range doc.Packages {
+		if pkg == nil {
+			continue
+		}
+		add(pkg.PackageSPDXIdentifier, pkg.PackageName, pkg.PackageLicenseConcluded)
+		for _, f := range pkg.Files {
+			if f != nil {
+				add(f.FileSPDXIdentifier, f.FileName, f.LicenseConcluded)
+			}
+		}
+	}
+	for _, f := range doc.Files {
+		if f != nil {
+			add(f.FileSPDXIdentifier, f.FileName, f.LicenseConcluded)
+		}
+	}
+	return verdicts
+}
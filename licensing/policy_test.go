@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package licensing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx"
+)
+
+func Test_PolicyEvaluate(t *testing.T) {
+	allow := &Policy{Allow: []string{"MIT", "Apache-2.0", "GPL-2.0", "LicenseRef-internal", "GPL-3.0-only WITH GCC-exception-3.1"}}
+	deny := &Policy{Deny: []string{"GPL-3.0-only", "AGPL-3.0-only", "Commons-Clause"}}
+
+	tests := []struct {
+		name       string
+		policy     *Policy
+		expression string
+		satisfied  bool
+		choice     string
+		violations []string
+	}{
+		{"allowed", allow, "MIT", true, "MIT", nil},
+		{"case insensitive", allow, "mit", true, "mit", nil},
+		{"not allowed", allow, "BSD-3-Clause", false, "", []string{"BSD-3-Clause"}},
+		{"or picks the first acceptable choice", allow, "GPL-3.0-only OR MIT OR Apache-2.0", true, "MIT", nil},
+		{"and requires both", allow, "MIT AND BSD-3-Clause", false, "", []string{"BSD-3-Clause"}},
+		{"choice inside and", allow, "(BSD-3-Clause OR Apache-2.0) AND MIT", true, "Apache-2.0 AND MIT", nil},
+		{"no acceptable choice", allow, "ISC OR Zlib", false, "", []string{"ISC", "Zlib"}},
+		{"or later satisfied by base license", allow, "GPL-2.0+", true, "GPL-2.0+", nil},
+		{"license ref", allow, "LicenseRef-internal OR ISC", true, "LicenseRef-internal", nil},
+		{"allowed combination", allow, "GPL-3.0-only WITH GCC-exception-3.1", true, "GPL-3.0-only WITH GCC-exception-3.1", nil},
+		{"exception of allowed license", allow, "MIT WITH Some-exception", true, "MIT WITH Some-exception", nil},
+		{"noassertion is not allowed", allow, "NOASSERTION", false, "", []string{"NOASSERTION"}},
+		{"deny list", deny, "MIT AND ISC", true, "MIT AND ISC", nil},
+		{"denied", deny, "MIT AND GPL-3.0-only", false, "", []string{"GPL-3.0-only"}},
+		{"denied choice avoided", deny, "AGPL-3.0-only OR GPL-3.0-only OR MIT", true, "MIT", nil},
+		{"denied exception", deny, "Apache-2.0 WITH Commons-Clause", false, "", []string{"Commons-Clause"}},
+		{"denied license of allowed combination", &Policy{Allow: allow.Allow, Deny: []string{"GPL-3.0-only"}}, "GPL-3.0-only WITH GCC-exception-3.1", false, "", []string{"GPL-3.0-only"}},
+		{"denied exception of allowed combination", &Policy{Allow: allow.Allow, Deny: []string{"GCC-exception-3.1"}}, "GPL-3.0-only WITH GCC-exception-3.1", false, "", []string{"GCC-exception-3.1", "GPL-3.0-only"}},
+		{"noassertion is not denied", deny, "NOASSERTION", true, "NOASSERTION", nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := test.policy.EvaluateString(test.expression)
+			require.NoError(t, err)
+			require.Equal(t, test.satisfied, result.Satisfied)
+			require.Equal(t, test.violations, result.Violations)
+			if test.satisfied {
+				require.Equal(t, test.choice, result.Choice.String())
+			} else {
+				require.Nil(t, result.Choice)
+			}
+		})
+	}
+}
+
+func Test_PolicyEvaluateDocument(t *testing.T) {
+	doc := &spdx.Document{
+		Packages: []*spdx.Package{
+			{
+				PackageName:             "p1",
+				PackageSPDXIdentifier:   "p1",
+				PackageLicenseConcluded: "MIT OR GPL-3.0-only",
+				Files: []*spdx.File{
+					{FileName: "f1", FileSPDXIdentifier: "f1", LicenseConcluded: "GPL-3.0-only"},
+				},
+			},
+			{
+				PackageName:             "p2",
+				PackageSPDXIdentifier:   "p2",
+				PackageLicenseConcluded: "MIT AND (",
+			},
+		},
+		Files: []*spdx.File{
+			{FileName: "f1", FileSPDXIdentifier: "f1", LicenseConcluded: "GPL-3.0-only"},
+			{FileName: "f2", FileSPDXIdentifier: "f2", LicenseConcluded: "Apache-2.0"},
+			{FileName: "f3", FileSPDXIdentifier: "f3"},
+		},
+	}
+
+	policy := &Policy{Deny: []string{"GPL-3.0-only"}}
+	verdicts := policy.EvaluateDocument(doc)
+	require.Len(t, verdicts, 4)
+
+	require.Equal(t, "p1", string(verdicts[0].ElementID))
+	require.True(t, verdicts[0].Result.Satisfied)
+	require.Equal(t, "MIT", verdicts[0].Result.Choice.String())
+
+	require.Equal(t, "f1", string(verdicts[1].ElementID))
+	require.False(t, verdicts[1].Result.Satisfied)
+	require.Equal(t, []string{"GPL-3.0-only"}, verdicts[1].Result.Violations)
+
+	require.Equal(t, "p2", string(verdicts[2].ElementID))
+	require.Error(t, verdicts[2].Err)
+	require.False(t, verdicts[2].Result.Satisfied)
+
+	require.Equal(t, "f2", string(verdicts[3].ElementID))
+	require.True(t, verdicts[3].Result.Satisfied)
+}
@@ -0,0 +1,161 @@
+// Package profile checks SPDX documents against SBOM profiles: lists of
+// elements that a document and each of its packages must provide, such as the
+// NTIA minimum elements. Profiles are data, so new ones can be defined from
+// the requirements of this package or from custom checks.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+package profile
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// Requirement is an element that a profile requires, either once for the
+// document or for every package. Exactly one of Document and Package is set.
+type Requirement struct {
+	// Element names the required element, e.g. "Supplier Name"
+	Element string
+
+	// Document returns true if the document provides the element
+	Document func(doc *spdx.Document) bool
+
+	// Package returns true if the package provides the element
+	Package func(doc *spdx.Document, pkg *spdx.Package) bool
+}
+
+// Profile is a named list of requirements
+type Profile struct {
+	// Name identifies the profile, e.g. "NTIA"
+	Name string
+
+	// Description is a one-line summary, e.g. the title of the specification
+	Description string
+
+	Requirements []Requirement
+}
+
+// PackageResult lists the elements a package is missing
+type PackageResult struct {
+	ElementID common.ElementID
+	Name      string
+	Missing   []string
+}
+
+// Report is the result of checking a document against a Profile
+type Report struct {
+	Profile string
+
+	// Missing lists the document-level elements that are missing
+	Missing []string
+
+	// Packages has a result for every package of the document, in document order
+	Packages []PackageResult
+}
+
+// Conforms returns true if nothing is missing
+func (r *Report) Conforms() bool {
+	if len(r.Missing) > 0 {
+		return false
+	}
+	for _, p := range r.Packages {
+		if len(p.Missing) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Nonconforming returns the results of the packages that are missing an element
+func (r *Report) Nonconforming() []PackageResult {
+	var out []PackageResult
+	for _, p := range r.Packages {
+		if len(p.Missing) > 0 {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// String renders the report as one line per missing element
+func (r *Report) String() string {
+	var b strings.Builder
+	if r.Conforms() {
+		fmt.Fprintf(&b, "document conforms to %s\n", r.Profile)
+		return b.String()
+	}
+	for _, element := range r.Missing {
+		fmt.Fprintf(&b, "document: missing %s\n", element)
+	}
+	for _, p := range r.Nonconforming() {
+		for _, element := range p.Missing {
+			fmt.Fprintf(&b, "%s (%s): missing %s\n", common.RenderElementID(p.ElementID), p.Name, element)
+		}
+	}
+	return b.String()
+}
+
+// Check checks the document and every package against the profile
+func (p *Profile) Check(doc *spdx.Document) *Report {
+	report := &Report{Profile: p.Name}
+	for _, req := range p.Requirements {
+		if req.Document != nil && !req.Document(doc) {
+			report.Missing = append(report.Missing, req.Element)
+		}
+	}
+
+	for _, pkg := range doc.Packages {
+		if pkg == nil {
+			continue
+		}
+		result := PackageResult{ElementID: pkg.PackageSPDXIdentifier, Name: pkg.PackageName}
+		for _, req := range p.Requirements {
+			if req.Package != nil && !req.Package(doc, pkg) {
+				result.Missing = append(result.Missing, req.Element)
+			}
+		}
+		report.Packages = append(report.Packages, result)
+	}
+	return report
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[string]*Profile{}
+)
+
+// Register makes a profile available to Lookup, replacing any profile with the same name
+func Register(p *Profile) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	registry[strings.ToLower(p.Name)] = p
+}
+
+// Lookup returns the registered profile with the given name, ignoring case
+func Lookup(name string) (*Profile, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	p, ok := registry[strings.ToLower(name)]
+	return p, ok
+}
+
+// Names returns the sorted names of the registered profiles
+func Names() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	names := make([]string, 0, len(registry))
+	for _, p := range registry {
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	Register(NTIA)
+	Register(BSI)
+}
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package profile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+func sbom() *spdx.Document {
+	return &spdx.Document{
+		CreationInfo: &spdx.CreationInfo{
+			Creators: []common.Creator{{CreatorType: "Tool", Creator: "builder-1.0"}},
+			Created:  "2024-03-01T10:00:00Z",
+		},
+		Packages: []*spdx.Package{
+			{
+				PackageName:           "app",
+				PackageSPDXIdentifier: "app",
+				PackageVersion:        "1.0.0",
+				PackageFileName:       "app-1.0.0.tar.gz",
+				PackageSupplier:       &common.Supplier{SupplierType: "Organization", Supplier: "Example Inc."},
+				PackageChecksums: []common.Checksum{
+					{Algorithm: common.SHA512, Value: "ab12"},
+				},
+				PackageLicenseDeclared: "Apache-2.0",
+				PackageExternalReferences: []*spdx.PackageExternalReference{
+					{Category: common.CategoryPackageManager, RefType: common.TypePackageManagerPURL, Locator: "pkg:generic/app@1.0.0"},
+				},
+			},
+			{
+				PackageName:           "lib",
+				PackageSPDXIdentifier: "lib",
+				PackageVersion:        "2.1",
+				PackageFileName:       "lib-2.1.jar",
+				PackageSupplier:       &common.Supplier{SupplierType: "Person", Supplier: "Jane Doe"},
+				PackageOriginator:     &common.Originator{OriginatorType: "Organization", Originator: "Lib Authors"},
+				PackageChecksums: []common.Checksum{
+					{Algorithm: common.SHA512, Value: "cd34"},
+				},
+				PackageLicenseConcluded: "MIT",
+				PackageExternalReferences: []*spdx.PackageExternalReference{
+					{Category: common.CategorySecurity, RefType: common.TypeSecurityCPE23Type, Locator: "cpe:2.3:a:lib:lib:2.1:*:*:*:*:*:*:*"},
+				},
+			},
+		},
+		Relationships: []*spdx.Relationship{
+			{RefA: common.MakeDocElementID("", "app"), RefB: common.MakeDocElementID("", "lib"), Relationship: common.TypeRelationshipDependsOn},
+		},
+	}
+}
+
+func Test_NTIAConforms(t *testing.T) {
+	report := NTIA.Check(sbom())
+	require.True(t, report.Conforms(), report.String())
+	require.Empty(t, report.Nonconforming())
+	require.Len(t, report.Packages, 2)
+	require.Equal(t, "document conforms to NTIA\n", report.String())
+}
+
+func Test_NTIAMissingElements(t *testing.T) {
+	doc := sbom()
+	doc.CreationInfo.Created = ""
+	doc.Packages[0].PackageSupplier = &common.Supplier{Supplier: "NOASSERTION"}
+	doc.Packages[0].PackageVersion = ""
+	doc.Packages[1].PackageExternalReferences[0].RefType = common.TypeSecurityAdvisory
+	doc.Relationships[0].Relationship = common.TypeRelationshipContains
+
+	report := NTIA.Check(doc)
+	require.False(t, report.Conforms())
+	require.Equal(t, []string{"Timestamp"}, report.Missing)
+	require.Equal(t, []PackageResult{
+		{ElementID: "app", Name: "app", Missing: []string{"Supplier Name", "Version of the Component", "Dependency Relationship"}},
+		{ElementID: "lib", Name: "lib", Missing: []string{"Other Unique Identifiers", "Dependency Relationship"}},
+	}, report.Packages)
+	require.Equal(t, "document: missing Timestamp\n"+
+		"SPDXRef-app (app): missing Supplier Name\n"+
+		"SPDXRef-app (app): missing Version of the Component\n"+
+		"SPDXRef-app (app): missing Dependency Relationship\n"+
+		"SPDXRef-lib (lib): missing Other Unique Identifiers\n"+
+		"SPDXRef-lib (lib): missing Dependency Relationship\n", report.String())
+}
+
+func Test_DependencyRelationship(t *testing.T) {
+	doc := sbom()
+	// inverse relationships count, and a leaf can state it has no dependencies
+	doc.Relationships = []*spdx.Relationship{
+		{RefA: common.MakeDocElementID("", "lib"), RefB: common.MakeDocElementID("", "app"), Relationship: common.TypeRelationshipRuntimeDependencyOf},
+	}
+	require.True(t, NTIA.Check(doc).Conforms())
+
+	doc.Relationships = []*spdx.Relationship{
+		{RefA: common.MakeDocElementID("", "app"), RefB: common.MakeDocElementSpecial("NONE"), Relationship: common.TypeRelationshipDependsOn},
+	}
+	report := NTIA.Check(doc)
+	require.Empty(t, report.Packages[0].Missing)
+	require.Equal(t, []string{"Dependency Relationship"}, report.Packages[1].Missing)
+}
+
+func Test_BSI(t *testing.T) {
+	doc := sbom()
+	require.True(t, BSI.Check(doc).Conforms())
+
+	doc.Packages[0].PackageSupplier = nil
+	doc.Packages[0].PackageLicenseDeclared = "NOASSERTION"
+	doc.Packages[1].PackageChecksums[0].Algorithm = common.SHA256
+	doc.Packages[1].PackageFileName = ""
+
+	report := BSI.Check(doc)
+	require.Equal(t, []string{"Component Creator", "Licences"}, report.Packages[0].Missing)
+	require.Equal(t, []string{"Filename of the Component", "Hash value of the executable Component"}, report.Packages[1].Missing)
+}
+
+func Test_CustomProfile(t *testing.T) {
+	internal := &Profile{
+		Name: "internal",
+		Requirements: []Requirement{
+			Timestamp,
+			ComponentName,
+			{
+				Element: "Home Page",
+				Package: func(_ *spdx.Document, pkg *spdx.Package) bool { return pkg.PackageHomePage != "" },
+			},
+		},
+	}
+	Register(internal)
+
+	p, ok := Lookup("INTERNAL")
+	require.True(t, ok)
+	require.Same(t, internal, p)
+	require.Equal(t, []string{"BSI-TR-03183", "NTIA", "internal"}, Names())
+
+	report := p.Check(sbom())
+	require.Equal(t, []string{"Home Page"}, report.Packages[0].Missing)
+
+	_, ok = Lookup("unknown")
+	require.False(t, ok)
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package profile
+
+import (
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// NTIA is "The Minimum Elements For a Software Bill of Materials (SBOM)"
+// published by the NTIA in July 2021
+var NTIA = &Profile{
+	Name:        "NTIA",
+	Description: "NTIA minimum elements for a software bill of materials",
+	Requirements: []Requirement{
+		Author,
+		Timestamp,
+		SupplierName,
+		ComponentName,
+		Version,
+		UniqueIdentifier,
+		DependencyRelationship,
+	},
+}
+
+// BSI is the list of required data fields of the BSI Technical Guideline
+// TR-03183-2 version 1.1. The executable, archive and structured properties
+// of a component have no SPDX 2 equivalent and are not checked.
+var BSI = &Profile{
+	Name:        "BSI-TR-03183",
+	Description: "BSI TR-03183-2 required data fields",
+	Requirements: []Requirement{
+		{Element: "Creator of the SBOM", Document: Author.Document},
+		Timestamp,
+		ComponentCreator,
+		ComponentName,
+		{Element: "Component Version", Package: Version.Package},
+		FileName,
+		{Element: "Dependencies on other Components", Package: DependencyRelationship.Package},
+		Licenses,
+		Checksum("Hash value of the executable Component", common.SHA512),
+	},
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package profile
+
+import (
+	"time"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+const (
+	noAssertion = "NOASSERTION"
+	none        = "NONE"
+)
+
+// dependencyTypes are the relationship types that state a dependency, in either direction
+var dependencyTypes = map[string]bool{
+	common.TypeRelationshipDependsOn:            true,
+	common.TypeRelationshipDependencyOf:         true,
+	common.TypeRelationshipBuildDependencyOf:    true,
+	common.TypeRelationshipDevDependencyOf:      true,
+	common.TypeRelationshipOptionalDependencyOf: true,
+	common.TypeRelationshipProvidedDependencyOf: true,
+	common.TypeRelationshipTestDependencyOf:     true,
+	common.TypeRelationshipRuntimeDependencyOf:  true,
+}
+
+// identifierTypes are the external reference types that identify a package globally
+var identifierTypes = map[string]bool{
+	common.TypePackageManagerPURL: true,
+	common.TypeSecurityCPE22Type:  true,
+	common.TypeSecurityCPE23Type:  true,
+	common.TypeSecuritySwid:       true,
+}
+
+// Author requires at least one creator of the document
+var Author = Requirement{
+	Element: "Author of SBOM Data",
+	Document: func(doc *spdx.Document) bool {
+		if doc.CreationInfo == nil {
+			return false
+		}
+		for _, c := range doc.CreationInfo.Creators {
+			if c.Creator != "" {
+				return true
+			}
+		}
+		return false
+	},
+}
+
+// Timestamp requires a valid creation date of the document
+var Timestamp = Requirement{
+	Element: "Timestamp",
+	Document: func(doc *spdx.Document) bool {
+		if doc.CreationInfo == nil {
+			return false
+		}
+		_, err := time.Parse("2006-01-02T15:04:05Z", doc.CreationInfo.Created)
+		return err == nil
+	},
+}
+
+// SupplierName requires a PackageSupplier other than NOASSERTION
+var SupplierName = Requirement{
+	Element: "Supplier Name",
+	Package: func(_ *spdx.Document, pkg *spdx.Package) bool {
+		return pkg.PackageSupplier != nil && assertion(pkg.PackageSupplier.Supplier)
+	},
+}
+
+// ComponentCreator requires a PackageOriginator or, if the package was not
+// redistributed, a PackageSupplier, other than NOASSERTION
+var ComponentCreator = Requirement{
+	Element: "Component Creator",
+	Package: func(_ *spdx.Document, pkg *spdx.Package) bool {
+		return pkg.PackageOriginator != nil && assertion(pkg.PackageOriginator.Originator) ||
+			pkg.PackageSupplier != nil && assertion(pkg.PackageSupplier.Supplier)
+	},
+}
+
+// ComponentName requires a PackageName
+var ComponentName = Requirement{
+	Element: "Component Name",
+	Package: func(_ *spdx.Document, pkg *spdx.Package) bool {
+		return pkg.PackageName != ""
+	},
+}
+
+// Version requires a PackageVersion
+var Version = Requirement{
+	Element: "Version of the Component",
+	Package: func(_ *spdx.Document, pkg *spdx.Package) bool {
+		return assertion(pkg.PackageVersion)
+	},
+}
+
+// UniqueIdentifier requires a purl, CPE or SWID external reference
+var UniqueIdentifier = Requirement{
+	Element: "Other Unique Identifiers",
+	Package: func(_ *spdx.Document, pkg *spdx.Package) bool {
+		for _, ref := range pkg.PackageExternalReferences {
+			if ref != nil && identifierTypes[ref.RefType] && ref.Locator != "" {
+				return true
+			}
+		}
+		return false
+	},
+}
+
+// DependencyRelationship requires the package to be on either side of a
+// DEPENDS_ON relationship or one of its inverses. A package without
+// dependencies can state so with a DEPENDS_ON relationship to NONE.
+var DependencyRelationship = Requirement{
+	Element: "Dependency Relationship",
+	Package: func(doc *spdx.Document, pkg *spdx.Package) bool {
+		for _, r := range doc.Relationships {
+			if r == nil || !dependencyTypes[r.Relationship] {
+				continue
+			}
+			for _, ref := range []common.DocElementID{r.RefA, r.RefB} {
+				if ref.DocumentRefID == "" && ref.SpecialID == "" && ref.ElementRefID == pkg.PackageSPDXIdentifier {
+					return true
+				}
+			}
+		}
+		return false
+	},
+}
+
+// FileName requires a PackageFileName
+var FileName = Requirement{
+	Element: "Filename of the Component",
+	Package: func(_ *spdx.Document, pkg *spdx.Package) bool {
+		return pkg.PackageFileName != ""
+	},
+}
+
+// Licenses requires a concluded or declared license other than NONE and NOASSERTION
+var Licenses = Requirement{
+	Element: "Licences",
+	Package: func(_ *spdx.Document, pkg *spdx.Package) bool {
+		return assertion(pkg.PackageLicenseConcluded) && pkg.PackageLicenseConcluded != none ||
+			assertion(pkg.PackageLicenseDeclared) && pkg.PackageLicenseDeclared != none
+	},
+}
+
+// Checksum returns a requirement for a package checksum with the given algorithm
+func Checksum(element string, algorithm common.ChecksumAlgorithm) Requirement {
+	return Requirement{
+		Element: element,
+		Package: func(_ *spdx.Document, pkg *spdx.Package) bool {
+			for _, c := range pkg.PackageChecksums {
+				if c.Algorithm == algorithm && c.Value != "" {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
+
+func assertion(value string) bool {
+	return value != "" && value != noAssertion
+}
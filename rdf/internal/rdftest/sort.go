@@ -0,0 +1,109 @@
+// Package rdftest has helpers for the tests of the RDF readers and writers.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+package rdftest
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/spdx/tools-golang/licensing"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+// Sort puts a document read from RDF in canonical form, as
+// spdxlib.Canonicalize does, and also sorts the lists which RDF graphs don't
+// keep the order of, and the members of its license expressions, so that
+// documents read from the same graph are equal.
+func Sort(doc *spdx.Document) {
+	files := doc.Files
+	for _, pkg := range doc.Packages {
+		pkg.PackageLicenseConcluded = sortLicense(pkg.PackageLicenseConcluded)
+		pkg.PackageLicenseDeclared = sortLicense(pkg.PackageLicenseDeclared)
+		sort.Strings(pkg.PackageLicenseInfoFromFiles)
+		files = append(files, pkg.Files...)
+	}
+
+	snippets := []*spdx.Snippet{}
+	for i := range doc.Snippets {
+		snippets = append(snippets, &doc.Snippets[i])
+	}
+	for _, file := range files {
+		sort.Strings(file.FileTypes)
+		file.LicenseConcluded = sortLicense(file.LicenseConcluded)
+		sort.Strings(file.LicenseInfoInFiles)
+		sort.Strings(file.FileContributors)
+		sort.Strings(file.FileDependencies)
+		sort.Slice(file.ArtifactOfProjects, func(i, j int) bool {
+			a, b := file.ArtifactOfProjects[i], file.ArtifactOfProjects[j]
+			return a.Name+a.URI < b.Name+b.URI
+		})
+		for _, snippet := range file.Snippets {
+			snippets = append(snippets, snippet)
+		}
+	}
+
+	for _, snippet := range snippets {
+		snippet.SnippetLicenseConcluded = sortLicense(snippet.SnippetLicenseConcluded)
+		sort.Strings(snippet.LicenseInfoInSnippet)
+		sort.Slice(snippet.Ranges, func(i, j int) bool {
+			a, b := snippet.Ranges[i].StartPointer, snippet.Ranges[j].StartPointer
+			return a.Offset+a.LineNumber < b.Offset+b.LineNumber
+		})
+	}
+
+	if len(doc.Reviews) == 0 {
+		doc.Reviews = nil
+	}
+	sort.Slice(doc.Reviews, func(i, j int) bool {
+		return doc.Reviews[i].ReviewDate < doc.Reviews[j].ReviewDate
+	})
+
+	spdxlib.Canonicalize(doc)
+}
+
+// sortLicense renders the license expression with the members of
+// conjunctions and disjunctions sorted
+func sortLicense(expression string) string {
+	if expression == "" {
+		return ""
+	}
+	e, err := licensing.Parse(expression)
+	if err != nil {
+		return expression
+	}
+	return sortedLicense(e)
+}
+
+func sortedLicense(e licensing.Expression) string {
+	var members []string
+	var operator string
+	var flatten func(licensing.Expression)
+	switch e.(type) {
+	case *licensing.And:
+		operator = " AND "
+		flatten = func(e licensing.Expression) {
+			if and, ok := e.(*licensing.And); ok {
+				flatten(and.Left)
+				flatten(and.Right)
+				return
+			}
+			members = append(members, sortedLicense(e))
+		}
+	case *licensing.Or:
+		operator = " OR "
+		flatten = func(e licensing.Expression) {
+			if or, ok := e.(*licensing.Or); ok {
+				flatten(or.Left)
+				flatten(or.Right)
+				return
+			}
+			members = append(members, sortedLicense(e))
+		}
+	default:
+		return e.String()
+	}
+	flatten(e)
+	sort.Strings(members)
+	return "(" + strings.Join(members, operator) + ")"
+}
@@ -0,0 +1,534 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package syntax
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	gordfParser "github.com/spdx/gordf/rdfloader/parser"
+)
+
+// member is a member of a JSON object
+type member struct {
+	key   string
+	value interface{}
+}
+
+// object is a JSON object which keeps the order of its members
+type object []member
+
+func (o object) get(key string) (interface{}, bool) {
+	for _, m := range o {
+		if m.key == key {
+			return m.value, true
+		}
+	}
+	return nil, false
+}
+
+func (o object) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, m := range o {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		key, err := marshal(m.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := marshal(m.value)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// marshal encodes the value without escaping HTML characters
+func marshal(v interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+// WriteJSONLD writes the triples as a JSON-LD document: a @graph of node
+// objects, with the Namespaces in the @context. Blank nodes which are the
+// object of a single triple are written inside it.
+func WriteJSONLD(w io.Writer, triples []*gordfParser.Triple) error {
+	context := object{}
+	for _, ns := range Namespaces {
+		context = append(context, member{ns[0], ns[1]})
+	}
+
+	s := groupSubjects(triples)
+	nodes := []interface{}{}
+	for _, subject := range s.order {
+		nodes = append(nodes, nodeObject(s, subject, true))
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(object{{"@context", context}, {"@graph", nodes}})
+}
+
+// nodeObject returns the node object of the subject. Properties with more
+// than one value have an array of the values, in the order of the triples.
+func nodeObject(s *subjects, subject *gordfParser.Node, withID bool) object {
+	node := object{}
+	if withID {
+		node = append(node, member{"@id", jsonldID(subject)})
+	}
+	var types []interface{}
+	values := map[string][]interface{}{}
+	var keys []string
+	for _, t := range s.triples[subject.String()] {
+		if t.Predicate.ID == rdfType && !isLiteral(t.Object) && !isBlank(t.Object) {
+			types = append(types, compactIRI(t.Object.ID))
+			continue
+		}
+		var value interface{}
+		switch {
+		case s.inline(t.Object):
+			value = nodeObject(s, t.Object, false)
+		case isLiteral(t.Object):
+			value = t.Object.ID
+		default:
+			value = object{{"@id", jsonldID(t.Object)}}
+		}
+		key := compactIRI(t.Predicate.ID)
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+		values[key] = append(values[key], value)
+	}
+	switch len(types) {
+	case 0:
+	case 1:
+		node = append(node, member{"@type", types[0]})
+	default:
+		node = append(node, member{"@type", types})
+	}
+	for _, key := range keys {
+		if len(values[key]) == 1 {
+			node = append(node, member{key, values[key][0]})
+		} else {
+			node = append(node, member{key, values[key]})
+		}
+	}
+	return node
+}
+
+func jsonldID(n *gordfParser.Node) string {
+	if isBlank(n) {
+		return "_:" + n.ID
+	}
+	return n.ID
+}
+
+// compactIRI returns the IRI as a compact IRI if it is in one of the
+// Namespaces
+func compactIRI(iri string) string {
+	for _, ns := range Namespaces {
+		if local := strings.TrimPrefix(iri, ns[1]); local != iri && localName.MatchString(local) {
+			return ns[0] + ":" + local
+		}
+	}
+	return iri
+}
+
+// ParseJSONLD reads the triples of a JSON-LD document. Contexts must be
+// embedded in the document: remote contexts are not loaded.
+func ParseJSONLD(r io.Reader) ([]*gordfParser.Triple, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	doc, err := readJSON(dec)
+	if err != nil {
+		return nil, err
+	}
+	p := &jsonldParser{graph: newGraph()}
+	if err := p.document(doc); err != nil {
+		return nil, err
+	}
+	return p.graph.triples, nil
+}
+
+// readJSON reads a JSON value, with objects as object to keep the order of
+// their members
+func readJSON(dec *json.Decoder) (interface{}, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		o := object{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := readJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			o = append(o, member{key.(string), value})
+		}
+		_, err = dec.Token()
+		return o, err
+	case json.Delim('['):
+		a := []interface{}{}
+		for dec.More() {
+			value, err := readJSON(dec)
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, value)
+		}
+		_, err = dec.Token()
+		return a, err
+	}
+	return token, nil
+}
+
+// term is a term definition of a context
+type term struct {
+	iri string
+
+	// typ is the @type of the term: "@id" and "@vocab" make string values IRIs
+	typ string
+}
+
+// context is an active context: the terms, prefixes and base IRIs in scope
+type context struct {
+	terms map[string]term
+	vocab string
+	base  string
+}
+
+type jsonldParser struct {
+	graph *graph
+}
+
+func (p *jsonldParser) document(doc interface{}) error {
+	ctx := &context{terms: map[string]term{}}
+	switch doc := doc.(type) {
+	case []interface{}:
+		for _, n := range doc {
+			if _, err := p.value(ctx, n); err != nil {
+				return err
+			}
+		}
+		return nil
+	case object:
+		_, err := p.node(ctx, doc, true)
+		return err
+	}
+	return fmt.Errorf("a JSON-LD document must be an object or an array")
+}
+
+// withContext returns the active context updated by a local context
+func (ctx *context) withContext(local interface{}) (*context, error) {
+	next := &context{terms: map[string]term{}, vocab: ctx.vocab, base: ctx.base}
+	for k, v := range ctx.terms {
+		next.terms[k] = v
+	}
+	var locals []interface{}
+	if a, ok := local.([]interface{}); ok {
+		locals = a
+	} else {
+		locals = []interface{}{local}
+	}
+	for _, l := range locals {
+		switch l := l.(type) {
+		case nil:
+			next = &context{terms: map[string]term{}}
+		case string:
+			return nil, fmt.Errorf("remote JSON-LD context %s is not supported", l)
+		case object:
+			if err := next.define(l); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("invalid JSON-LD context")
+		}
+	}
+	return next, nil
+}
+
+// define adds the term definitions of a context object
+func (ctx *context) define(defs object) error {
+	for _, m := range defs {
+		switch m.key {
+		case "@vocab":
+			vocab, _ := m.value.(string)
+			ctx.vocab = vocab
+			continue
+		case "@base":
+			base, _ := m.value.(string)
+			ctx.base = base
+			continue
+		case "@version", "@language", "@protected", "@direction", "@propagate", "@import":
+			continue
+		}
+		switch v := m.value.(type) {
+		case nil:
+			delete(ctx.terms, m.key)
+		case string:
+			ctx.terms[m.key] = term{iri: v}
+		case object:
+			t := term{}
+			if id, ok := v.get("@id"); ok {
+				t.iri, _ = id.(string)
+			}
+			if typ, ok := v.get("@type"); ok {
+				t.typ, _ = typ.(string)
+			}
+			ctx.terms[m.key] = t
+		default:
+			return fmt.Errorf("invalid definition of JSON-LD term %s", m.key)
+		}
+	}
+
+	// term IRIs may be compact IRIs or terms themselves
+	for key, t := range ctx.terms {
+		if t.iri == "" {
+			t.iri = key
+		}
+		t.iri = ctx.expand(t.iri, true)
+		ctx.terms[key] = t
+	}
+	return nil
+}
+
+// expand returns the IRI of a key or @type value, or of an @id value if vocab
+// is false. It returns "" for a key which can't be expanded to an IRI.
+func (ctx *context) expand(value string, vocab bool) string {
+	if strings.HasPrefix(value, "@") || strings.HasPrefix(value, "_:") {
+		return value
+	}
+	if vocab {
+		if t, ok := ctx.terms[value]; ok && t.iri != "" && t.iri != value {
+			return t.iri
+		}
+	}
+	if i := strings.Index(value, ":"); i > 0 {
+		prefix, suffix := value[:i], value[i+1:]
+		if !strings.HasPrefix(suffix, "//") {
+			if t, ok := ctx.terms[prefix]; ok && t.iri != "" {
+				return t.iri + suffix
+			}
+		}
+		return value
+	}
+	if vocab {
+		if ctx.vocab != "" {
+			return ctx.vocab + value
+		}
+		return ""
+	}
+	if ctx.base != "" {
+		base, err := url.Parse(ctx.base)
+		ref, err2 := url.Parse(value)
+		if err == nil && err2 == nil {
+			return base.ResolveReference(ref).String()
+		}
+	}
+	return value
+}
+
+// node adds the triples of a node object and returns its subject
+func (p *jsonldParser) node(ctx *context, n object, top bool) (*gordfParser.Node, error) {
+	if local, ok := n.get("@context"); ok {
+		var err error
+		if ctx, err = ctx.withContext(local); err != nil {
+			return nil, err
+		}
+	}
+
+	// a document with a @graph and no other properties is a set of nodes
+	if graph, ok := n.get("@graph"); ok {
+		nodes, _ := graph.([]interface{})
+		if g, ok := graph.(object); ok {
+			nodes = []interface{}{g}
+		}
+		for _, g := range nodes {
+			if _, err := p.value(ctx, g); err != nil {
+				return nil, err
+			}
+		}
+		if top {
+			return nil, nil
+		}
+	}
+
+	var subject *gordfParser.Node
+	if id, ok := n.get("@id"); ok {
+		s, _ := id.(string)
+		iri := ctx.expand(s, false)
+		if strings.HasPrefix(iri, "_:") {
+			subject = p.graph.blank(iri[2:])
+		} else {
+			subject = p.graph.subject(iri)
+		}
+	} else {
+		subject = p.graph.blank("")
+	}
+
+	for _, m := range n {
+		switch m.key {
+		case "@context", "@id", "@graph", "@index", "@reverse":
+			continue
+		case "@type":
+			types, ok := m.value.([]interface{})
+			if !ok {
+				types = []interface{}{m.value}
+			}
+			for _, t := range types {
+				s, ok := t.(string)
+				if !ok {
+					return nil, fmt.Errorf("invalid JSON-LD @type")
+				}
+				iri := ctx.expand(s, true)
+				if iri == "" {
+					continue
+				}
+				p.graph.add(subject, rdfType, p.graph.resource(iri))
+			}
+			continue
+		}
+		predicate := ctx.expand(m.key, true)
+		if predicate == "" || strings.HasPrefix(predicate, "@") {
+			// keys which aren't IRIs are dropped, as JSON-LD expansion does
+			continue
+		}
+		values, ok := m.value.([]interface{})
+		if !ok {
+			values = []interface{}{m.value}
+		}
+		for _, v := range values {
+			object, err := p.propertyValue(ctx, ctx.terms[m.key], v)
+			if err != nil {
+				return nil, err
+			}
+			if object != nil {
+				p.graph.add(subject, predicate, object)
+			}
+		}
+	}
+	return subject, nil
+}
+
+// propertyValue returns the object of a property value
+func (p *jsonldParser) propertyValue(ctx *context, t term, v interface{}) (*gordfParser.Node, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		switch t.typ {
+		case "@id":
+			return p.reference(ctx.expand(v, false)), nil
+		case "@vocab":
+			return p.reference(ctx.expand(v, true)), nil
+		}
+		return literal(v), nil
+	case json.Number:
+		return literal(v.String()), nil
+	case bool:
+		if v {
+			return literal("true"), nil
+		}
+		return literal("false"), nil
+	case object:
+		if value, ok := v.get("@value"); ok {
+			switch value := value.(type) {
+			case nil:
+				return nil, nil
+			case string:
+				return literal(value), nil
+			default:
+				return literal(fmt.Sprint(value)), nil
+			}
+		}
+		if list, ok := v.get("@list"); ok {
+			return p.list(ctx, t, list)
+		}
+		if set, ok := v.get("@set"); ok {
+			return p.propertyValue(ctx, t, set)
+		}
+		if len(v) == 1 && v[0].key == "@id" {
+			id, _ := v[0].value.(string)
+			return p.reference(ctx.expand(id, false)), nil
+		}
+		n, err := p.node(ctx, v, false)
+		if err != nil {
+			return nil, err
+		}
+		return p.graph.asObject(n), nil
+	case []interface{}:
+		return nil, fmt.Errorf("nested JSON-LD arrays are not supported")
+	}
+	return nil, fmt.Errorf("invalid JSON-LD value %v", v)
+}
+
+// value reads a top level node object
+func (p *jsonldParser) value(ctx *context, v interface{}) (*gordfParser.Node, error) {
+	n, ok := v.(object)
+	if !ok {
+		return nil, fmt.Errorf("expected a JSON-LD node object")
+	}
+	return p.node(ctx, n, false)
+}
+
+// reference returns the object node of an IRI or blank node identifier
+func (p *jsonldParser) reference(id string) *gordfParser.Node {
+	if strings.HasPrefix(id, "_:") {
+		return p.graph.blank(id[2:])
+	}
+	return p.graph.resource(id)
+}
+
+// list adds the triples of an RDF collection and returns its head
+func (p *jsonldParser) list(ctx *context, t term, list interface{}) (*gordfParser.Node, error) {
+	items, ok := list.([]interface{})
+	if !ok {
+		items = []interface{}{list}
+	}
+	var head, last *gordfParser.Node
+	for _, item := range items {
+		object, err := p.propertyValue(ctx, t, item)
+		if err != nil {
+			return nil, err
+		}
+		if object == nil {
+			continue
+		}
+		n := p.graph.blank("")
+		if last == nil {
+			head = n
+		} else {
+			p.graph.add(last, rdfRest, n)
+		}
+		p.graph.add(n, rdfFirst, object)
+		last = n
+	}
+	if head == nil {
+		return p.graph.resource(rdfNil), nil
+	}
+	p.graph.add(last, rdfRest, p.graph.resource(rdfNil))
+	return head, nil
+}
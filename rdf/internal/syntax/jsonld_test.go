@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package syntax
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseJSONLD(t *testing.T) {
+	triples, err := ParseJSONLD(strings.NewReader(`{
+  "@context": {
+    "spdx": "http://spdx.org/rdf/terms#",
+    "@base": "http://example.com/doc",
+    "name": "spdx:name",
+    "describes": {"@id": "spdx:describes", "@type": "@id"},
+    "unmapped": null
+  },
+  "@graph": [
+    {
+      "@id": "#SPDXRef-DOCUMENT",
+      "@type": "spdx:SpdxDocument",
+      "name": ["doc", {"@value": "two", "@language": "en"}],
+      "describes": "#SPDXRef-Package",
+      "spdx:creationInfo": {"@type": "spdx:CreationInfo", "spdx:created": "2010"},
+      "spdx:count": 42,
+      "spdx:ok": false,
+      "spdx:list": {"@list": ["a", {"@id": "_:b"}]},
+      "notAnIRI": "dropped"
+    },
+    {
+      "@context": {"@vocab": "http://example.com/ns#"},
+      "@id": "_:b",
+      "local": "vocab"
+    }
+  ]
+}`))
+	require.NoError(t, err)
+	require.Equal(t, `<http://example.com/doc#SPDXRef-DOCUMENT> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://spdx.org/rdf/terms#SpdxDocument> .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://spdx.org/rdf/terms#name> "doc" .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://spdx.org/rdf/terms#name> "two" .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://spdx.org/rdf/terms#describes> <http://example.com/doc#SPDXRef-Package> .
+_:N1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://spdx.org/rdf/terms#CreationInfo> .
+_:N1 <http://spdx.org/rdf/terms#created> "2010" .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://spdx.org/rdf/terms#creationInfo> _:N1 .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://spdx.org/rdf/terms#count> "42" .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://spdx.org/rdf/terms#ok> "false" .
+_:N2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> "a" .
+_:N2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> _:N4 .
+_:N4 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> _:N3 .
+_:N4 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil> .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://spdx.org/rdf/terms#list> _:N2 .
+_:N3 <http://example.com/ns#local> "vocab" .
+`, ntriples(t, triples))
+}
+
+func Test_ParseJSONLDErrors(t *testing.T) {
+	for _, in := range []string{
+		`{"@context": "https://example.com/context.jsonld", "@id": "x"}`,
+		`"a string"`,
+		`{"@id": "x", "http://example.com/p": [[1]]}`,
+		`{"@id": "x"`,
+	} {
+		_, err := ParseJSONLD(strings.NewReader(in))
+		require.Error(t, err, in)
+	}
+}
+
+func Test_WriteJSONLD(t *testing.T) {
+	in := `<http://spdx.org/rdf/terms#a> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://spdx.org/rdf/terms#Package> .
+<http://spdx.org/rdf/terms#a> <http://spdx.org/rdf/terms#name> "<a> & b" .
+<http://spdx.org/rdf/terms#a> <http://spdx.org/rdf/terms#name> "c" .
+_:N1 <http://spdx.org/rdf/terms#checksumValue> "x" .
+<http://spdx.org/rdf/terms#a> <http://spdx.org/rdf/terms#checksum> _:N1 .
+<http://spdx.org/rdf/terms#a> <http://example.com/p> <http://spdx.org/rdf/terms#b> .
+`
+	triples, err := ParseTurtle(strings.NewReader(in))
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteJSONLD(buf, triples))
+	require.Contains(t, buf.String(), `"@graph": [
+    {
+      "@id": "http://spdx.org/rdf/terms#a",
+      "@type": "spdx:Package",
+      "spdx:name": [
+        "<a> & b",
+        "c"
+      ],
+      "spdx:checksum": {
+        "spdx:checksumValue": "x"
+      },
+      "http://example.com/p": {
+        "@id": "http://spdx.org/rdf/terms#b"
+      }
+    }
+  ]`)
+
+	got, err := ParseJSONLD(buf)
+	require.NoError(t, err)
+	require.Equal(t, in, ntriples(t, got))
+}
@@ -0,0 +1,172 @@
+// Package syntax reads and writes the triples of RDF graphs in the Turtle,
+// N-Triples and JSON-LD syntaxes, in the triple model of the gordf parser
+// that the SPDX 2 rdf/reader packages are built on.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+package syntax
+
+import (
+	"fmt"
+
+	gordfParser "github.com/spdx/gordf/rdfloader/parser"
+)
+
+// Namespaces are the prefixes of the SPDX 2 RDF vocabularies, in the order
+// they are declared
+var Namespaces = [][2]string{
+	{"rdf", gordfParser.RDFNS},
+	{"rdfs", "http://www.w3.org/2000/01/rdf-schema#"},
+	{"spdx", "http://spdx.org/rdf/terms#"},
+	{"doap", "http://usefulinc.com/ns/doap#"},
+	{"ptr", "http://www.w3.org/2009/pointers#"},
+}
+
+const (
+	rdfType  = gordfParser.RDFNS + "type"
+	rdfFirst = gordfParser.RDFNS + "first"
+	rdfRest  = gordfParser.RDFNS + "rest"
+	rdfNil   = gordfParser.RDFNS + "nil"
+)
+
+// graph collects parsed triples. As in the triples gordf reads from RDF/XML,
+// a subject IRI is the same IRI node in all its triples, IRI objects are
+// resource nodes, and a blank node is the same node wherever it is used.
+type graph struct {
+	triples []*gordfParser.Triple
+	iris    map[string]*gordfParser.Node
+
+	// blanks holds the blank node of each blank node label
+	blanks map[string]*gordfParser.Node
+
+	// count is the number of blank nodes so far
+	count int
+}
+
+func newGraph() *graph {
+	return &graph{
+		iris:   map[string]*gordfParser.Node{},
+		blanks: map[string]*gordfParser.Node{},
+	}
+}
+
+// subject returns the node of an IRI in the subject position
+func (g *graph) subject(iri string) *gordfParser.Node {
+	n := g.iris[iri]
+	if n == nil {
+		n = &gordfParser.Node{NodeType: gordfParser.IRI, ID: iri}
+		g.iris[iri] = n
+	}
+	return n
+}
+
+// resource returns the node of an IRI in the object position
+func (g *graph) resource(iri string) *gordfParser.Node {
+	return &gordfParser.Node{NodeType: gordfParser.RESOURCELITERAL, ID: iri}
+}
+
+// blank returns the blank node of the label, or a new blank node if the label
+// is empty. Blank node IDs are generated, so that labels of the document
+// can't clash with them.
+func (g *graph) blank(label string) *gordfParser.Node {
+	if label != "" && g.blanks[label] != nil {
+		return g.blanks[label]
+	}
+	g.count++
+	n := &gordfParser.Node{NodeType: gordfParser.BLANK, ID: fmt.Sprintf("N%d", g.count)}
+	if label != "" {
+		g.blanks[label] = n
+	}
+	return n
+}
+
+func literal(value string) *gordfParser.Node {
+	return &gordfParser.Node{NodeType: gordfParser.LITERAL, ID: value}
+}
+
+func (g *graph) add(subject *gordfParser.Node, predicate string, object *gordfParser.Node) {
+	g.triples = append(g.triples, &gordfParser.Triple{
+		Subject:   subject,
+		Predicate: &gordfParser.Node{NodeType: gordfParser.IRI, ID: predicate},
+		Object:    object,
+	})
+}
+
+// asObject returns the node to use for n in the object position
+func (g *graph) asObject(n *gordfParser.Node) *gordfParser.Node {
+	if n.NodeType == gordfParser.IRI {
+		return g.resource(n.ID)
+	}
+	return n
+}
+
+func isBlank(n *gordfParser.Node) bool {
+	return n.NodeType == gordfParser.BLANK || n.NodeType == gordfParser.NODEIDLITERAL
+}
+
+func isLiteral(n *gordfParser.Node) bool {
+	return n.NodeType == gordfParser.LITERAL
+}
+
+// subjects groups the triples by subject, in the order the subjects first
+// appear. Blank nodes that are the object of exactly one triple are returned
+// in nested, to be written inside that triple, and are left out of order.
+type subjects struct {
+	order   []*gordfParser.Node
+	triples map[string][]*gordfParser.Triple
+	nested  map[string]bool
+}
+
+func groupSubjects(triples []*gordfParser.Triple) *subjects {
+	s := &subjects{
+		triples: map[string][]*gordfParser.Triple{},
+		nested:  map[string]bool{},
+	}
+	var all []*gordfParser.Node
+	references := map[string]int{}
+	for _, t := range triples {
+		key := t.Subject.String()
+		if _, ok := s.triples[key]; !ok {
+			all = append(all, t.Subject)
+		}
+		s.triples[key] = append(s.triples[key], t)
+		if isBlank(t.Object) {
+			references[t.Object.String()]++
+		}
+	}
+	for _, n := range all {
+		if isBlank(n) && references[n.String()] == 1 {
+			s.nested[n.String()] = true
+		}
+	}
+
+	// blank nodes in a cycle of nested blank nodes can't all be nested
+	written := map[string]bool{}
+	var walk func(n *gordfParser.Node)
+	walk = func(n *gordfParser.Node) {
+		written[n.String()] = true
+		for _, t := range s.triples[n.String()] {
+			if s.inline(t.Object) && !written[t.Object.String()] {
+				walk(t.Object)
+			}
+		}
+	}
+	for _, n := range all {
+		if !s.nested[n.String()] {
+			s.order = append(s.order, n)
+			walk(n)
+		}
+	}
+	for _, n := range all {
+		if !written[n.String()] {
+			delete(s.nested, n.String())
+			s.order = append(s.order, n)
+			walk(n)
+		}
+	}
+	return s
+}
+
+// inline returns whether the object is a blank node to write inside the
+// triple
+func (s *subjects) inline(object *gordfParser.Node) bool {
+	return isBlank(object) && s.nested[object.String()]
+}
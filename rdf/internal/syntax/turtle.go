@@ -0,0 +1,699 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package syntax
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	gordfParser "github.com/spdx/gordf/rdfloader/parser"
+)
+
+// WriteTurtle writes the triples in Turtle, with the Namespaces as prefixes.
+// Blank nodes which are the object of a single triple are written inside it.
+func WriteTurtle(w io.Writer, triples []*gordfParser.Triple) error {
+	out := bufio.NewWriter(w)
+	for _, ns := range Namespaces {
+		out.WriteString("@prefix " + ns[0] + ": " + iriRef(ns[1]) + " .\n")
+	}
+
+	s := groupSubjects(triples)
+	for _, subject := range s.order {
+		out.WriteString("\n" + turtleTerm(subject))
+		writePredicates(out, s, subject, 1)
+		out.WriteString(" .\n")
+	}
+	return out.Flush()
+}
+
+// writePredicates writes the predicate object list of the subject
+func writePredicates(out *bufio.Writer, s *subjects, subject *gordfParser.Node, depth int) {
+	indent := strings.Repeat("    ", depth)
+	for i, t := range s.triples[subject.String()] {
+		if i > 0 {
+			out.WriteString(" ;")
+		}
+		out.WriteString("\n" + indent)
+		if t.Predicate.ID == rdfType {
+			out.WriteString("a ")
+		} else {
+			out.WriteString(prefixedName(t.Predicate.ID) + " ")
+		}
+		if s.inline(t.Object) {
+			out.WriteString("[")
+			writePredicates(out, s, t.Object, depth+1)
+			out.WriteString("\n" + indent + "]")
+			continue
+		}
+		out.WriteString(turtleTerm(t.Object))
+	}
+}
+
+// WriteNTriples writes the triples in N-Triples, one triple per line
+func WriteNTriples(w io.Writer, triples []*gordfParser.Triple) error {
+	out := bufio.NewWriter(w)
+	for _, t := range triples {
+		out.WriteString(ntriplesTerm(t.Subject) + " " + iriRef(t.Predicate.ID) + " " + ntriplesTerm(t.Object) + " .\n")
+	}
+	return out.Flush()
+}
+
+func turtleTerm(n *gordfParser.Node) string {
+	if isBlank(n) || isLiteral(n) {
+		return ntriplesTerm(n)
+	}
+	return prefixedName(n.ID)
+}
+
+func ntriplesTerm(n *gordfParser.Node) string {
+	switch {
+	case isBlank(n):
+		return "_:" + n.ID
+	case isLiteral(n):
+		return quote(n.ID)
+	}
+	return iriRef(n.ID)
+}
+
+// localName matches the local names written after a prefix
+var localName = regexp.MustCompile(`^[A-Za-z0-9_]([A-Za-z0-9_.-]*[A-Za-z0-9_-])?$`)
+
+// prefixedName returns the IRI as a prefixed name if it is in one of the
+// Namespaces, or as an IRI reference otherwise
+func prefixedName(iri string) string {
+	for _, ns := range Namespaces {
+		if local := strings.TrimPrefix(iri, ns[1]); local != iri && localName.MatchString(local) {
+			return ns[0] + ":" + local
+		}
+	}
+	return iriRef(iri)
+}
+
+func iriRef(iri string) string {
+	var b strings.Builder
+	b.WriteString("<")
+	for _, r := range iri {
+		if r <= 0x20 || strings.ContainsRune("<>\"{}|^`\\", r) {
+			fmt.Fprintf(&b, "\\u%04X", r)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	b.WriteString(">")
+	return b.String()
+}
+
+// quote returns the literal as a string with escapes
+func quote(value string) string {
+	var b strings.Builder
+	b.WriteString(`"`)
+	for _, r := range value {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, "\\u%04X", r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteString(`"`)
+	return b.String()
+}
+
+// ParseTurtle reads the triples of a Turtle document. N-Triples documents are
+// Turtle documents, and are read as well.
+func ParseTurtle(r io.Reader) ([]*gordfParser.Triple, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.Valid(content) {
+		return nil, fmt.Errorf("turtle document is not valid UTF-8")
+	}
+	p := &turtleParser{
+		input:    string(content),
+		prefixes: map[string]string{},
+		graph:    newGraph(),
+	}
+	for {
+		p.skipSpace()
+		if p.eof() {
+			break
+		}
+		if err := p.statement(); err != nil {
+			return nil, p.errorf("%v", err)
+		}
+	}
+	return p.graph.triples, nil
+}
+
+type turtleParser struct {
+	input    string
+	pos      int
+	base     string
+	prefixes map[string]string
+	graph    *graph
+}
+
+func (p *turtleParser) errorf(format string, args ...interface{}) error {
+	line := strings.Count(p.input[:p.pos], "\n") + 1
+	return fmt.Errorf("turtle line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+func (p *turtleParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *turtleParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+// skipSpace skips white space and comments
+func (p *turtleParser) skipSpace() {
+	for !p.eof() {
+		switch p.peek() {
+		case ' ', '\t', '\r', '\n':
+			p.pos++
+		case '#':
+			for !p.eof() && p.peek() != '\n' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *turtleParser) expect(c byte) error {
+	p.skipSpace()
+	if p.peek() != c {
+		return fmt.Errorf("expected %q", c)
+	}
+	p.pos++
+	return nil
+}
+
+// keyword consumes the keyword, compared case-insensitively, if it is next
+// and is not the start of a longer name
+func (p *turtleParser) keyword(word string) bool {
+	end := p.pos + len(word)
+	if end > len(p.input) || !strings.EqualFold(p.input[p.pos:end], word) {
+		return false
+	}
+	if end < len(p.input) && (isNameChar(rune(p.input[end])) || p.input[end] == ':') {
+		return false
+	}
+	p.pos = end
+	return true
+}
+
+func (p *turtleParser) statement() error {
+	switch {
+	case p.keyword("@prefix"):
+		if err := p.prefix(); err != nil {
+			return err
+		}
+		return p.expect('.')
+	case p.keyword("@base"):
+		if err := p.setBase(); err != nil {
+			return err
+		}
+		return p.expect('.')
+	case p.keyword("PREFIX"):
+		return p.prefix()
+	case p.keyword("BASE"):
+		return p.setBase()
+	}
+
+	var subject *gordfParser.Node
+	var err error
+	if p.peek() == '[' {
+		p.pos++
+		subject = p.graph.blank("")
+		p.skipSpace()
+		if p.peek() == ']' {
+			p.pos++
+		} else {
+			if err = p.predicateObjects(subject); err != nil {
+				return err
+			}
+			if err = p.expect(']'); err != nil {
+				return err
+			}
+			// the predicate object list is optional after a blank node property list
+			p.skipSpace()
+			if p.peek() == '.' {
+				p.pos++
+				return nil
+			}
+		}
+	} else if subject, err = p.term(true); err != nil {
+		return err
+	}
+	if isLiteral(subject) {
+		return fmt.Errorf("a literal can't be a subject")
+	}
+	if err = p.predicateObjects(subject); err != nil {
+		return err
+	}
+	return p.expect('.')
+}
+
+func (p *turtleParser) prefix() error {
+	p.skipSpace()
+	start := p.pos
+	for !p.eof() && p.peek() != ':' && isNameChar(rune(p.peek())) {
+		p.pos++
+	}
+	name := p.input[start:p.pos]
+	if err := p.expect(':'); err != nil {
+		return err
+	}
+	p.skipSpace()
+	iri, err := p.iriRef()
+	if err != nil {
+		return err
+	}
+	p.prefixes[name] = iri
+	return nil
+}
+
+func (p *turtleParser) setBase() error {
+	p.skipSpace()
+	iri, err := p.iriRef()
+	if err != nil {
+		return err
+	}
+	p.base = iri
+	return nil
+}
+
+func (p *turtleParser) predicateObjects(subject *gordfParser.Node) error {
+	for {
+		p.skipSpace()
+		var predicate string
+		if p.keyword("a") {
+			predicate = rdfType
+		} else {
+			n, err := p.term(false)
+			if err != nil {
+				return err
+			}
+			if isBlank(n) || isLiteral(n) {
+				return fmt.Errorf("a predicate must be an IRI")
+			}
+			predicate = n.ID
+		}
+
+		for {
+			p.skipSpace()
+			object, open, err := p.object()
+			if err != nil {
+				return err
+			}
+			p.graph.add(subject, predicate, object)
+			if open {
+				if err := p.propertyList(object); err != nil {
+					return err
+				}
+			}
+			p.skipSpace()
+			if p.peek() != ',' {
+				break
+			}
+			p.pos++
+		}
+
+		// a predicate object list may end with any number of semicolons
+		p.skipSpace()
+		if p.peek() != ';' {
+			return nil
+		}
+		for p.peek() == ';' {
+			p.pos++
+			p.skipSpace()
+		}
+		switch p.peek() {
+		case '.', ']', 0:
+			return nil
+		}
+	}
+}
+
+// object reads an object. If it is a blank node property list, only its "["
+// is read and open is true: the caller reads the list with propertyList once
+// the triple of the object is added, so that triples are in document order.
+func (p *turtleParser) object() (n *gordfParser.Node, open bool, err error) {
+	if p.peek() == '[' {
+		p.pos++
+		return p.graph.blank(""), true, nil
+	}
+	n, err = p.term(false)
+	if err != nil {
+		return nil, false, err
+	}
+	return p.graph.asObject(n), false, nil
+}
+
+// propertyList reads the rest of a blank node property list
+func (p *turtleParser) propertyList(object *gordfParser.Node) error {
+	p.skipSpace()
+	if p.peek() == ']' {
+		p.pos++
+		return nil
+	}
+	if err := p.predicateObjects(object); err != nil {
+		return err
+	}
+	return p.expect(']')
+}
+
+// term reads an IRI, blank node, collection or literal
+func (p *turtleParser) term(subject bool) (*gordfParser.Node, error) {
+	p.skipSpace()
+	c := p.peek()
+	switch {
+	case c == '<':
+		iri, err := p.iriRef()
+		if err != nil {
+			return nil, err
+		}
+		return p.graph.subject(iri), nil
+	case c == '_' && p.pos+1 < len(p.input) && p.input[p.pos+1] == ':':
+		p.pos += 2
+		start := p.pos
+		for !p.eof() && isNameChar(rune(p.peek())) {
+			p.pos++
+		}
+		for p.pos > start && p.input[p.pos-1] == '.' {
+			p.pos--
+		}
+		if p.pos == start {
+			return nil, fmt.Errorf("empty blank node label")
+		}
+		return p.graph.blank(p.input[start:p.pos]), nil
+	case c == '(':
+		return p.collection()
+	case c == '"' || c == '\'':
+		if subject {
+			return nil, fmt.Errorf("a literal can't be a subject")
+		}
+		return p.literal()
+	case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+		if subject {
+			return nil, fmt.Errorf("a literal can't be a subject")
+		}
+		return p.number()
+	case p.keyword("true"):
+		return literal("true"), nil
+	case p.keyword("false"):
+		return literal("false"), nil
+	}
+	iri, err := p.prefixedName()
+	if err != nil {
+		return nil, err
+	}
+	return p.graph.subject(iri), nil
+}
+
+func (p *turtleParser) collection() (*gordfParser.Node, error) {
+	p.pos++
+	var head, last *gordfParser.Node
+	for {
+		p.skipSpace()
+		if p.peek() == ')' {
+			p.pos++
+			break
+		}
+		if p.eof() {
+			return nil, fmt.Errorf("unterminated collection")
+		}
+		item := p.graph.blank("")
+		if last == nil {
+			head = item
+		} else {
+			p.graph.add(last, rdfRest, item)
+		}
+		object, open, err := p.object()
+		if err != nil {
+			return nil, err
+		}
+		p.graph.add(item, rdfFirst, object)
+		if open {
+			if err := p.propertyList(object); err != nil {
+				return nil, err
+			}
+		}
+		last = item
+	}
+	if head == nil {
+		return p.graph.subject(rdfNil), nil
+	}
+	p.graph.add(last, rdfRest, p.graph.resource(rdfNil))
+	return head, nil
+}
+
+func (p *turtleParser) iriRef() (string, error) {
+	if p.peek() != '<' {
+		return "", fmt.Errorf("expected an IRI")
+	}
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.eof() {
+			return "", fmt.Errorf("unterminated IRI")
+		}
+		c := p.peek()
+		if c == '>' {
+			p.pos++
+			break
+		}
+		if c == '\\' {
+			r, err := p.unicodeEscape()
+			if err != nil {
+				return "", err
+			}
+			b.WriteRune(r)
+			continue
+		}
+		b.WriteByte(c)
+		p.pos++
+	}
+	return p.resolve(b.String())
+}
+
+// resolve returns the IRI resolved against the base IRI
+func (p *turtleParser) resolve(iri string) (string, error) {
+	if p.base == "" {
+		return iri, nil
+	}
+	ref, err := url.Parse(iri)
+	if err != nil {
+		return "", err
+	}
+	if ref.IsAbs() {
+		return iri, nil
+	}
+	base, err := url.Parse(p.base)
+	if err != nil {
+		return "", err
+	}
+	return base.ResolveReference(ref).String(), nil
+}
+
+func (p *turtleParser) prefixedName() (string, error) {
+	start := p.pos
+	for !p.eof() && p.peek() != ':' && isNameChar(rune(p.peek())) {
+		p.pos++
+	}
+	prefix := p.input[start:p.pos]
+	if p.peek() != ':' {
+		p.pos = start
+		return "", fmt.Errorf("unexpected %q", p.rest())
+	}
+	ns, ok := p.prefixes[prefix]
+	if !ok {
+		return "", fmt.Errorf("undefined prefix %q", prefix)
+	}
+	p.pos++
+
+	var b strings.Builder
+	for !p.eof() {
+		c := p.peek()
+		switch {
+		case c == '\\' && p.pos+1 < len(p.input):
+			b.WriteByte(p.input[p.pos+1])
+			p.pos += 2
+		case c == '%' || c == ':' || isNameChar(rune(c)):
+			b.WriteByte(c)
+			p.pos++
+		default:
+			return ns + trimDots(p, &b), nil
+		}
+	}
+	return ns + trimDots(p, &b), nil
+}
+
+// trimDots gives back the trailing dots of a local name, which end the
+// statement instead
+func trimDots(p *turtleParser, b *strings.Builder) string {
+	local := b.String()
+	for strings.HasSuffix(local, ".") {
+		local = local[:len(local)-1]
+		p.pos--
+	}
+	return local
+}
+
+func (p *turtleParser) rest() string {
+	end := p.pos + 20
+	if end > len(p.input) {
+		end = len(p.input)
+	}
+	return p.input[p.pos:end]
+}
+
+func (p *turtleParser) literal() (*gordfParser.Node, error) {
+	quote := p.input[p.pos : p.pos+1]
+	long := strings.HasPrefix(p.input[p.pos:], strings.Repeat(quote, 3))
+	if long {
+		quote = strings.Repeat(quote, 3)
+	}
+	p.pos += len(quote)
+
+	var b strings.Builder
+	for {
+		if p.eof() {
+			return nil, fmt.Errorf("unterminated string")
+		}
+		if strings.HasPrefix(p.input[p.pos:], quote) {
+			p.pos += len(quote)
+			break
+		}
+		c := p.peek()
+		if !long && (c == '\n' || c == '\r') {
+			return nil, fmt.Errorf("line break in a short string")
+		}
+		if c != '\\' {
+			b.WriteByte(c)
+			p.pos++
+			continue
+		}
+		if p.pos+1 >= len(p.input) {
+			return nil, fmt.Errorf("unterminated string")
+		}
+		switch e := p.input[p.pos+1]; e {
+		case 't':
+			b.WriteByte('\t')
+		case 'b':
+			b.WriteByte('\b')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case '"', '\'', '\\':
+			b.WriteByte(e)
+		case 'u', 'U':
+			r, err := p.unicodeEscape()
+			if err != nil {
+				return nil, err
+			}
+			b.WriteRune(r)
+			continue
+		default:
+			return nil, fmt.Errorf("invalid escape \\%c", e)
+		}
+		p.pos += 2
+	}
+
+	// the language tag or datatype of the literal isn't kept
+	if p.peek() == '@' {
+		p.pos++
+		for !p.eof() && (isLetterOrDigit(rune(p.peek())) || p.peek() == '-') {
+			p.pos++
+		}
+	} else if strings.HasPrefix(p.input[p.pos:], "^^") {
+		p.pos += 2
+		if _, err := p.term(false); err != nil {
+			return nil, err
+		}
+	}
+	return literal(b.String()), nil
+}
+
+func (p *turtleParser) unicodeEscape() (rune, error) {
+	size := 0
+	switch {
+	case strings.HasPrefix(p.input[p.pos:], `\u`):
+		size = 4
+	case strings.HasPrefix(p.input[p.pos:], `\U`):
+		size = 8
+	default:
+		return 0, fmt.Errorf("invalid escape")
+	}
+	p.pos += 2
+	if p.pos+size > len(p.input) {
+		return 0, fmt.Errorf("invalid unicode escape")
+	}
+	code, err := strconv.ParseUint(p.input[p.pos:p.pos+size], 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid unicode escape: %v", err)
+	}
+	p.pos += size
+	return rune(code), nil
+}
+
+func (p *turtleParser) number() (*gordfParser.Node, error) {
+	start := p.pos
+	if c := p.peek(); c == '+' || c == '-' {
+		p.pos++
+	}
+	for !p.eof() {
+		c := p.peek()
+		if (c >= '0' && c <= '9') || c == 'e' || c == 'E' ||
+			(c == '.' && p.pos+1 < len(p.input) && p.input[p.pos+1] >= '0' && p.input[p.pos+1] <= '9') ||
+			((c == '+' || c == '-') && (p.input[p.pos-1] == 'e' || p.input[p.pos-1] == 'E')) {
+			p.pos++
+			continue
+		}
+		break
+	}
+	value := p.input[start:p.pos]
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return nil, fmt.Errorf("invalid number %q", value)
+	}
+	return literal(value), nil
+}
+
+func isLetterOrDigit(r rune) bool {
+	return r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// isNameChar returns whether the byte can be part of a prefix, local name or
+// blank node label. Bytes of non-ASCII characters are.
+func isNameChar(r rune) bool {
+	return isLetterOrDigit(r) || r == '_' || r == '-' || r == '.' || r >= utf8.RuneSelf
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package syntax
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	gordfParser "github.com/spdx/gordf/rdfloader/parser"
+	"github.com/stretchr/testify/require"
+)
+
+// ntriples returns the triples in N-Triples, to compare graphs in tests
+func ntriples(t *testing.T, triples []*gordfParser.Triple) string {
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteNTriples(buf, triples))
+	return buf.String()
+}
+
+func Test_ParseTurtle(t *testing.T) {
+	triples, err := ParseTurtle(strings.NewReader(`
+# a comment
+@prefix spdx: <http://spdx.org/rdf/terms#> .
+PREFIX ex: <http://example.com/ns#>
+@base <http://example.com/doc> .
+
+<#SPDXRef-DOCUMENT> a spdx:SpdxDocument ;
+    spdx:name "doc \"one\"\n", 'two' ;
+    spdx:comment """multi
+line""" ;
+    spdx:describes [ a spdx:Package ; spdx:name "pkg"@en ] ;
+    ex:count 42 ;
+    ex:ok true ;
+    ex:typed "1"^^<http://www.w3.org/2001/XMLSchema#int> ;
+    ex:list ( "a" _:b ) ;
+    ex:escaped <http://example.com/A> .
+
+_:b ex:name "bé" .
+`))
+	require.NoError(t, err)
+	require.Equal(t, `<http://example.com/doc#SPDXRef-DOCUMENT> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://spdx.org/rdf/terms#SpdxDocument> .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://spdx.org/rdf/terms#name> "doc \"one\"\n" .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://spdx.org/rdf/terms#name> "two" .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://spdx.org/rdf/terms#comment> "multi\nline" .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://spdx.org/rdf/terms#describes> _:N1 .
+_:N1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://spdx.org/rdf/terms#Package> .
+_:N1 <http://spdx.org/rdf/terms#name> "pkg" .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://example.com/ns#count> "42" .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://example.com/ns#ok> "true" .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://example.com/ns#typed> "1" .
+_:N2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> "a" .
+_:N2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> _:N3 .
+_:N3 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> _:N4 .
+_:N3 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil> .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://example.com/ns#list> _:N2 .
+<http://example.com/doc#SPDXRef-DOCUMENT> <http://example.com/ns#escaped> <http://example.com/A> .
+_:N4 <http://example.com/ns#name> "bé" .
+`, ntriples(t, triples))
+
+	// subjects are shared IRI nodes and objects are resource nodes, as in
+	// the triples read from RDF/XML
+	require.Same(t, triples[0].Subject, triples[1].Subject)
+	require.True(t, triples[0].Object.NodeType == gordfParser.RESOURCELITERAL)
+	require.Same(t, triples[4].Object, triples[5].Subject)
+}
+
+func Test_ParseNTriples(t *testing.T) {
+	in := `<http://example.com/a> <http://example.com/p> "x\ty" .
+<http://example.com/a> <http://example.com/q> _:b1 .
+_:b1 <http://example.com/p> <http://example.com/c> .
+`
+	triples, err := ParseTurtle(strings.NewReader(in))
+	require.NoError(t, err)
+	require.Equal(t, strings.ReplaceAll(in, "_:b1", "_:N1"), ntriples(t, triples))
+}
+
+func Test_ParseTurtleErrors(t *testing.T) {
+	for _, in := range []string{
+		`<http://example.com/a> <http://example.com/p> "x"`,
+		`<http://example.com/a> ex:p "x" .`,
+		`<http://example.com/a> <http://example.com/p> "x .`,
+		`<http://example.com/a> <http://example.com/p> [ <http://example.com/p> "x" .`,
+		`"x" <http://example.com/p> "x" .`,
+	} {
+		_, err := ParseTurtle(strings.NewReader(in))
+		require.Error(t, err, in)
+	}
+}
+
+func Test_WriteTurtle(t *testing.T) {
+	in := `<http://spdx.org/rdf/terms#a> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://spdx.org/rdf/terms#Package> .
+<http://spdx.org/rdf/terms#a> <http://spdx.org/rdf/terms#checksum> _:c .
+<http://spdx.org/rdf/terms#a> <http://example.com/p> _:shared .
+<http://spdx.org/rdf/terms#a> <http://example.com/q> _:shared .
+_:c <http://spdx.org/rdf/terms#checksumValue> "a\"b" .
+_:shared <http://example.com/p> <http://spdx.org/rdf/terms#a> .
+_:x <http://example.com/p> _:y .
+_:y <http://example.com/p> _:x .
+`
+	triples, err := ParseTurtle(strings.NewReader(in))
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, WriteTurtle(buf, triples))
+	require.Contains(t, buf.String(), `spdx:a
+    a spdx:Package ;
+    spdx:checksum [
+        spdx:checksumValue "a\"b"
+    ] ;
+    <http://example.com/p> _:N2 ;`)
+
+	got, err := ParseTurtle(buf)
+	require.NoError(t, err)
+	require.Equal(t, len(triples), len(got))
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package jsonld
+
+import (
+	"io"
+
+	"github.com/spdx/tools-golang/rdf"
+	"github.com/spdx/tools-golang/rdf/internal/syntax"
+	"github.com/spdx/tools-golang/spdx/common"
+)
+
+type WriteOption func(*writer)
+
+type writer struct {
+	canonical bool
+}
+
+// Canonical writes the graph of the canonical document, as rdf.Canonical does
+func Canonical() WriteOption {
+	return func(w *writer) {
+		w.canonical = true
+	}
+}
+
+// Write takes an SPDX 2.2 or 2.3 Document and an io.Writer, and writes the
+// document to the writer in JSON-LD format, with the graph written by rdf.Write. The context of the
+// document is embedded, with the prefixes of the SPDX 2 RDF vocabularies.
+func Write(doc common.AnyDocument, w io.Writer, opts ...WriteOption) error {
+	wr := writer{}
+	for _, opt := range opts {
+		opt(&wr)
+	}
+
+	var rdfOpts []rdf.WriteOption
+	if wr.canonical {
+		rdfOpts = append(rdfOpts, rdf.Canonical())
+	}
+	triples, err := rdf.Triples(doc, rdfOpts...)
+	if err != nil {
+		return err
+	}
+	return syntax.WriteJSONLD(w, triples)
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package jsonld
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+)
+
+func Test_Write(t *testing.T) {
+	doc := example.Copy()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(&doc, buf))
+
+	// the context is embedded, so the document can be read without fetching it
+	var out map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	context, ok := out["@context"].(map[string]interface{})
+	require.True(t, ok, "no embedded @context")
+	require.Equal(t, "http://spdx.org/rdf/terms#", context["spdx"])
+}
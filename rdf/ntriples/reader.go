@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package ntriples
+
+import (
+	"io"
+
+	"github.com/spdx/tools-golang/rdf"
+	"github.com/spdx/tools-golang/rdf/internal/syntax"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/common"
+)
+
+// Read takes an io.Reader and returns a fully-parsed current model SPDX Document
+// or an error if any error is encountered.
+func Read(content io.Reader) (*spdx.Document, error) {
+	doc := spdx.Document{}
+	err := ReadInto(content, &doc)
+	return &doc, err
+}
+
+// ReadInto takes an io.Reader, reads in the SPDX document at the version provided
+// and converts to the doc version
+func ReadInto(content io.Reader, doc common.AnyDocument) error {
+	// N-Triples is a subset of Turtle
+	triples, err := syntax.ParseTurtle(content)
+	if err != nil {
+		return err
+	}
+	return rdf.ReadTriplesInto(triples, doc)
+}
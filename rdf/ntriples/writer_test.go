@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package ntriples
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+)
+
+func Test_Write(t *testing.T) {
+	doc := example.Copy()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(&doc, buf))
+
+	// one triple on each line, with full IRIs rather than prefixed names
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		require.True(t, strings.HasSuffix(line, " ."), line)
+		require.False(t, strings.HasPrefix(line, "@"), line)
+		require.NotContains(t, line, " spdx:", line)
+	}
+}
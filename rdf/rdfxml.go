@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package rdf
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/spdx/tools-golang/rdf/internal/syntax"
+)
+
+// namespaces declared on the rdf:RDF element, in the order they are written
+var namespaces = syntax.Namespaces
+
+// element is an RDF/XML element: a node, e.g. <spdx:Package>, or a property
+// of a node, e.g. <spdx:name>, with either text or child elements
+type element struct {
+	name     string
+	attrs    [][2]string
+	text     string
+	children []*element
+}
+
+// node returns a typed node, identified by about if it is not empty and blank
+// otherwise
+func node(name string, about string) *element {
+	e := &element{name: name}
+	if about != "" {
+		e.attrs = append(e.attrs, [2]string{"rdf:about", about})
+	}
+	return e
+}
+
+// add appends the properties that are not nil
+func (e *element) add(children ...*element) *element {
+	for _, child := range children {
+		if child != nil {
+			e.children = append(e.children, child)
+		}
+	}
+	return e
+}
+
+// literal returns a property with a text value, or nil if the value is empty
+func literal(name string, value string) *element {
+	if value == "" {
+		return nil
+	}
+	return &element{name: name, text: value}
+}
+
+// literals returns a property for each non-empty value
+func literals(name string, values []string) []*element {
+	var elements []*element
+	for _, value := range values {
+		if e := literal(name, value); e != nil {
+			elements = append(elements, e)
+		}
+	}
+	return elements
+}
+
+// resource returns a property referring to the resource uri, or nil if the uri
+// is empty
+func resource(name string, uri string) *element {
+	if uri == "" {
+		return nil
+	}
+	return &element{name: name, attrs: [][2]string{{"rdf:resource", uri}}}
+}
+
+// property returns a property whose value is the node, or nil if the node is nil
+func property(name string, value *element) *element {
+	if value == nil {
+		return nil
+	}
+	return &element{name: name, children: []*element{value}}
+}
+
+var (
+	textEscaper      = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	attributeEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+)
+
+// writeRDF writes the nodes as an RDF/XML document
+func writeRDF(w io.Writer, nodes []*element) error {
+	out := bufio.NewWriter(w)
+	out.WriteString("<?xml version=\"1.0\" encoding=\"utf-8\"?>\n<rdf:RDF")
+	for _, ns := range namespaces {
+		out.WriteString("\n    xmlns:" + ns[0] + "=\"" + attributeEscaper.Replace(ns[1]) + "\"")
+	}
+	out.WriteString(">\n")
+	for _, n := range nodes {
+		writeElement(out, n, 1)
+	}
+	out.WriteString("</rdf:RDF>\n")
+	return out.Flush()
+}
+
+func writeElement(out *bufio.Writer, e *element, depth int) {
+	indent := strings.Repeat("  ", depth)
+	out.WriteString(indent + "<" + e.name)
+	for _, attr := range e.attrs {
+		out.WriteString(" " + attr[0] + "=\"" + attributeEscaper.Replace(attr[1]) + "\"")
+	}
+	switch {
+	case len(e.children) > 0:
+		out.WriteString(">\n")
+		for _, child := range e.children {
+			writeElement(out, child, depth+1)
+		}
+		out.WriteString(indent + "</" + e.name + ">\n")
+	case e.text != "":
+		out.WriteString(">")
+		writeText(out, e.text)
+		out.WriteString("</" + e.name + ">\n")
+	default:
+		out.WriteString("/>\n")
+	}
+}
+
+// writeText escapes the text. Text starting with white space is written as a
+// CDATA section, as RDF/XML parsers may trim it otherwise.
+func writeText(out *bufio.Writer, text string) {
+	if strings.TrimLeft(text, " \t\r\n") != text && !strings.Contains(text, "]]>") {
+		out.WriteString("<![CDATA[" + text + "]]>")
+		return
+	}
+	out.WriteString(textEscaper.Replace(text))
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/spdx/tools-golang/convert"
 	"github.com/spdx/tools-golang/spdx"
 	"github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
+	v2_1_reader "github.com/spdx/tools-golang/spdx/v2/v2_1/rdf/reader"
 	"github.com/spdx/tools-golang/spdx/v2/v2_2"
 	v2_2_reader "github.com/spdx/tools-golang/spdx/v2/v2_2/rdf/reader"
 	"github.com/spdx/tools-golang/spdx/v2/v2_3"
@@ -37,13 +39,15 @@ func ReadInto(content io.Reader, doc common.AnyDocument) error {
 		return err
 	}
 
-	version, err := getSpdxVersion(rdfParserObj)
+	version, err := getSpdxVersion(rdfParserObj.Triples)
 	if err != nil {
 		return err
 	}
 
 	var data interface{}
 	switch version {
+	case v2_1.Version:
+		data, err = v2_1_reader.LoadFromGoRDFParser(rdfParserObj)
 	case v2_2.Version:
 		data, err = v2_2_reader.LoadFromGoRDFParser(rdfParserObj)
 	case v2_3.Version:
@@ -59,9 +63,52 @@ func ReadInto(content io.Reader, doc common.AnyDocument) error {
 	return convert.Document(data.(common.AnyDocument), doc)
 }
 
-func getSpdxVersion(parser *gordfParser.Parser) (string, error) {
+// ReadTriples takes the triples of an SPDX document read from an RDF syntax
+// other than RDF/XML, and returns a fully-parsed current model SPDX Document
+// or an error if any error is encountered.
+func ReadTriples(triples []*gordfParser.Triple) (*spdx.Document, error) {
+	doc := spdx.Document{}
+	err := ReadTriplesInto(triples, &doc)
+	return &doc, err
+}
+
+// ReadTriplesInto takes the triples of an SPDX document, as ReadTriples does,
+// reads in the SPDX document at the version provided and converts to the doc
+// version. IRI objects are resource nodes, as the objects of rdf:resource
+// attributes are in RDF/XML, and a blank node is the same *Node in each of
+// its triples.
+func ReadTriplesInto(triples []*gordfParser.Triple, doc common.AnyDocument) error {
+	if !convert.IsPtr(doc) {
+		return fmt.Errorf("doc to read into must be a pointer")
+	}
+
+	version, err := getSpdxVersion(triples)
+	if err != nil {
+		return err
+	}
+
+	var data interface{}
+	switch version {
+	case v2_1.Version:
+		data, err = v2_1_reader.LoadFromTriples(triples)
+	case v2_2.Version:
+		data, err = v2_2_reader.LoadFromTriples(triples)
+	case v2_3.Version:
+		data, err = v2_3_reader.LoadFromTriples(triples)
+	default:
+		return fmt.Errorf("unsupported SPDX version: '%v'", version)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return convert.Document(data.(common.AnyDocument), doc)
+}
+
+func getSpdxVersion(triples []*gordfParser.Triple) (string, error) {
 	version := ""
-	for _, node := range parser.Triples {
+	for _, node := range triples {
 		if node.Predicate.ID == "http://spdx.org/rdf/terms#specVersion" {
 			version = node.Object.ID
 			break
@@ -5,11 +5,14 @@ package rdf
 import (
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
 )
 
 func Test_Read(t *testing.T) {
@@ -28,3 +31,88 @@ func Test_Read(t *testing.T) {
 
 	assert.IsType(t, &spdx.Document{}, got)
 }
+
+const v2_1Document = `<?xml version="1.0" encoding="utf-8"?>
+<rdf:RDF
+    xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+    xmlns:rdfs="http://www.w3.org/2000/01/rdf-schema#"
+    xmlns:spdx="http://spdx.org/rdf/terms#"
+    xmlns:doap="http://usefulinc.com/ns/doap#">
+  <spdx:SpdxDocument rdf:about="http://spdx.org/spdxdocs/example-2.1#SPDXRef-DOCUMENT">
+    <spdx:specVersion>SPDX-2.1</spdx:specVersion>
+    <spdx:dataLicense rdf:resource="http://spdx.org/licenses/CC0-1.0"/>
+    <spdx:name>example-2.1</spdx:name>
+    <spdx:creationInfo>
+      <spdx:CreationInfo>
+        <spdx:creator>Tool: old-scanner-1.0</spdx:creator>
+        <spdx:created>2016-01-01T00:00:00Z</spdx:created>
+      </spdx:CreationInfo>
+    </spdx:creationInfo>
+    <spdx:relationship>
+      <spdx:Relationship>
+        <spdx:relationshipType rdf:resource="http://spdx.org/rdf/terms#relationshipType_describes"/>
+        <spdx:relatedSpdxElement>
+          <spdx:File rdf:about="http://spdx.org/spdxdocs/example-2.1#SPDXRef-main">
+            <spdx:fileName>./main.c</spdx:fileName>
+            <spdx:fileType rdf:resource="http://spdx.org/rdf/terms#fileType_source"/>
+            <spdx:checksum>
+              <spdx:Checksum>
+                <spdx:algorithm rdf:resource="http://spdx.org/rdf/terms#checksumAlgorithm_sha1"/>
+                <spdx:checksumValue>d6a770ba38583ed4bb4525bd96e50461655d2758</spdx:checksumValue>
+              </spdx:Checksum>
+            </spdx:checksum>
+            <spdx:licenseConcluded rdf:resource="http://spdx.org/licenses/MIT"/>
+            <spdx:licenseInfoInFile rdf:resource="http://spdx.org/licenses/MIT"/>
+            <spdx:copyrightText>Copyright 2016 Jane Doe</spdx:copyrightText>
+            <spdx:artifactOf>
+              <doap:Project>
+                <doap:name>Example</doap:name>
+                <doap:homepage>http://example.com/</doap:homepage>
+              </doap:Project>
+            </spdx:artifactOf>
+            <spdx:fileDependency>
+              <spdx:File rdf:about="http://spdx.org/spdxdocs/example-2.1#SPDXRef-util">
+                <spdx:fileName>./util.c</spdx:fileName>
+                <spdx:checksum>
+                  <spdx:Checksum>
+                    <spdx:algorithm rdf:resource="http://spdx.org/rdf/terms#checksumAlgorithm_sha1"/>
+                    <spdx:checksumValue>d6a770ba38583ed4bb4525bd96e50461655d2759</spdx:checksumValue>
+                  </spdx:Checksum>
+                </spdx:checksum>
+                <spdx:licenseConcluded rdf:resource="http://spdx.org/rdf/terms#noassertion"/>
+                <spdx:licenseInfoInFile rdf:resource="http://spdx.org/rdf/terms#noassertion"/>
+                <spdx:copyrightText rdf:resource="http://spdx.org/rdf/terms#noassertion"/>
+              </spdx:File>
+            </spdx:fileDependency>
+          </spdx:File>
+        </spdx:relatedSpdxElement>
+      </spdx:Relationship>
+    </spdx:relationship>
+  </spdx:SpdxDocument>
+</rdf:RDF>`
+
+func Test_ReadV2_1(t *testing.T) {
+	doc := v2_1.Document{}
+	err := ReadInto(strings.NewReader(v2_1Document), &doc)
+	require.NoError(t, err)
+
+	require.Equal(t, v2_1.Version, doc.SPDXVersion)
+	require.Equal(t, "example-2.1", doc.DocumentName)
+	require.Len(t, doc.Files, 2)
+
+	var main *v2_1.File
+	for _, file := range doc.Files {
+		if file.FileSPDXIdentifier == "main" {
+			main = file
+		}
+	}
+	require.NotNil(t, main)
+	require.Equal(t, []*v2_1.ArtifactOfProject{{Name: "Example", HomePage: "http://example.com/"}}, main.ArtifactOfProjects)
+	require.Equal(t, []string{"util"}, main.FileDependencies)
+
+	latest, err := Read(strings.NewReader(v2_1Document))
+	require.NoError(t, err)
+	require.Equal(t, spdx.Version, latest.SPDXVersion)
+	require.Len(t, latest.Files, 2)
+	require.Len(t, latest.Relationships, 1)
+}
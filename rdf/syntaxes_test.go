@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package rdf_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/rdf"
+	"github.com/spdx/tools-golang/rdf/internal/rdftest"
+	"github.com/spdx/tools-golang/rdf/jsonld"
+	"github.com/spdx/tools-golang/rdf/ntriples"
+	"github.com/spdx/tools-golang/rdf/turtle"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+// syntaxes are the RDF syntaxes other than RDF/XML, which all write the graph
+// built by rdf.Triples
+var syntaxes = []struct {
+	name     string
+	write    func(doc common.AnyDocument, w io.Writer, canonical bool) error
+	read     func(r io.Reader) (*spdx.Document, error)
+	readInto func(r io.Reader, doc common.AnyDocument) error
+}{
+	{
+		name: "turtle",
+		write: func(doc common.AnyDocument, w io.Writer, canonical bool) error {
+			if canonical {
+				return turtle.Write(doc, w, turtle.Canonical())
+			}
+			return turtle.Write(doc, w)
+		},
+		read:     turtle.Read,
+		readInto: turtle.ReadInto,
+	},
+	{
+		name: "ntriples",
+		write: func(doc common.AnyDocument, w io.Writer, canonical bool) error {
+			if canonical {
+				return ntriples.Write(doc, w, ntriples.Canonical())
+			}
+			return ntriples.Write(doc, w)
+		},
+		read:     ntriples.Read,
+		readInto: ntriples.ReadInto,
+	},
+	{
+		name: "jsonld",
+		write: func(doc common.AnyDocument, w io.Writer, canonical bool) error {
+			if canonical {
+				return jsonld.Write(doc, w, jsonld.Canonical())
+			}
+			return jsonld.Write(doc, w)
+		},
+		read:     jsonld.Read,
+		readInto: jsonld.ReadInto,
+	},
+}
+
+// fromTriples returns the document as read back from its RDF graph, which is
+// what reading it back from any RDF syntax returns, sorted by rdftest.Sort
+func fromTriples(t *testing.T, doc *spdx.Document) *spdx.Document {
+	triples, err := rdf.Triples(doc)
+	require.NoError(t, err)
+	got, err := rdf.ReadTriples(triples)
+	require.NoError(t, err)
+	rdftest.Sort(got)
+	return got
+}
+
+func Test_SyntaxesWriteExample(t *testing.T) {
+	for _, s := range syntaxes {
+		t.Run(s.name, func(t *testing.T) {
+			doc := example.Copy()
+
+			buf := &bytes.Buffer{}
+			require.NoError(t, s.write(&doc, buf, false))
+
+			got, err := s.read(buf)
+			require.NoError(t, err)
+			rdftest.Sort(got)
+			require.Equal(t, fromTriples(t, &doc), got)
+		})
+	}
+}
+
+func Test_SyntaxesWriteSample(t *testing.T) {
+	f, err := os.Open("../examples/sample-docs/rdf/SPDXRdfExample-v2.2.spdx.rdf")
+	require.NoError(t, err)
+	defer f.Close()
+
+	doc, err := rdf.Read(f)
+	require.NoError(t, err)
+
+	for _, s := range syntaxes {
+		t.Run(s.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			require.NoError(t, s.write(doc, buf, false))
+
+			got, err := s.read(bytes.NewReader(buf.Bytes()))
+			require.NoError(t, err)
+			rdftest.Sort(got)
+			require.Equal(t, fromTriples(t, doc), got)
+
+			into := v2_2.Document{}
+			require.NoError(t, s.readInto(bytes.NewReader(buf.Bytes()), &into))
+			require.Equal(t, doc.DocumentNamespace, into.DocumentNamespace)
+			require.Len(t, into.Packages, len(doc.Packages))
+		})
+	}
+}
+
+func Test_SyntaxesWriteCanonical(t *testing.T) {
+	for _, s := range syntaxes {
+		t.Run(s.name, func(t *testing.T) {
+			doc := example.Copy()
+			canonical, err := spdxlib.Canonical(&doc)
+			require.NoError(t, err)
+
+			a := &bytes.Buffer{}
+			require.NoError(t, s.write(&doc, a, true))
+			b := &bytes.Buffer{}
+			require.NoError(t, s.write(canonical, b, false))
+			require.Equal(t, b.String(), a.String())
+			require.Equal(t, example.Copy(), doc)
+
+			require.Error(t, s.write(nil, &bytes.Buffer{}, false))
+		})
+	}
+}
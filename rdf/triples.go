@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package rdf
+
+import (
+	"fmt"
+	"strings"
+
+	gordfParser "github.com/spdx/gordf/rdfloader/parser"
+
+	"github.com/spdx/tools-golang/spdx/common"
+)
+
+// Triples takes an SPDX 2.2 or 2.3 Document and returns the triples of its RDF
+// graph, as written by Write, for serialization in other RDF syntaxes. The
+// triples are in the model read by ReadTriples.
+func Triples(doc common.AnyDocument, opts ...WriteOption) ([]*gordfParser.Triple, error) {
+	nodes, err := build(doc, opts)
+	if err != nil {
+		return nil, err
+	}
+	g := &graph{iris: map[string]*gordfParser.Node{}}
+	for _, n := range nodes {
+		if _, err := g.node(n); err != nil {
+			return nil, err
+		}
+	}
+	return g.triples, nil
+}
+
+// graph collects the triples of RDF nodes
+type graph struct {
+	triples []*gordfParser.Triple
+
+	// iris holds the subject node of each IRI
+	iris map[string]*gordfParser.Node
+
+	// blanks is the number of blank nodes so far
+	blanks int
+}
+
+// node adds the triples of a typed node and of the nodes it contains, and
+// returns its subject
+func (g *graph) node(e *element) (*gordfParser.Node, error) {
+	var subject *gordfParser.Node
+	if about := e.attr("rdf:about"); about != "" {
+		subject = g.iris[about]
+		if subject == nil {
+			subject = &gordfParser.Node{NodeType: gordfParser.IRI, ID: about}
+			g.iris[about] = subject
+		}
+	} else {
+		g.blanks++
+		subject = &gordfParser.Node{NodeType: gordfParser.BLANK, ID: fmt.Sprintf("N%d", g.blanks)}
+	}
+
+	typ, err := expand(e.name)
+	if err != nil {
+		return nil, err
+	}
+	g.add(subject, gordfParser.RDFNS+"type", &gordfParser.Node{NodeType: gordfParser.RESOURCELITERAL, ID: typ})
+
+	for _, p := range e.children {
+		predicate, err := expand(p.name)
+		if err != nil {
+			return nil, err
+		}
+		var object *gordfParser.Node
+		switch {
+		case p.attr("rdf:resource") != "":
+			object = &gordfParser.Node{NodeType: gordfParser.RESOURCELITERAL, ID: p.attr("rdf:resource")}
+		case len(p.children) > 0:
+			if object, err = g.node(p.children[0]); err != nil {
+				return nil, err
+			}
+		default:
+			object = &gordfParser.Node{NodeType: gordfParser.LITERAL, ID: p.text}
+		}
+		g.add(subject, predicate, object)
+	}
+	return subject, nil
+}
+
+func (g *graph) add(subject *gordfParser.Node, predicate string, object *gordfParser.Node) {
+	g.triples = append(g.triples, &gordfParser.Triple{
+		Subject:   subject,
+		Predicate: &gordfParser.Node{NodeType: gordfParser.IRI, ID: predicate},
+		Object:    object,
+	})
+}
+
+// attr returns the value of an attribute of the element, or "" if it has none
+func (e *element) attr(name string) string {
+	for _, attr := range e.attrs {
+		if attr[0] == name {
+			return attr[1]
+		}
+	}
+	return ""
+}
+
+// expand returns the IRI of a prefixed element name
+func expand(name string) (string, error) {
+	parts := strings.SplitN(name, ":", 2)
+	if len(parts) == 2 {
+		for _, ns := range namespaces {
+			if ns[0] == parts[0] {
+				return ns[1] + parts[1], nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no namespace for %s", name)
+}
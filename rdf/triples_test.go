@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package rdf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+)
+
+func Test_Triples(t *testing.T) {
+	want := example.Copy()
+
+	triples, err := Triples(&want)
+	require.NoError(t, err)
+
+	got, err := ReadTriples(triples)
+	require.NoError(t, err)
+
+	normalize(t, &want)
+	normalize(t, got)
+	require.Equal(t, &want, got)
+
+	into := v2_3.Document{}
+	require.NoError(t, ReadTriplesInto(triples, &into))
+	require.Equal(t, got.DocumentNamespace, into.DocumentNamespace)
+	require.Error(t, ReadTriplesInto(triples, into))
+
+	_, err = ReadTriples(nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package turtle
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+const document = `@prefix spdx: <http://spdx.org/rdf/terms#> .
+@prefix doap: <http://usefulinc.com/ns/doap#> .
+@base <http://spdx.org/spdxdocs/example-2.3> .
+
+<#SPDXRef-DOCUMENT> a spdx:SpdxDocument ;
+    spdx:specVersion "SPDX-2.3" ;
+    spdx:dataLicense <http://spdx.org/licenses/CC0-1.0> ;
+    spdx:name "example-2.3" ;
+    spdx:creationInfo [
+        a spdx:CreationInfo ;
+        spdx:creator "Tool: scanner-1.0" ;
+        spdx:created "2023-01-01T00:00:00Z"
+    ] ;
+    spdx:relationship [
+        a spdx:Relationship ;
+        spdx:relationshipType spdx:relationshipType_describes ;
+        spdx:relatedSpdxElement <#SPDXRef-main>
+    ] .
+
+<#SPDXRef-main> a spdx:File ;
+    spdx:fileName "./main.c" ;
+    spdx:checksum [
+        a spdx:Checksum ;
+        spdx:algorithm spdx:checksumAlgorithm_sha1 ;
+        spdx:checksumValue "d6a770ba38583ed4bb4525bd96e50461655d2758"
+    ] ;
+    spdx:licenseConcluded <http://spdx.org/licenses/MIT> ;
+    spdx:licenseInfoInFile <http://spdx.org/licenses/MIT> ;
+    spdx:copyrightText "Copyright 2023 Jane Doe" ;
+    spdx:artifactOf <http://example.com/doap.rdf> .
+
+<http://example.com/doap.rdf> a doap:Project ;
+    doap:name "Example" .
+`
+
+func Test_Read(t *testing.T) {
+	doc := v2_3.Document{}
+	require.NoError(t, ReadInto(strings.NewReader(document), &doc))
+
+	require.Equal(t, v2_3.Version, doc.SPDXVersion)
+	require.Equal(t, "example-2.3", doc.DocumentName)
+	require.Equal(t, "http://spdx.org/spdxdocs/example-2.3", doc.DocumentNamespace)
+	require.Equal(t, []string{"Tool: scanner-1.0"}, creators(doc.CreationInfo.Creators))
+
+	require.Len(t, doc.Files, 1)
+	file := doc.Files[0]
+	require.Equal(t, common.ElementID("main"), file.FileSPDXIdentifier)
+	require.Equal(t, "MIT", file.LicenseConcluded)
+	require.Equal(t, []*v2_3.ArtifactOfProject{{Name: "Example", URI: "http://example.com/doap.rdf"}}, file.ArtifactOfProjects)
+
+	require.Len(t, doc.Relationships, 1)
+	require.Equal(t, common.ElementID("main"), doc.Relationships[0].RefB.ElementRefID)
+
+	_, err := Read(strings.NewReader(`<http://example.com/a> <http://example.com/p> "x" .`))
+	require.Error(t, err)
+	require.Error(t, ReadInto(strings.NewReader(document), doc))
+}
+
+func creators(creators []common.Creator) []string {
+	var out []string
+	for _, c := range creators {
+		out = append(out, c.CreatorType+": "+c.Creator)
+	}
+	return out
+}
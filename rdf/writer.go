@@ -0,0 +1,749 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package rdf
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spdx/tools-golang/convert"
+	"github.com/spdx/tools-golang/licensing"
+	"github.com/spdx/tools-golang/licensing/licenselist"
+	"github.com/spdx/tools-golang/spdx/common"
+	v2common "github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+	v2_3_reader "github.com/spdx/tools-golang/spdx/v2/v2_3/rdf/reader"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+const (
+	nsSPDX        = "http://spdx.org/rdf/terms#"
+	licensesURI   = "http://spdx.org/licenses/"
+	referencesURI = "http://spdx.org/rdf/references/"
+
+	spdxRefPrefix     = "SPDXRef-"
+	documentRefPrefix = "DocumentRef-"
+)
+
+type WriteOption func(*writer)
+
+type writer struct {
+	canonical bool
+}
+
+// Canonical writes the document as returned by spdxlib.Canonical
+func Canonical() WriteOption {
+	return func(w *writer) {
+		w.canonical = true
+	}
+}
+
+// Write takes an SPDX 2.2 or 2.3 Document and an io.Writer, and writes the
+// document to the writer in RDF/XML format, using the vocabulary read by Read.
+func Write(doc common.AnyDocument, w io.Writer, opts ...WriteOption) error {
+	nodes, err := build(doc, opts)
+	if err != nil {
+		return err
+	}
+	return writeRDF(w, nodes)
+}
+
+// build returns the RDF nodes of the document
+func build(doc common.AnyDocument, opts []WriteOption) ([]*element, error) {
+	if doc == nil {
+		return nil, fmt.Errorf("nil document")
+	}
+	wr := &writer{}
+	for _, opt := range opts {
+		opt(wr)
+	}
+	if wr.canonical {
+		var err error
+		if doc, err = spdxlib.Canonical(doc); err != nil {
+			return nil, err
+		}
+	}
+
+	var version string
+	switch convert.FromPtr(doc).(type) {
+	case v2_2.Document:
+		version = v2_2.Version
+	case v2_3.Document:
+		version = v2_3.Version
+	default:
+		return nil, fmt.Errorf("unsupported document type for RDF: %s", convert.Describe(doc))
+	}
+
+	var latest v2_3.Document
+	if err := convert.Document(doc, &latest); err != nil {
+		return nil, err
+	}
+
+	b, err := newBuilder(&latest, version)
+	if err != nil {
+		return nil, err
+	}
+	return b.build()
+}
+
+// builder turns a document into RDF nodes
+type builder struct {
+	doc     *v2_3.Document
+	version string
+
+	// namespace is the base URI of the elements of the document
+	namespace string
+
+	// externalDocuments maps DocumentRef IDs, without prefix, to the document namespaces
+	externalDocuments map[string]string
+
+	// elements holds the node of each package, file and snippet, and the document
+	elements map[v2common.ElementID]*element
+
+	// licenses holds the URIs of LicenseRef- nodes written so far
+	licenses map[string]bool
+}
+
+func newBuilder(doc *v2_3.Document, version string) (*builder, error) {
+	if doc.DocumentNamespace == "" {
+		return nil, fmt.Errorf("an RDF document requires a document namespace")
+	}
+	if doc.SPDXIdentifier == "" {
+		doc.SPDXIdentifier = "DOCUMENT"
+	}
+	b := &builder{
+		doc:               doc,
+		version:           version,
+		namespace:         doc.DocumentNamespace,
+		externalDocuments: map[string]string{},
+		elements:          map[v2common.ElementID]*element{},
+		licenses:          map[string]bool{},
+	}
+	for _, ref := range doc.ExternalDocumentReferences {
+		b.externalDocuments[strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)] = ref.URI
+	}
+	for _, l := range doc.OtherLicenses {
+		if l != nil {
+			b.licenses[b.licenseRefURI("", l.LicenseIdentifier)] = true
+		}
+	}
+	return b, nil
+}
+
+func (b *builder) build() ([]*element, error) {
+	docNode, err := b.document()
+	if err != nil {
+		return nil, err
+	}
+	nodes := []*element{docNode}
+	b.elements[b.doc.SPDXIdentifier] = docNode
+
+	for _, pkg := range b.doc.Packages {
+		if pkg == nil {
+			continue
+		}
+		n, err := b.pkg(pkg)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+		b.elements[pkg.PackageSPDXIdentifier] = n
+	}
+
+	var snippets []*v2_3.Snippet
+	for _, file := range b.files() {
+		n, err := b.file(file)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+		b.elements[file.FileSPDXIdentifier] = n
+
+		ids := make([]v2common.ElementID, 0, len(file.Snippets))
+		for id := range file.Snippets {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		for _, id := range ids {
+			if file.Snippets[id] != nil {
+				snippets = append(snippets, file.Snippets[id])
+			}
+		}
+	}
+	for i := range b.doc.Snippets {
+		snippets = append(snippets, &b.doc.Snippets[i])
+	}
+
+	for _, snippet := range snippets {
+		if b.elements[snippet.SnippetSPDXIdentifier] != nil {
+			continue
+		}
+		n, err := b.snippet(snippet)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, n)
+		b.elements[snippet.SnippetSPDXIdentifier] = n
+	}
+
+	// relationships and annotations are properties of the element they are about
+	for _, r := range b.doc.Relationships {
+		if r == nil {
+			continue
+		}
+		from, err := b.element(r.RefA, "relationship")
+		if err != nil {
+			return nil, err
+		}
+		rel, err := b.relationship(r)
+		if err != nil {
+			return nil, err
+		}
+		from.add(property("spdx:relationship", rel))
+	}
+	for _, a := range b.doc.Annotations {
+		if a == nil {
+			continue
+		}
+		target := a.AnnotationSPDXIdentifier
+		if target == (v2common.DocElementID{}) {
+			target = v2common.MakeDocElementID("", string(b.doc.SPDXIdentifier))
+		}
+		on, err := b.element(target, "annotation")
+		if err != nil {
+			return nil, err
+		}
+		on.add(property("spdx:annotation", annotation(a)))
+	}
+
+	return nodes, nil
+}
+
+// files returns the files of the packages followed by the files of the
+// document, each once
+func (b *builder) files() []*v2_3.File {
+	seen := map[v2common.ElementID]bool{}
+	var files []*v2_3.File
+	add := func(list []*v2_3.File) {
+		for _, file := range list {
+			if file != nil && !seen[file.FileSPDXIdentifier] {
+				seen[file.FileSPDXIdentifier] = true
+				files = append(files, file)
+			}
+		}
+	}
+	for _, pkg := range b.doc.Packages {
+		if pkg != nil {
+			add(pkg.Files)
+		}
+	}
+	add(b.doc.Files)
+	return files
+}
+
+// element returns the node of the element of this document with the ID
+func (b *builder) element(id v2common.DocElementID, what string) (*element, error) {
+	if id.DocumentRefID != "" || id.SpecialID != "" {
+		return nil, fmt.Errorf("%s of %s: only elements of this document can have a %s in RDF", what, v2common.RenderDocElementID(id), what)
+	}
+	n := b.elements[id.ElementRefID]
+	if n == nil {
+		return nil, fmt.Errorf("%s of %s: element not found", what, v2common.RenderDocElementID(id))
+	}
+	return n, nil
+}
+
+// elementURI returns the URI of an element of this document
+func (b *builder) elementURI(id v2common.ElementID) string {
+	return b.namespace + "#" + spdxRefPrefix + strings.TrimPrefix(string(id), spdxRefPrefix)
+}
+
+// docElementURI returns the URI of an element of this or an external document
+func (b *builder) docElementURI(id v2common.DocElementID) string {
+	switch {
+	case id.SpecialID != "":
+		return nsSPDX + strings.ToLower(id.SpecialID)
+	case id.DocumentRefID != "":
+		ref := strings.TrimPrefix(id.DocumentRefID, documentRefPrefix)
+		if uri, ok := b.externalDocuments[ref]; ok {
+			return uri + "#" + spdxRefPrefix + string(id.ElementRefID)
+		}
+		return b.namespace + "#" + v2common.RenderDocElementID(id)
+	}
+	return b.elementURI(id.ElementRefID)
+}
+
+func (b *builder) document() (*element, error) {
+	doc := b.doc
+	n := node("spdx:SpdxDocument", b.elementURI(doc.SPDXIdentifier))
+
+	dataLicense, err := b.license("spdx:dataLicense", doc.DataLicense)
+	if err != nil {
+		return nil, fmt.Errorf("dataLicense: %w", err)
+	}
+	n.add(
+		literal("spdx:specVersion", b.version),
+		dataLicense,
+		literal("spdx:name", doc.DocumentName),
+	)
+
+	if ci := doc.CreationInfo; ci != nil {
+		info := node("spdx:CreationInfo", "").add(literal("spdx:licenseListVersion", ci.LicenseListVersion))
+		for _, c := range ci.Creators {
+			info.add(literal("spdx:creator", entity(c.CreatorType, c.Creator)))
+		}
+		info.add(
+			literal("spdx:created", ci.Created),
+			literal("rdfs:comment", ci.CreatorComment),
+		)
+		n.add(property("spdx:creationInfo", info))
+	}
+
+	n.add(literal("rdfs:comment", doc.DocumentComment))
+
+	for _, ref := range doc.ExternalDocumentReferences {
+		n.add(property("spdx:externalDocumentRef", node("spdx:ExternalDocumentRef", "").add(
+			literal("spdx:externalDocumentId", documentRefPrefix+strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)),
+			checksum(ref.Checksum),
+			resource("spdx:spdxDocument", ref.URI),
+		)))
+	}
+
+	for _, l := range doc.OtherLicenses {
+		if l == nil {
+			continue
+		}
+		info := node("spdx:ExtractedLicensingInfo", b.licenseRefURI("", l.LicenseIdentifier)).add(
+			literal("spdx:licenseId", l.LicenseIdentifier),
+			literal("spdx:extractedText", l.ExtractedText),
+			literal("spdx:name", l.LicenseName),
+			literal("rdfs:comment", l.LicenseComment),
+		)
+		info.add(literals("rdfs:seeAlso", l.LicenseCrossReferences)...)
+		n.add(property("spdx:hasExtractedLicensingInfo", info))
+	}
+
+	for _, r := range doc.Reviews {
+		if r == nil {
+			continue
+		}
+		n.add(property("spdx:reviewed", node("spdx:Review", "").add(
+			literal("spdx:reviewer", entity(r.ReviewerType, r.Reviewer)),
+			literal("spdx:reviewDate", r.ReviewDate),
+			literal("rdfs:comment", r.ReviewComment),
+		)))
+	}
+
+	return n, nil
+}
+
+func (b *builder) pkg(pkg *v2_3.Package) (*element, error) {
+	n := node("spdx:Package", b.elementURI(pkg.PackageSPDXIdentifier)).add(
+		literal("spdx:name", pkg.PackageName),
+		literal("spdx:versionInfo", pkg.PackageVersion),
+		literal("spdx:packageFileName", pkg.PackageFileName),
+	)
+	if s := pkg.PackageSupplier; s != nil {
+		n.add(literal("spdx:supplier", entity(s.SupplierType, s.Supplier)))
+	}
+	if o := pkg.PackageOriginator; o != nil {
+		n.add(literal("spdx:originator", entity(o.OriginatorType, o.Originator)))
+	}
+	n.add(
+		specialOrLiteral("spdx:downloadLocation", pkg.PackageDownloadLocation),
+		literal("spdx:filesAnalyzed", strconv.FormatBool(pkg.FilesAnalyzed)),
+	)
+	if code := pkg.PackageVerificationCode; code != nil {
+		n.add(property("spdx:packageVerificationCode", node("spdx:PackageVerificationCode", "").add(
+			literal("spdx:packageVerificationCodeValue", code.Value),
+		).add(literals("spdx:packageVerificationCodeExcludedFile", code.ExcludedFiles)...)))
+	}
+	for _, c := range pkg.PackageChecksums {
+		n.add(checksum(c))
+	}
+	n.add(
+		literal("doap:homepage", pkg.PackageHomePage),
+		literal("spdx:sourceInfo", pkg.PackageSourceInfo),
+	)
+
+	concluded, err := b.license("spdx:licenseConcluded", pkg.PackageLicenseConcluded)
+	if err != nil {
+		return nil, fmt.Errorf("package %s licenseConcluded: %w", v2common.RenderElementID(pkg.PackageSPDXIdentifier), err)
+	}
+	n.add(concluded)
+	for _, l := range pkg.PackageLicenseInfoFromFiles {
+		n.add(resource("spdx:licenseInfoFromFiles", b.licenseURI(l)))
+	}
+	declared, err := b.license("spdx:licenseDeclared", pkg.PackageLicenseDeclared)
+	if err != nil {
+		return nil, fmt.Errorf("package %s licenseDeclared: %w", v2common.RenderElementID(pkg.PackageSPDXIdentifier), err)
+	}
+	n.add(
+		declared,
+		literal("spdx:licenseComments", pkg.PackageLicenseComments),
+		literal("spdx:copyrightText", pkg.PackageCopyrightText),
+		literal("spdx:summary", pkg.PackageSummary),
+		literal("spdx:description", pkg.PackageDescription),
+		literal("rdfs:comment", pkg.PackageComment),
+	)
+
+	for _, ref := range pkg.PackageExternalReferences {
+		if ref == nil {
+			continue
+		}
+		refType := ref.RefType
+		if !strings.Contains(refType, ":") {
+			refType = referencesURI + refType
+		}
+		n.add(property("spdx:externalRef", node("spdx:ExternalRef", "").add(
+			resource("spdx:referenceCategory", nsSPDX+"referenceCategory_"+camelCase(ref.Category)),
+			resource("spdx:referenceType", refType),
+			literal("spdx:referenceLocator", ref.Locator),
+			literal("rdfs:comment", ref.ExternalRefComment),
+		)))
+	}
+
+	for _, file := range pkg.Files {
+		if file != nil {
+			n.add(resource("spdx:hasFile", b.elementURI(file.FileSPDXIdentifier)))
+		}
+	}
+	n.add(literals("spdx:attributionText", pkg.PackageAttributionTexts)...)
+
+	if b.version == v2_3.Version {
+		if pkg.PrimaryPackagePurpose != "" {
+			n.add(resource("spdx:primaryPackagePurpose", nsSPDX+"purpose_"+strings.ToLower(strings.ReplaceAll(pkg.PrimaryPackagePurpose, "-", "_"))))
+		}
+		n.add(
+			literal("spdx:releaseDate", pkg.ReleaseDate),
+			literal("spdx:builtDate", pkg.BuiltDate),
+			literal("spdx:validUntilDate", pkg.ValidUntilDate),
+		)
+	}
+
+	for i := range pkg.Annotations {
+		n.add(property("spdx:annotation", annotation(&pkg.Annotations[i])))
+	}
+	return n, nil
+}
+
+func (b *builder) file(file *v2_3.File) (*element, error) {
+	n := node("spdx:File", b.elementURI(file.FileSPDXIdentifier)).add(literal("spdx:fileName", file.FileName))
+	for _, t := range file.FileTypes {
+		n.add(resource("spdx:fileType", nsSPDX+"fileType_"+strings.ToLower(t)))
+	}
+	for _, c := range file.Checksums {
+		n.add(checksum(c))
+	}
+
+	concluded, err := b.license("spdx:licenseConcluded", file.LicenseConcluded)
+	if err != nil {
+		return nil, fmt.Errorf("file %s licenseConcluded: %w", v2common.RenderElementID(file.FileSPDXIdentifier), err)
+	}
+	n.add(concluded)
+	for _, l := range file.LicenseInfoInFiles {
+		info, err := b.license("spdx:licenseInfoInFile", l)
+		if err != nil {
+			return nil, fmt.Errorf("file %s licenseInfoInFile: %w", v2common.RenderElementID(file.FileSPDXIdentifier), err)
+		}
+		n.add(info)
+	}
+	n.add(
+		literal("spdx:licenseComments", file.LicenseComments),
+		literal("spdx:copyrightText", file.FileCopyrightText),
+	)
+
+	for _, artifact := range file.ArtifactOfProjects {
+		if artifact == nil {
+			continue
+		}
+		n.add(property("spdx:artifactOf", node("doap:Project", artifact.URI).add(
+			literal("doap:name", artifact.Name),
+			literal("doap:homepage", artifact.HomePage),
+		)))
+	}
+
+	n.add(
+		literal("rdfs:comment", file.FileComment),
+		literal("spdx:noticeText", file.FileNotice),
+	)
+	n.add(literals("spdx:fileContributor", file.FileContributors)...)
+	for _, dependency := range file.FileDependencies {
+		n.add(resource("spdx:fileDependency", b.elementURI(v2common.ElementID(dependency))))
+	}
+	n.add(literals("spdx:attributionText", file.FileAttributionTexts)...)
+
+	for i := range file.Annotations {
+		n.add(property("spdx:annotation", annotation(&file.Annotations[i])))
+	}
+	return n, nil
+}
+
+func (b *builder) snippet(snippet *v2_3.Snippet) (*element, error) {
+	fileURI := b.elementURI(snippet.SnippetFromFileSPDXIdentifier)
+	n := node("spdx:Snippet", b.elementURI(snippet.SnippetSPDXIdentifier)).add(
+		resource("spdx:snippetFromFile", fileURI),
+	)
+	for _, r := range snippet.Ranges {
+		n.add(property("spdx:range", node("ptr:StartEndPointer", "").add(
+			pointer("ptr:startPointer", r.StartPointer, r.EndPointer, fileURI),
+			pointer("ptr:endPointer", r.EndPointer, r.StartPointer, fileURI),
+		)))
+	}
+
+	id := v2common.RenderElementID(snippet.SnippetSPDXIdentifier)
+	concluded, err := b.license("spdx:licenseConcluded", snippet.SnippetLicenseConcluded)
+	if err != nil {
+		return nil, fmt.Errorf("snippet %s licenseConcluded: %w", id, err)
+	}
+	n.add(concluded)
+	for _, l := range snippet.LicenseInfoInSnippet {
+		info, err := b.license("spdx:licenseInfoInSnippet", l)
+		if err != nil {
+			return nil, fmt.Errorf("snippet %s licenseInfoInSnippet: %w", id, err)
+		}
+		n.add(info)
+	}
+	n.add(
+		literal("spdx:licenseComments", snippet.SnippetLicenseComments),
+		literal("spdx:copyrightText", snippet.SnippetCopyrightText),
+		literal("spdx:name", snippet.SnippetName),
+		literal("rdfs:comment", snippet.SnippetComment),
+	)
+	return n, nil
+}
+
+// pointer returns a byte offset pointer, or a line pointer if the range is
+// given in lines
+func pointer(name string, p v2common.SnippetRangePointer, other v2common.SnippetRangePointer, fileURI string) *element {
+	if p.Offset == 0 && other.Offset == 0 && (p.LineNumber != 0 || other.LineNumber != 0) {
+		return property(name, node("ptr:LineCharPointer", "").add(
+			resource("ptr:reference", fileURI),
+			literal("ptr:lineNumber", strconv.Itoa(p.LineNumber)),
+		))
+	}
+	return property(name, node("ptr:ByteOffsetPointer", "").add(
+		resource("ptr:reference", fileURI),
+		literal("ptr:offset", strconv.Itoa(p.Offset)),
+	))
+}
+
+func (b *builder) relationship(r *v2_3.Relationship) (*element, error) {
+	relationshipType, err := relationshipType(r.Relationship)
+	if err != nil {
+		return nil, err
+	}
+	return node("spdx:Relationship", "").add(
+		resource("spdx:relationshipType", nsSPDX+v2_3_reader.PREFIX_RELATIONSHIP_TYPE+relationshipType),
+		resource("spdx:relatedSpdxElement", b.docElementURI(r.RefB)),
+		literal("rdfs:comment", r.RelationshipComment),
+	), nil
+}
+
+// relationshipType returns the RDF name of a relationship type, which may be
+// given as in tag-value ("DYNAMIC_LINK") or as in RDF ("dynamicLink")
+func relationshipType(t string) (string, error) {
+	key := strings.ReplaceAll(t, "_", "")
+	for _, name := range v2_3_reader.AllRelationshipTypes() {
+		if strings.EqualFold(name, key) {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("relationship type %q is not supported in RDF", t)
+}
+
+func annotation(a *v2_3.Annotation) *element {
+	return node("spdx:Annotation", "").add(
+		literal("spdx:annotator", entity(a.Annotator.AnnotatorType, a.Annotator.Annotator)),
+		literal("spdx:annotationDate", a.AnnotationDate),
+		resource("spdx:annotationType", nsSPDX+"annotationType_"+strings.ToLower(a.AnnotationType)),
+		literal("rdfs:comment", a.AnnotationComment),
+	)
+}
+
+func checksum(c v2common.Checksum) *element {
+	if c.Algorithm == "" && c.Value == "" {
+		return nil
+	}
+	algorithm := strings.ToLower(string(c.Algorithm))
+	if strings.HasPrefix(algorithm, "blake2b-") {
+		algorithm = strings.ReplaceAll(algorithm, "-", "")
+	} else {
+		algorithm = strings.ReplaceAll(algorithm, "-", "_")
+	}
+	return property("spdx:checksum", node("spdx:Checksum", "").add(
+		resource("spdx:algorithm", nsSPDX+"checksumAlgorithm_"+algorithm),
+		literal("spdx:checksumValue", c.Value),
+	))
+}
+
+// entity renders a creator, supplier, originator, annotator or reviewer as
+// "Type: Name", or just the name if it has no type, e.g. "NOASSERTION"
+func entity(entityType string, name string) string {
+	if entityType == "" {
+		return name
+	}
+	return entityType + ": " + name
+}
+
+// specialOrLiteral returns a reference to spdx:none or spdx:noassertion for
+// NONE and NOASSERTION, and a literal otherwise
+func specialOrLiteral(name string, value string) *element {
+	switch value {
+	case "NONE", "NOASSERTION":
+		return resource(name, nsSPDX+strings.ToLower(value))
+	}
+	return literal(name, value)
+}
+
+// camelCase turns an upper-case name with "-" or "_" separators into camel
+// case, e.g. "PACKAGE-MANAGER" into "packageManager"
+func camelCase(s string) string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool { return r == '-' || r == '_' })
+	for i := 1; i < len(words); i++ {
+		words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+	}
+	return strings.Join(words, "")
+}
+
+// license returns the property for a license expression, or nil if the
+// expression is empty
+func (b *builder) license(name string, expression string) (*element, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+	e, err := licensing.Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	value := b.licenseNode(e)
+	if value.name == "" {
+		// a reference to a license node defined elsewhere
+		value.name = name
+		return value, nil
+	}
+	return property(name, value), nil
+}
+
+// licenseNode returns the node of a license expression. References to nodes
+// are returned as unnamed rdf:resource elements.
+func (b *builder) licenseNode(e licensing.Expression) *element {
+	switch e := e.(type) {
+	case licensing.Special:
+		return resource("", nsSPDX+strings.ToLower(string(e)))
+	case *licensing.License:
+		if e.OrLater {
+			return node("spdx:OrLaterOperator", "").add(property("spdx:member", simpleLicense(e.ID)))
+		}
+		if isListed(e.ID) {
+			return resource("", licensesURI+e.ID)
+		}
+		return simpleLicense(e.ID)
+	case *licensing.LicenseRef:
+		uri := b.licenseRefURI(e.DocumentRef, e.LicenseRef)
+		if b.licenses[uri] {
+			return resource("", uri)
+		}
+		// licenses that are not defined in the document are written once,
+		// with just their ID
+		b.licenses[uri] = true
+		return node("spdx:ExtractedLicensingInfo", uri).add(literal("spdx:licenseId", e.String()))
+	case *licensing.With:
+		var member *element
+		switch l := e.License.(type) {
+		case *licensing.License:
+			if l.OrLater {
+				member = b.licenseNode(l)
+			} else {
+				member = simpleLicense(l.ID)
+			}
+		default:
+			member = node("spdx:ExtractedLicensingInfo", "").add(literal("spdx:licenseId", l.String()))
+		}
+		return node("spdx:WithExceptionOperator", "").add(
+			property("spdx:member", member),
+			property("spdx:licenseException", node("spdx:LicenseException", "").add(
+				literal("spdx:licenseExceptionId", e.Exception),
+			)),
+		)
+	case *licensing.And:
+		return b.licenseSet("spdx:ConjunctiveLicenseSet", e)
+	case *licensing.Or:
+		return b.licenseSet("spdx:DisjunctiveLicenseSet", e)
+	}
+	return nil
+}
+
+// licenseSet returns a license set with the operands of nested operators of
+// the same kind as its members
+func (b *builder) licenseSet(name string, e licensing.Expression) *element {
+	set := node(name, "")
+	var addMembers func(e licensing.Expression)
+	addMembers = func(e licensing.Expression) {
+		switch op := e.(type) {
+		case *licensing.And:
+			if name == "spdx:ConjunctiveLicenseSet" {
+				addMembers(op.Left)
+				addMembers(op.Right)
+				return
+			}
+		case *licensing.Or:
+			if name == "spdx:DisjunctiveLicenseSet" {
+				addMembers(op.Left)
+				addMembers(op.Right)
+				return
+			}
+		}
+		member := b.licenseNode(e)
+		if member.name == "" {
+			member.name = "spdx:member"
+			set.add(member)
+			return
+		}
+		set.add(property("spdx:member", member))
+	}
+	addMembers(e)
+	return set
+}
+
+// licenseURI returns the URI of a license ID, as used by licenseInfoFromFiles
+func (b *builder) licenseURI(id string) string {
+	switch id {
+	case "NONE", "NOASSERTION":
+		return nsSPDX + strings.ToLower(id)
+	}
+	if ref, err := licensing.Parse(id); err == nil {
+		if ref, ok := ref.(*licensing.LicenseRef); ok {
+			return b.licenseRefURI(ref.DocumentRef, ref.LicenseRef)
+		}
+	}
+	return licensesURI + id
+}
+
+// licenseRefURI returns the URI of a LicenseRef- defined in this or an external document
+func (b *builder) licenseRefURI(documentRef string, licenseRef string) string {
+	if documentRef != "" {
+		if uri, ok := b.externalDocuments[documentRef]; ok {
+			return uri + "#" + licenseRef
+		}
+		return b.namespace + "#" + documentRefPrefix + documentRef + ":" + licenseRef
+	}
+	return b.namespace + "#" + licenseRef
+}
+
+// simpleLicense returns a license node with just its ID
+func simpleLicense(id string) *element {
+	if isListed(id) {
+		return node("spdx:ListedLicense", "").add(literal("spdx:licenseId", id))
+	}
+	return node("spdx:License", "").add(literal("spdx:licenseId", id))
+}
+
+func isListed(id string) bool {
+	l, ok := licenselist.Default().License(id)
+	return ok && l.ID == id
+}
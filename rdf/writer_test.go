@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package rdf
+
+import (
+	"bytes"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/rdf/internal/rdftest"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+)
+
+func Test_WriteSample(t *testing.T) {
+	f, err := os.Open("../examples/sample-docs/rdf/SPDXRdfExample-v2.2.spdx.rdf")
+	require.NoError(t, err)
+	defer f.Close()
+
+	want, err := Read(f)
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(want, buf))
+
+	got, err := Read(buf)
+	require.NoError(t, err)
+
+	normalize(t, want)
+	normalize(t, got)
+	require.Equal(t, want, got)
+}
+
+func Test_WriteExample(t *testing.T) {
+	want := example.Copy()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(&want, buf))
+
+	got, err := Read(buf)
+	require.NoError(t, err)
+
+	normalize(t, &want)
+	normalize(t, got)
+	require.Equal(t, &want, got)
+}
+
+func Test_WriteV2_2(t *testing.T) {
+	f, err := os.Open("../examples/sample-docs/rdf/SPDXRdfExample-v2.2.spdx.rdf")
+	require.NoError(t, err)
+	defer f.Close()
+
+	doc := v2_2.Document{}
+	require.NoError(t, ReadInto(f, &doc))
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(&doc, buf))
+	require.Contains(t, buf.String(), "<spdx:specVersion>SPDX-2.2</spdx:specVersion>")
+
+	got := v2_2.Document{}
+	require.NoError(t, ReadInto(buf, &got))
+	require.Equal(t, doc.DocumentNamespace, got.DocumentNamespace)
+	require.Len(t, got.Packages, len(doc.Packages))
+	require.Len(t, got.OtherLicenses, len(doc.OtherLicenses))
+}
+
+func Test_WriteErrors(t *testing.T) {
+	doc := example.Copy()
+	doc.DocumentNamespace = ""
+	require.Error(t, Write(&doc, &bytes.Buffer{}))
+
+	doc = example.Copy()
+	doc.Relationships = append(doc.Relationships, &spdx.Relationship{
+		RefA:         common.MakeDocElementID("", "File"),
+		RefB:         common.MakeDocElementID("", "Package"),
+		Relationship: "NOT_A_RELATIONSHIP",
+	})
+	require.Error(t, Write(&doc, &bytes.Buffer{}))
+
+	require.Error(t, Write(nil, &bytes.Buffer{}))
+}
+
+func Test_WriteEscaping(t *testing.T) {
+	doc := example.Copy()
+	doc.DocumentComment = "  <a> & \"b\" ünïcode"
+	doc.CreationInfo.CreatorComment = "5 < 6 & ]]> 7"
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(&doc, buf))
+
+	got, err := Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, doc.DocumentComment, got.DocumentComment)
+	require.Equal(t, doc.CreationInfo.CreatorComment, got.CreationInfo.CreatorComment)
+}
+
+var wordBoundary = regexp.MustCompile(`([a-z])([A-Z])`)
+
+// normalize removes the differences between a document and the same document
+// read back from RDF: the reader keeps relationship types and reference types
+// as they are written in RDF, moves snippets and element annotations to their
+// RDF location, and doesn't keep the order of lists and of license expression
+// members: the reader walks the RDF graph through maps, so the order in which
+// it returns lists such as reviews and file dependencies is not stable.
+func normalize(t *testing.T, doc *spdx.Document) {
+	doc.SPDXIdentifier = common.ElementID(strings.TrimPrefix(string(doc.SPDXIdentifier), spdxRefPrefix))
+
+	for _, r := range doc.Relationships {
+		typ, err := relationshipType(r.Relationship)
+		require.NoError(t, err)
+		r.Relationship = strings.ToUpper(wordBoundary.ReplaceAllString(typ, "${1}_${2}"))
+		if r.RefB.SpecialID == "" && (r.RefB.ElementRefID == "NOASSERTION" || r.RefB.ElementRefID == "NONE") {
+			r.RefB = common.DocElementID{SpecialID: string(r.RefB.ElementRefID)}
+		}
+	}
+
+	for _, a := range doc.Annotations {
+		if a.AnnotationSPDXIdentifier == (common.DocElementID{}) {
+			a.AnnotationSPDXIdentifier.ElementRefID = doc.SPDXIdentifier
+		}
+	}
+
+	files := doc.Files
+	for _, pkg := range doc.Packages {
+		pkg.IsFilesAnalyzedTagPresent = true
+		for _, ref := range pkg.PackageExternalReferences {
+			ref.RefType = strings.TrimPrefix(ref.RefType, referencesURI)
+		}
+		doc.Annotations = append(doc.Annotations, annotations(pkg.Annotations, pkg.PackageSPDXIdentifier)...)
+		pkg.Annotations = nil
+		files = append(files, pkg.Files...)
+	}
+
+	for _, file := range files {
+		for i := range file.FileTypes {
+			file.FileTypes[i] = strings.ToUpper(file.FileTypes[i])
+		}
+		for _, snippet := range file.Snippets {
+			doc.Snippets = append(doc.Snippets, *snippet)
+		}
+		file.Snippets = nil
+		doc.Annotations = append(doc.Annotations, annotations(file.Annotations, file.FileSPDXIdentifier)...)
+		file.Annotations = nil
+	}
+
+	rdftest.Sort(doc)
+}
+
+func annotations(annotations []spdx.Annotation, id common.ElementID) []*spdx.Annotation {
+	var out []*spdx.Annotation
+	for i := range annotations {
+		a := annotations[i]
+		a.AnnotationSPDXIdentifier = common.MakeDocElementID("", string(id))
+		out = append(out, &a)
+	}
+	return out
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package resolver
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spdx/tools-golang/spdx"
+)
+
+// extensions are the file name extensions of the documents LoadDir reads
+var extensions = []string{".spdx", ".json", ".yaml", ".yml", ".rdf", ".xml", ".jsonld", ".xlsx"}
+
+// Catalog is an in-memory Resolver. It is safe for concurrent use.
+type Catalog struct {
+	lock    sync.RWMutex
+	entries map[string]*Entry
+}
+
+var _ Resolver = (*Catalog)(nil)
+
+// NewCatalog returns an empty catalog
+func NewCatalog() *Catalog {
+	return &Catalog{entries: map[string]*Entry{}}
+}
+
+// Add reads a serialized document in any supported format and adds it to the
+// catalog under its namespace, replacing any document with the same namespace
+func (c *Catalog) Add(content []byte, source string) (*Entry, error) {
+	doc, err := Read(content)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", source, err)
+	}
+	if doc.DocumentNamespace == "" {
+		return nil, fmt.Errorf("document %s has no namespace", source)
+	}
+	entry := &Entry{Document: doc, Content: content, Source: source}
+	c.add(entry)
+	return entry, nil
+}
+
+// AddDocument adds a document that is already in memory. Its checksum cannot
+// be verified, since the bytes it was read from are unknown.
+func (c *Catalog) AddDocument(doc *spdx.Document) error {
+	if doc.DocumentNamespace == "" {
+		return fmt.Errorf("document %q has no namespace", doc.DocumentName)
+	}
+	c.add(&Entry{Document: doc})
+	return nil
+}
+
+func (c *Catalog) add(entry *Entry) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries[entry.Document.DocumentNamespace] = entry
+}
+
+// Resolve returns the document with the given namespace
+func (c *Catalog) Resolve(namespace string) (*Entry, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	entry, ok := c.entries[namespace]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, namespace)
+	}
+	return entry, nil
+}
+
+// Namespaces returns the sorted namespaces of the documents in the catalog
+func (c *Catalog) Namespaces() []string {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	namespaces := make([]string, 0, len(c.entries))
+	for ns := range c.entries {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// LoadDir returns a catalog of the SPDX documents in the directory and its
+// subdirectories. Files are recognized by extension: .spdx, .json, .yaml,
+// .yml, .rdf, .xml, .jsonld and .xlsx; other files are ignored.
+func LoadDir(dir string) (*Catalog, error) {
+	c := NewCatalog()
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !hasExtension(path) {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		_, err = c.Add(content, path)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func hasExtension(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, e := range extensions {
+		if ext == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+// Package resolver follows the ExternalDocumentRefs of SPDX documents: it finds
+// the referenced documents in a catalog keyed by document namespace, verifies
+// their recorded checksums, and dereferences "DocumentRef-x:SPDXRef-y"
+// identifiers into the packages, files and snippets they refer to.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+package resolver
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spdx/tools-golang/checksum"
+	"github.com/spdx/tools-golang/json"
+	"github.com/spdx/tools-golang/jsonld"
+	"github.com/spdx/tools-golang/rdf"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spreadsheet"
+	"github.com/spdx/tools-golang/tagvalue"
+	"github.com/spdx/tools-golang/xml"
+	"github.com/spdx/tools-golang/yaml"
+)
+
+// rdfNamespace is declared by RDF/XML documents, and tells them from SPDX XML
+// documents
+const rdfNamespace = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+
+// zipSignature starts zip files, such as the XLSX files of SPDX spreadsheets
+const zipSignature = "PK\x03\x04"
+
+// ErrNotFound is returned when a resolver has no document with the requested namespace
+var ErrNotFound = errors.New("document not found")
+
+// ErrChecksumMismatch is returned when a document does not have the checksum
+// recorded in the ExternalDocumentRef that refers to it
+var ErrChecksumMismatch = checksum.ErrMismatch
+
+// Resolver finds SPDX documents by namespace
+type Resolver interface {
+	// Resolve returns the document with the given namespace, or an error
+	// wrapping ErrNotFound
+	Resolve(namespace string) (*Entry, error)
+}
+
+// Entry is a document known to a resolver
+type Entry struct {
+	Document *spdx.Document
+
+	// Content is the serialized document, which checksums are computed over;
+	// it is nil for documents added to a catalog as values
+	Content []byte
+
+	// Source describes where the document was loaded from, e.g. a file path
+	Source string
+}
+
+// Checksum computes the checksum of the serialized document
+func (e *Entry) Checksum(algorithm common.ChecksumAlgorithm) (common.Checksum, error) {
+	if e.Content == nil {
+		return common.Checksum{}, fmt.Errorf("document %s has no serialized content to compute a checksum over", e.Document.DocumentNamespace)
+	}
+	return checksum.Bytes(e.Content, algorithm)
+}
+
+// Verify returns an error wrapping ErrChecksumMismatch if the serialized
+// document does not have the given checksum
+func (e *Entry) Verify(checksum common.Checksum) error {
+	actual, err := e.Checksum(checksum.Algorithm)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(actual.Value, checksum.Value) {
+		return fmt.Errorf("%w: document %s has %s %s, not %s", ErrChecksumMismatch, e.Document.DocumentNamespace, actual.Algorithm, actual.Value, checksum.Value)
+	}
+	return nil
+}
+
+// Resolve resolves the ExternalDocumentRef and verifies the checksum it records
+func Resolve(r Resolver, ref spdx.ExternalDocumentRef) (*Entry, error) {
+	entry, err := r.Resolve(ref.URI)
+	if err != nil {
+		return nil, err
+	}
+	if err := entry.Verify(ref.Checksum); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Read reads an SPDX document in any format this module supports: tag-value,
+// JSON, YAML, XML, RDF/XML, spreadsheet (XLSX), or SPDX 3.0 JSON-LD, which is
+// converted to the SPDX 2 model
+func Read(content []byte) (*spdx.Document, error) {
+	trimmed := bytes.TrimSpace(content)
+	switch {
+	case bytes.HasPrefix(content, []byte(zipSignature)):
+		return spreadsheet.Read(bytes.NewReader(content))
+	case bytes.HasPrefix(trimmed, []byte("{")) && bytes.Contains(trimmed, []byte(`"@context"`)):
+		doc := &spdx.Document{}
+		return doc, jsonld.ReadInto(bytes.NewReader(content), doc)
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		return json.Read(bytes.NewReader(content))
+	case bytes.HasPrefix(trimmed, []byte("<")) && bytes.Contains(trimmed, []byte(rdfNamespace)):
+		return rdf.Read(bytes.NewReader(content))
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		return xml.Read(bytes.NewReader(content))
+	case isTagValue(trimmed):
+		return tagvalue.Read(bytes.NewReader(content))
+	}
+	return yaml.Read(bytes.NewReader(content))
+}
+
+// isTagValue returns true if the first tag of the content is SPDXVersion
+func isTagValue(content []byte) bool {
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		return bytes.HasPrefix(line, []byte("SPDXVersion:"))
+	}
+	return false
+}
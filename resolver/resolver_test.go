@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package resolver
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/json"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+	"github.com/spdx/tools-golang/spdxlib"
+	"github.com/spdx/tools-golang/tagvalue"
+	"github.com/spdx/tools-golang/yaml"
+)
+
+func sha1Checksum(content []byte) common.Checksum {
+	sum := sha1.Sum(content)
+	return common.Checksum{Algorithm: common.SHA1, Value: hex.EncodeToString(sum[:])}
+}
+
+// referencing returns a document that refers to the example document
+func referencing(checksum common.Checksum) *spdx.Document {
+	return &spdx.Document{
+		DocumentNamespace: "https://example.com/product",
+		ExternalDocumentReferences: []spdx.ExternalDocumentRef{
+			{DocumentRefID: "DocumentRef-example", URI: example.Copy().DocumentNamespace, Checksum: checksum},
+		},
+		Packages: []*spdx.Package{{PackageSPDXIdentifier: "product"}},
+		Relationships: []*spdx.Relationship{
+			{RefA: common.MakeDocElementID("", "product"), RefB: common.MakeDocElementID("example", "Package"), Relationship: common.TypeRelationshipDependsOn},
+			{RefA: common.MakeDocElementID("", "product"), RefB: common.MakeDocElementID("example", "JenaLib"), Relationship: common.TypeRelationshipContains},
+		},
+	}
+}
+
+func serialize(t *testing.T, write func(doc *spdx.Document, buf *bytes.Buffer) error) []byte {
+	doc := example.Copy()
+	buf := &bytes.Buffer{}
+	require.NoError(t, write(&doc, buf))
+	return buf.Bytes()
+}
+
+func Test_Read(t *testing.T) {
+	for name, content := range map[string][]byte{
+		"json":     serialize(t, func(doc *spdx.Document, buf *bytes.Buffer) error { return json.Write(doc, buf) }),
+		"yaml":     serialize(t, func(doc *spdx.Document, buf *bytes.Buffer) error { return yaml.Write(doc, buf) }),
+		"tagvalue": serialize(t, func(doc *spdx.Document, buf *bytes.Buffer) error { return tagvalue.Write(doc, buf) }),
+		"rdf":      readFile(t, "../examples/sample-docs/rdf/SPDXRdfExample-v2.2.spdx.rdf"),
+		"xml":      readFile(t, "../examples/sample-docs/xml/SPDXXMLExample-v2.2.spdx.xml"),
+		"jsonld":   readFile(t, "../examples/sample-docs/jsonld/SPDXJSONLDExample-v3.0.1.spdx.json"),
+		"xlsx":     readFile(t, "../examples/sample-docs/xls/SPDXSpreadsheetExample-v2.2.xlsx"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			doc, err := Read(content)
+			require.NoError(t, err)
+			require.NotEmpty(t, doc.DocumentNamespace)
+			require.NotEmpty(t, doc.Packages)
+		})
+	}
+
+	_, err := Read([]byte("not: [an spdx document"))
+	require.Error(t, err)
+}
+
+func readFile(t *testing.T, path string) []byte {
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return content
+}
+
+func Test_Catalog(t *testing.T) {
+	content := serialize(t, func(doc *spdx.Document, buf *bytes.Buffer) error { return json.Write(doc, buf) })
+	catalog := NewCatalog()
+	entry, err := catalog.Add(content, "example.json")
+	require.NoError(t, err)
+	require.Equal(t, []string{entry.Document.DocumentNamespace}, catalog.Namespaces())
+
+	resolved, err := catalog.Resolve(entry.Document.DocumentNamespace)
+	require.NoError(t, err)
+	require.Same(t, entry, resolved)
+
+	_, err = catalog.Resolve("https://example.com/missing")
+	require.True(t, errors.Is(err, ErrNotFound))
+
+	// the checksum is computed over the bytes the document was read from
+	checksum, err := entry.Checksum(common.SHA1)
+	require.NoError(t, err)
+	require.Equal(t, sha1Checksum(content), checksum)
+	require.NoError(t, entry.Verify(checksum))
+	require.True(t, errors.Is(entry.Verify(common.Checksum{Algorithm: common.SHA1, Value: "00"}), ErrChecksumMismatch))
+	_, err = entry.Checksum(common.MD6)
+	require.Error(t, err)
+
+	// documents added as values cannot be verified
+	doc := example.Copy()
+	doc.DocumentNamespace = "https://example.com/in-memory"
+	require.NoError(t, catalog.AddDocument(&doc))
+	entry, err = catalog.Resolve(doc.DocumentNamespace)
+	require.NoError(t, err)
+	require.Error(t, entry.Verify(checksum))
+
+	require.Error(t, catalog.AddDocument(&spdx.Document{}))
+}
+
+func Test_LoadDir(t *testing.T) {
+	dir := t.TempDir()
+	content := serialize(t, func(doc *spdx.Document, buf *bytes.Buffer) error { return tagvalue.Write(doc, buf) })
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "example.spdx"), content, 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not an SPDX document"), 0o644))
+
+	catalog, err := LoadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{example.Copy().DocumentNamespace}, catalog.Namespaces())
+
+	entry, err := Resolve(catalog, referencing(sha1Checksum(content)).ExternalDocumentReferences[0])
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "sub", "example.spdx"), entry.Source)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0o644))
+	_, err = LoadDir(dir)
+	require.Error(t, err)
+}
+
+func Test_Set(t *testing.T) {
+	content := serialize(t, func(doc *spdx.Document, buf *bytes.Buffer) error { return json.Write(doc, buf) })
+	catalog := NewCatalog()
+	_, err := catalog.Add(content, "example.json")
+	require.NoError(t, err)
+
+	doc := referencing(sha1Checksum(content))
+	set := NewSet(doc, catalog)
+	require.Same(t, doc, set.Document())
+	require.Empty(t, set.Verify())
+
+	e, defining, err := set.Element(doc.Relationships[0].RefB)
+	require.NoError(t, err)
+	require.Equal(t, spdxlib.KindPackage, e.Kind)
+	require.Equal(t, "glibc", e.Package.PackageName)
+	require.Equal(t, example.Copy().DocumentNamespace, defining.DocumentNamespace)
+
+	e, _, err = set.Element(doc.Relationships[1].RefB)
+	require.NoError(t, err)
+	require.Equal(t, spdxlib.KindFile, e.Kind)
+
+	e, defining, err = set.Element(common.MakeDocElementID("", "product"))
+	require.NoError(t, err)
+	require.Same(t, doc, defining)
+	require.Same(t, doc.Packages[0], e.Package)
+
+	_, _, err = set.Element(common.MakeDocElementSpecial("NONE"))
+	require.Error(t, err)
+	_, _, err = set.Element(common.MakeDocElementID("unknown", "Package"))
+	require.Error(t, err)
+
+	// undefined external elements and wrong checksums are reported
+	doc.Relationships = append(doc.Relationships, &spdx.Relationship{
+		RefA: common.MakeDocElementID("", "product"), RefB: common.MakeDocElementID("example", "missing"), Relationship: common.TypeRelationshipDependsOn,
+	})
+	require.Len(t, NewSet(doc, catalog).Verify(), 1)
+
+	doc.ExternalDocumentReferences[0].Checksum.Value = "d6a770ba38583ed4bb4525bd96e50461655d2759"
+	errs := NewSet(doc, catalog).Verify()
+	require.Len(t, errs, 1)
+	require.True(t, errors.Is(errs[0], ErrChecksumMismatch))
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package resolver
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+const documentRefPrefix = "DocumentRef-"
+
+// Set is a document together with the documents its ExternalDocumentRefs refer
+// to, which are resolved and verified on first use. It is safe for concurrent use.
+type Set struct {
+	doc      *spdx.Document
+	resolver Resolver
+
+	lock    sync.Mutex
+	index   *spdxlib.Index
+	entries map[string]*Entry
+	indexes map[string]*spdxlib.Index
+}
+
+// NewSet returns the set of the document and the documents it refers to
+func NewSet(doc *spdx.Document, r Resolver) *Set {
+	return &Set{
+		doc:      doc,
+		resolver: r,
+		index:    spdxlib.NewIndex(doc),
+		entries:  map[string]*Entry{},
+		indexes:  map[string]*spdxlib.Index{},
+	}
+}
+
+// Document returns the document the set was created for
+func (s *Set) Document() *spdx.Document {
+	return s.doc
+}
+
+// External returns the document referred to by the ExternalDocumentRef with
+// the given ID, with or without the "DocumentRef-" prefix, after verifying its checksum
+func (s *Set) External(documentRefID string) (*Entry, error) {
+	entry, _, err := s.external(documentRefID)
+	return entry, err
+}
+
+func (s *Set) external(documentRefID string) (*Entry, *spdxlib.Index, error) {
+	id := strings.TrimPrefix(documentRefID, documentRefPrefix)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if entry, ok := s.entries[id]; ok {
+		return entry, s.indexes[id], nil
+	}
+
+	for _, ref := range s.doc.ExternalDocumentReferences {
+		if strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix) != id {
+			continue
+		}
+		entry, err := Resolve(s.resolver, ref)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s%s: %w", documentRefPrefix, id, err)
+		}
+		s.entries[id] = entry
+		s.indexes[id] = spdxlib.NewIndex(entry.Document)
+		return entry, s.indexes[id], nil
+	}
+	return nil, nil, fmt.Errorf("%s%s is not an external document reference of %s", documentRefPrefix, id, s.doc.DocumentNamespace)
+}
+
+// Element returns the element a reference refers to, in the document of the
+// set or in an external document, and the document that defines it
+func (s *Set) Element(ref common.DocElementID) (*spdxlib.Element, *spdx.Document, error) {
+	if ref.SpecialID != "" {
+		return nil, nil, fmt.Errorf("%s does not refer to an element", ref.SpecialID)
+	}
+
+	doc, idx := s.doc, s.index
+	if ref.DocumentRefID != "" {
+		entry, entryIndex, err := s.external(ref.DocumentRefID)
+		if err != nil {
+			return nil, nil, err
+		}
+		doc, idx = entry.Document, entryIndex
+	}
+
+	e, ok := idx.Element(ref.ElementRefID)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s is not defined in %s", common.RenderDocElementID(ref), doc.DocumentNamespace)
+	}
+	return e, doc, nil
+}
+
+// Verify resolves every ExternalDocumentRef of the document and verifies its
+// checksum, then checks that the external elements the relationships and
+// annotations refer to are defined. It returns one error per failure.
+func (s *Set) Verify() []error {
+	var errs []error
+	failed := map[string]bool{}
+	for _, ref := range s.doc.ExternalDocumentReferences {
+		if _, err := s.External(ref.DocumentRefID); err != nil {
+			errs = append(errs, err)
+			failed[strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)] = true
+		}
+	}
+
+	var refs []common.DocElementID
+	for _, r := range s.doc.Relationships {
+		if r != nil {
+			refs = append(refs, r.RefA, r.RefB)
+		}
+	}
+	for _, a := range s.doc.Annotations {
+		if a != nil {
+			refs = append(refs, a.AnnotationSPDXIdentifier)
+		}
+	}
+	checked := map[common.DocElementID]bool{}
+	for _, ref := range refs {
+		if ref.DocumentRefID == "" || ref.SpecialID != "" || failed[strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)] || checked[ref] {
+			continue
+		}
+		checked[ref] = true
+		if _, _, err := s.Element(ref); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
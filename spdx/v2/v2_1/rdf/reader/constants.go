@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package reader
+
+import (
+	"github.com/spdx/gordf/rdfloader/parser"
+
+	"github.com/spdx/tools-golang/licensing/licenselist"
+)
+
+var (
+	// NAMESPACES
+	NS_SPDX = "http://spdx.org/rdf/terms#"
+	NS_RDFS = "http://www.w3.org/2000/01/rdf-schema#"
+	NS_RDF  = parser.RDFNS
+	NS_PTR  = "http://www.w3.org/2009/pointers#"
+	NS_DOAP = "http://usefulinc.com/ns/doap#"
+
+	// SPDX properties
+	SPDX_SPEC_VERSION                            = NS_SPDX + "specVersion"
+	SPDX_DATA_LICENSE                            = NS_SPDX + "dataLicense"
+	SPDX_NAME                                    = NS_SPDX + "name"
+	SPDX_EXTERNAL_DOCUMENT_REF                   = NS_SPDX + "externalDocumentRef"
+	SPDX_LICENSE_LIST_VERSION                    = NS_SPDX + "licenseListVersion"
+	SPDX_CREATOR                                 = NS_SPDX + "creator"
+	SPDX_CREATED                                 = NS_SPDX + "created"
+	SPDX_REVIEWED                                = NS_SPDX + "reviewed"
+	SPDX_DESCRIBES_PACKAGE                       = NS_SPDX + "describesPackage"
+	SPDX_HAS_EXTRACTED_LICENSING_INFO            = NS_SPDX + "hasExtractedLicensingInfo"
+	SPDX_RELATIONSHIP                            = NS_SPDX + "relationship"
+	SPDX_ANNOTATION                              = NS_SPDX + "annotation"
+	SPDX_COMMENT                                 = NS_SPDX + "comment"
+	SPDX_CREATION_INFO                           = NS_SPDX + "creationInfo"
+	SPDX_CHECKSUM_ALGORITHM_SHA1                 = NS_SPDX + "checksumAlgorithm_sha1"
+	SPDX_CHECKSUM_ALGORITHM_SHA256               = NS_SPDX + "checksumAlgorithm_sha256"
+	SPDX_CHECKSUM_ALGORITHM_MD5                  = NS_SPDX + "checksumAlgorithm_md5"
+	SPDX_EXTERNAL_DOCUMENT_ID                    = NS_SPDX + "externalDocumentId"
+	SPDX_SPDX_DOCUMENT                           = NS_SPDX + "spdxDocument"
+	SPDX_SPDX_DOCUMENT_CAPITALIZED               = NS_SPDX + "SpdxDocument"
+	SPDX_CHECKSUM                                = NS_SPDX + "checksum"
+	SPDX_CHECKSUM_CAPITALIZED                    = NS_SPDX + "Checksum"
+	SPDX_ANNOTATION_TYPE                         = NS_SPDX + "annotationType"
+	SPDX_ANNOTATION_TYPE_OTHER                   = NS_SPDX + "annotationType_other"
+	SPDX_ANNOTATION_TYPE_REVIEW                  = NS_SPDX + "annotationType_review"
+	SPDX_LICENSE_INFO_IN_FILE                    = NS_SPDX + "licenseInfoInFile"
+	SPDX_LICENSE_CONCLUDED                       = NS_SPDX + "licenseConcluded"
+	SPDX_LICENSE_COMMENTS                        = NS_SPDX + "licenseComments"
+	SPDX_COPYRIGHT_TEXT                          = NS_SPDX + "copyrightText"
+	SPDX_ARTIFACT_OF                             = NS_SPDX + "artifactOf"
+	SPDX_NOTICE_TEXT                             = NS_SPDX + "noticeText"
+	SPDX_FILE_CONTRIBUTOR                        = NS_SPDX + "fileContributor"
+	SPDX_FILE_DEPENDENCY                         = NS_SPDX + "fileDependency"
+	SPDX_FILE_TYPE                               = NS_SPDX + "fileType"
+	SPDX_FILE_NAME                               = NS_SPDX + "fileName"
+	SPDX_EXTRACTED_TEXT                          = NS_SPDX + "extractedText"
+	SPDX_LICENSE_ID                              = NS_SPDX + "licenseId"
+	SPDX_FILE                                    = NS_SPDX + "File"
+	SPDX_PACKAGE                                 = NS_SPDX + "Package"
+	SPDX_SPDX_ELEMENT                            = NS_SPDX + "SpdxElement"
+	SPDX_VERSION_INFO                            = NS_SPDX + "versionInfo"
+	SPDX_PACKAGE_FILE_NAME                       = NS_SPDX + "packageFileName"
+	SPDX_SUPPLIER                                = NS_SPDX + "supplier"
+	SPDX_ORIGINATOR                              = NS_SPDX + "originator"
+	SPDX_DOWNLOAD_LOCATION                       = NS_SPDX + "downloadLocation"
+	SPDX_FILES_ANALYZED                          = NS_SPDX + "filesAnalyzed"
+	SPDX_PACKAGE_VERIFICATION_CODE               = NS_SPDX + "packageVerificationCode"
+	SPDX_SOURCE_INFO                             = NS_SPDX + "sourceInfo"
+	SPDX_LICENSE_INFO_FROM_FILES                 = NS_SPDX + "licenseInfoFromFiles"
+	SPDX_LICENSE_DECLARED                        = NS_SPDX + "licenseDeclared"
+	SPDX_SUMMARY                                 = NS_SPDX + "summary"
+	SPDX_DESCRIPTION                             = NS_SPDX + "description"
+	SPDX_EXTERNAL_REF                            = NS_SPDX + "externalRef"
+	SPDX_HAS_FILE                                = NS_SPDX + "hasFile"
+	SPDX_PACKAGE_VERIFICATION_CODE_VALUE         = NS_SPDX + "packageVerificationCodeValue"
+	SPDX_PACKAGE_VERIFICATION_CODE_EXCLUDED_FILE = NS_SPDX + "packageVerificationCodeExcludedFile"
+	SPDX_RELATED_SPDX_ELEMENT                    = NS_SPDX + "relatedSpdxElement"
+	SPDX_RELATIONSHIP_TYPE                       = NS_SPDX + "relationshipType"
+	SPDX_SNIPPET_FROM_FILE                       = NS_SPDX + "snippetFromFile"
+	SPDX_LICENSE_INFO_IN_SNIPPET                 = NS_SPDX + "licenseInfoInSnippet"
+	SPDX_RANGE                                   = NS_SPDX + "range"
+	SPDX_REVIEWER                                = NS_SPDX + "reviewer"
+	SPDX_REVIEW_DATE                             = NS_SPDX + "reviewDate"
+	SPDX_SNIPPET                                 = NS_SPDX + "Snippet"
+	SPDX_ALGORITHM                               = NS_SPDX + "algorithm"
+	SPDX_CHECKSUM_VALUE                          = NS_SPDX + "checksumValue"
+	SPDX_REFERENCE_CATEGORY                      = NS_SPDX + "referenceCategory"
+	SPDX_REFERENCE_CATEGORY_PACKAGE_MANAGER      = NS_SPDX + "referenceCategory_packageManager"
+	SPDX_REFERENCE_CATEGORY_SECURITY             = NS_SPDX + "referenceCategory_security"
+	SPDX_REFERENCE_CATEGORY_OTHER                = NS_SPDX + "referenceCategory_other"
+
+	SPDX_REFERENCE_TYPE                   = NS_SPDX + "referenceType"
+	SPDX_REFERENCE_LOCATOR                = NS_SPDX + "referenceLocator"
+	SPDX_ANNOTATION_DATE                  = NS_SPDX + "annotationDate"
+	SPDX_ANNOTATOR                        = NS_SPDX + "annotator"
+	SPDX_MEMBER                           = NS_SPDX + "member"
+	SPDX_DISJUNCTIVE_LICENSE_SET          = NS_SPDX + "DisjunctiveLicenseSet"
+	SPDX_CONJUNCTIVE_LICENSE_SET          = NS_SPDX + "ConjunctiveLicenseSet"
+	SPDX_EXTRACTED_LICENSING_INFO         = NS_SPDX + "ExtractedLicensingInfo"
+	SPDX_SIMPLE_LICENSING_INFO            = NS_SPDX + "SimpleLicensingInfo"
+	SPDX_NONE_CAPS                        = NS_SPDX + "NONE"
+	SPDX_NOASSERTION_CAPS                 = NS_SPDX + "NOASSERTION"
+	SPDX_NONE_SMALL                       = NS_SPDX + "none"
+	SPDX_NOASSERTION_SMALL                = NS_SPDX + "noassertion"
+	SPDX_LICENSE                          = NS_SPDX + "License"
+	SPDX_LISTED_LICENSE                   = NS_SPDX + "ListedLicense"
+	SPDX_EXAMPLE                          = NS_SPDX + "example"
+	SPDX_IS_OSI_APPROVED                  = NS_SPDX + "isOsiApproved"
+	SPDX_STANDARD_LICENSE_TEMPLATE        = NS_SPDX + "standardLicenseTemplate"
+	SPDX_IS_DEPRECATED_LICENSE_ID         = NS_SPDX + "isDeprecatedLicenseId"
+	SPDX_IS_FSF_LIBRE                     = NS_SPDX + "isFsfLibre"
+	SPDX_LICENSE_TEXT                     = NS_SPDX + "licenseText"
+	SPDX_STANDARD_LICENSE_HEADER          = NS_SPDX + "standardLicenseHeader"
+	SPDX_LICENSE_EXCEPTION_ID             = NS_SPDX + "licenseExceptionId"
+	SPDX_LICENSE_EXCEPTION_TEXT           = NS_SPDX + "licenseExceptionText"
+	SPDX_LICENSE_EXCEPTION                = NS_SPDX + "licenseException"
+	SPDX_WITH_EXCEPTION_OPERATOR          = NS_SPDX + "WithExceptionOperator"
+	SPDX_OR_LATER_OPERATOR                = NS_SPDX + "OrLaterOperator"
+	SPDX_STANDARD_LICENSE_HEADER_TEMPLATE = NS_SPDX + "standardLicenseHeaderTemplate"
+
+	// RDFS properties
+	RDFS_COMMENT  = NS_RDFS + "comment"
+	RDFS_SEE_ALSO = NS_RDFS + "seeAlso"
+
+	// RDF properties
+	RDF_TYPE = NS_RDF + "type"
+
+	// DOAP properties
+	DOAP_HOMEPAGE = NS_DOAP + "homepage"
+	DOAP_NAME     = NS_DOAP + "name"
+
+	// PTR properties
+	PTR_START_END_POINTER   = NS_PTR + "StartEndPointer"
+	PTR_START_POINTER       = NS_PTR + "startPointer"
+	PTR_BYTE_OFFSET_POINTER = NS_PTR + "ByteOffsetPointer"
+	PTR_LINE_CHAR_POINTER   = NS_PTR + "LineCharPointer"
+	PTR_REFERENCE           = NS_PTR + "reference"
+	PTR_OFFSET              = NS_PTR + "offset"
+	PTR_LINE_NUMBER         = NS_PTR + "lineNumber"
+	PTR_END_POINTER         = NS_PTR + "endPointer"
+
+	// prefixes
+	PREFIX_RELATIONSHIP_TYPE = "relationshipType_"
+)
+
+func AllRelationshipTypes() []string {
+	return []string{
+		"amendment", "ancestorOf", "buildDependencyOf", "buildToolOf",
+		"containedBy", "contains", "copyOf", "dataFile", "dataFileOf",
+		"dependencyManifestOf", "dependencyOf", "dependsOn", "descendantOf",
+		"describedBy", "describes", "devDependencyOf", "devToolOf",
+		"distributionArtifact", "documentation", "dynamicLink", "exampleOf",
+		"expandedFromArchive", "fileAdded", "fileDeleted", "fileModified",
+		"generatedFrom", "generates", "hasPrerequisite", "metafileOf",
+		"optionalComponentOf", "optionalDependencyOf", "other", "packageOf",
+		"patchApplied", "patchFor", "prerequisiteFor", "providedDependencyOf",
+		"runtimeDependencyOf", "staticLink", "testDependencyOf", "testOf",
+		"testToolOf", "testcaseOf", "variantOf",
+	}
+}
+
+// AllStandardLicenseIDS returns the IDs of the licenses and license exceptions
+// of the SPDX License List, as provided by licenselist.Default
+func AllStandardLicenseIDS() []string {
+	list := licenselist.Default()
+	return append(list.LicenseIDs(), list.ExceptionIDs()...)
+}
+
+// isStandardLicense returns true if the ID, with the same case, is a license
+// or license exception of the SPDX License List
+func isStandardLicense(id string) bool {
+	list := licenselist.Default()
+	if l, ok := list.License(id); ok {
+		return l.ID == id
+	}
+	if e, ok := list.Exception(id); ok {
+		return e.ID == id
+	}
+	return false
+}
@@ -42,6 +42,21 @@ func (parser *rdfParser2_2) parseAnnotationFromNode(node *gordfParser.Node) (err
 	return setAnnotationToParser(parser, ann)
 }
 
+// parses the annotation of the element identified by elementURI, and sets
+// the element as the subject of the annotation.
+func (parser *rdfParser2_2) parseElementAnnotation(elementURI string, node *gordfParser.Node) error {
+	err := parser.parseAnnotationFromNode(node)
+	if err != nil {
+		return err
+	}
+	id, err := ExtractDocElementID(getLastPartOfURI(elementURI))
+	if err != nil {
+		return fmt.Errorf("error parsing the element of an annotation: %v", err)
+	}
+	parser.doc.Annotations[len(parser.doc.Annotations)-1].AnnotationSPDXIdentifier = id
+	return nil
+}
+
 func setAnnotationToParser(parser *rdfParser2_2, annotation *v2_2.Annotation) error {
 	if parser.doc == nil {
 		return errors.New("uninitialized spdx document")
@@ -62,8 +62,8 @@ func Test_getRelationshipTypeFromURI(t *testing.T) {
 	if err != nil {
 		t.Errorf("error getting relationship type from a valid input")
 	}
-	if op != relnType {
-		t.Errorf("expected %s, found %s", relnType, op)
+	if op != "EXPANDED_FROM_ARCHIVE" {
+		t.Errorf("expected %s, found %s", "EXPANDED_FROM_ARCHIVE", op)
 	}
 
 	// TestCase2: invalid relationshipType
@@ -372,7 +372,7 @@ func Test_rdfParser2_2_parseRelationship(t *testing.T) {
 		t.Errorf("after parsing a valid relationship, doc should've had 1 relationship, found %d", len(parser.doc.Relationships))
 	}
 	reln := parser.doc.Relationships[0]
-	expectedRelnType := "describes"
+	expectedRelnType := "DESCRIBES"
 	if reln.Relationship != expectedRelnType {
 		t.Errorf("expected %s, found %s", expectedRelnType, reln.Relationship)
 	}
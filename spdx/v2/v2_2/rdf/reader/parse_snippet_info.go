@@ -62,6 +62,18 @@ func (parser *rdfParser2_2) getSnippetInformationFromNode2_2(node *gordfParser.N
 			si.SnippetLicenseComments = siTriple.Object.ID
 		case RDFS_COMMENT:
 			si.SnippetComment = siTriple.Object.ID
+		case SPDX_RELATIONSHIP:
+			// cardinality: min 0
+			err = parser.parseRelationship(siTriple)
+			if err != nil {
+				return nil, err
+			}
+		case SPDX_ANNOTATION:
+			// cardinality: min 0
+			err = parser.parseElementAnnotation(siTriple.Subject.ID, siTriple.Object)
+			if err != nil {
+				return nil, err
+			}
 		case SPDX_LICENSE_CONCLUDED:
 			var anyLicense AnyLicenseInfo
 			anyLicense, err = parser.getAnyLicenseFromNode(siTriple.Object)
@@ -73,6 +85,16 @@ func (parser *rdfParser2_2) getSnippetInformationFromNode2_2(node *gordfParser.N
 			return nil, fmt.Errorf("unknown predicate %v", siTriple.Predicate.ID)
 		}
 	}
+
+	// ranges read before the snippetFromFile triple don't know their file yet
+	for i := range si.Ranges {
+		if si.Ranges[i].StartPointer.FileSPDXIdentifier == "" {
+			si.Ranges[i].StartPointer.FileSPDXIdentifier = si.SnippetFromFileSPDXIdentifier
+		}
+		if si.Ranges[i].EndPointer.FileSPDXIdentifier == "" {
+			si.Ranges[i].EndPointer.FileSPDXIdentifier = si.SnippetFromFileSPDXIdentifier
+		}
+	}
 	return si, nil
 }
 
@@ -137,18 +159,20 @@ func (parser *rdfParser2_2) setSnippetRangeFromNode(node *gordfParser.Node, si *
 		return fmt.Errorf("start and end range type doesn't match")
 	}
 
-	si.Ranges = []common.SnippetRange{{
+	snippetRange := common.SnippetRange{
 		StartPointer: common.SnippetRangePointer{FileSPDXIdentifier: si.SnippetFromFileSPDXIdentifier},
 		EndPointer:   common.SnippetRangePointer{FileSPDXIdentifier: si.SnippetFromFileSPDXIdentifier},
-	}}
+	}
 
 	if startRangeType == LINE_RANGE {
-		si.Ranges[0].StartPointer.LineNumber = start
-		si.Ranges[0].EndPointer.LineNumber = end
+		snippetRange.StartPointer.LineNumber = start
+		snippetRange.EndPointer.LineNumber = end
 	} else {
-		si.Ranges[0].StartPointer.Offset = start
-		si.Ranges[0].EndPointer.Offset = end
+		snippetRange.StartPointer.Offset = start
+		snippetRange.EndPointer.Offset = end
 	}
+	// a snippet can have both a byte range and a line range
+	si.Ranges = append(si.Ranges, snippetRange)
 	return nil
 }
 
@@ -29,6 +29,7 @@ func NewParser2_2(gordfParserObj *gordfParser.Parser, nodeToTriples map[string][
 		},
 		files:            map[common.ElementID]*v2_2.File{},
 		assocWithPackage: map[common.ElementID]bool{},
+		relatedElements:  map[*v2_2.Relationship]string{},
 		cache:            map[string]*nodeState{},
 	}
 	return &parser
@@ -37,6 +38,19 @@ func NewParser2_2(gordfParserObj *gordfParser.Parser, nodeToTriples map[string][
 // main function which takes in a gordfParser and returns
 // a spdxDocument model or the error encountered while parsing it
 func LoadFromGoRDFParser(gordfParserObj *gordfParser.Parser) (*v2_2.Document, error) {
+	decodeXMLValues(gordfParserObj.Triples)
+	return loadFromGoRDFParser(gordfParserObj)
+}
+
+// LoadFromTriples returns the spdxDocument model of the triples of a document
+// read from another RDF syntax than RDF/XML, such as Turtle or JSON-LD. IRI
+// objects are expected to be resource nodes, as rdf:resource objects are in
+// RDF/XML, and blank nodes to be shared by the triples they are part of.
+func LoadFromTriples(triples []*gordfParser.Triple) (*v2_2.Document, error) {
+	return loadFromGoRDFParser(&gordfParser.Parser{Triples: triples})
+}
+
+func loadFromGoRDFParser(gordfParserObj *gordfParser.Parser) (*v2_2.Document, error) {
 	// nodeToTriples is a mapping from a node to list of triples.
 	// for every node in the set of subjects of all the triples,
 	// it provides a list of triples that are associated with that subject node.
@@ -71,6 +85,16 @@ func LoadFromGoRDFParser(gordfParserObj *gordfParser.Parser) (*v2_2.Document, er
 			if err != nil {
 				return nil, err
 			}
+		case SPDX_PACKAGE:
+			// packages which are not nested in another element
+			if _, err := parser.getPackageFromNode(typeTriples[0].Subject); err != nil {
+				return nil, fmt.Errorf("error parsing a package: %v", err)
+			}
+		case SPDX_FILE:
+			// files which are not nested in another element
+			if _, err := parser.getFileFromNode(typeTriples[0].Subject); err != nil {
+				return nil, fmt.Errorf("error parsing a file: %v", err)
+			}
 		// todo: check other root node attributes.
 		default:
 			continue
@@ -85,6 +109,7 @@ func LoadFromGoRDFParser(gordfParserObj *gordfParser.Parser) (*v2_2.Document, er
 	// Files attribute of the document
 	// WARNING: do not relocate following function call. It must be at the end of the function
 	parser.setUnpackagedFiles()
+	parser.setExternalDocumentRefs()
 	return parser.doc, nil
 }
 
@@ -110,7 +135,7 @@ func (parser *rdfParser2_2) getSpdxDocNode() (node *gordfParser.Node, err error)
 			nil,                            // Object
 		)
 
-		if typeTriples[0].Object.ID == SPDX_SPDX_DOCUMENT_CAPITALIZED {
+		if len(typeTriples) > 0 && typeTriples[0].Object.ID == SPDX_SPDX_DOCUMENT_CAPITALIZED {
 			// we found a SpdxDocument Node
 
 			// must be associated with exactly one rdf:type.
@@ -21,6 +21,10 @@ type rdfParser2_2 struct {
 	files            map[common.ElementID]*v2_2.File
 	assocWithPackage map[common.ElementID]bool
 
+	// URIs of the related elements of relationships, to resolve elements of
+	// other documents once the external document references are parsed.
+	relatedElements map[*v2_2.Relationship]string
+
 	// mapping of nodeStrings to parsed object to save double computation.
 	cache map[string]*nodeState
 }
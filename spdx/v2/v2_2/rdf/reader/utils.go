@@ -5,7 +5,10 @@ package reader
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	gordfParser "github.com/spdx/gordf/rdfloader/parser"
 	"github.com/spdx/gordf/rdfwriter"
@@ -24,6 +27,77 @@ func getLastPartOfURI(uri string) string {
 	return parts[len(parts)-1]
 }
 
+var xmlReference = regexp.MustCompile(`&(lt|gt|amp|quot|apos|#[0-9]+|#x[0-9a-fA-F]+);`)
+
+// gordf keeps the values of an xml document as they are written, and reads
+// them one byte at a time. decodeXMLValues restores the UTF-8 encoded text and
+// replaces the character and entity references, and CDATA sections, of the
+// nodes by the text they stand for.
+func decodeXMLValues(triples []*gordfParser.Triple) {
+	decoded := map[*gordfParser.Node]bool{}
+	for _, triple := range triples {
+		for _, node := range []*gordfParser.Node{triple.Subject, triple.Object} {
+			if node == nil || decoded[node] {
+				continue
+			}
+			decoded[node] = true
+			switch node.NodeType {
+			case gordfParser.BLANK, gordfParser.NODEIDLITERAL:
+				continue
+			}
+			node.ID = decodeUTF8(node.ID)
+			if node.NodeType == gordfParser.LITERAL && strings.HasPrefix(node.ID, "<![CDATA[") && strings.HasSuffix(node.ID, "]]>") {
+				node.ID = strings.TrimSuffix(strings.TrimPrefix(node.ID, "<![CDATA["), "]]>")
+				continue
+			}
+			node.ID = xmlReference.ReplaceAllStringFunc(node.ID, decodeXMLReference)
+		}
+	}
+}
+
+// decodeUTF8 returns the text whose bytes gordf read as separate runes, or the
+// value itself if it isn't valid UTF-8 once read this way.
+func decodeUTF8(value string) string {
+	text := make([]byte, 0, len(value))
+	for _, r := range value {
+		if r > 0xff {
+			return value
+		}
+		text = append(text, byte(r))
+	}
+	if !utf8.Valid(text) {
+		return value
+	}
+	return string(text)
+}
+
+func decodeXMLReference(ref string) string {
+	name := ref[1 : len(ref)-1]
+	switch name {
+	case "lt":
+		return "<"
+	case "gt":
+		return ">"
+	case "amp":
+		return "&"
+	case "quot":
+		return `"`
+	case "apos":
+		return "'"
+	}
+	var code int64
+	var err error
+	if strings.HasPrefix(name, "#x") {
+		code, err = strconv.ParseInt(name[2:], 16, 32)
+	} else {
+		code, err = strconv.ParseInt(name[1:], 10, 32)
+	}
+	if err != nil {
+		return ref
+	}
+	return string(rune(code))
+}
+
 func isUriValid(uri string) bool {
 	_, err := urilib.NewURIRef(uri)
 	return err == nil
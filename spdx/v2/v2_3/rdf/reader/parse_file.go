@@ -111,7 +111,7 @@ func (parser *rdfParser2_3) getFileFromNode(fileNode *gordfParser.Node) (file *s
 			file.FileAttributionTexts = append(file.FileAttributionTexts, subTriple.Object.ID)
 		case SPDX_ANNOTATION:
 			// cardinality: min 0
-			err = parser.parseAnnotationFromNode(subTriple.Object)
+			err = parser.parseElementAnnotation(subTriple.Subject.ID, subTriple.Object)
 		case SPDX_RELATIONSHIP:
 			// cardinality: min 0
 			err = parser.parseRelationship(subTriple)
@@ -164,8 +164,14 @@ func (parser *rdfParser2_3) setFileChecksumFromNode(file *spdx.File, checksumNod
 func (parser *rdfParser2_3) getArtifactFromNode(node *gordfParser.Node) (*spdx.ArtifactOfProject, error) {
 	artifactOf := &spdx.ArtifactOfProject{}
 	// setting artifactOfProjectURI attribute (which is optional)
-	if node.NodeType == gordfParser.IRI {
+	switch node.NodeType {
+	case gordfParser.IRI:
 		artifactOf.URI = node.ID
+	case gordfParser.RESOURCELITERAL:
+		// a project referenced by its URI, which RDF syntaxes other than
+		// RDF/XML read for projects with a URI
+		artifactOf.URI = node.ID
+		node = &gordfParser.Node{NodeType: gordfParser.IRI, ID: node.ID}
 	}
 	// parsing rest triples and attributes of the artifact.
 	for _, triple := range parser.nodeToTriples(node) {
@@ -189,7 +195,8 @@ func (parser *rdfParser2_3) getFileTypeFromUri(uri string) (string, error) {
 	if !strings.HasPrefix(lastPart, "fileType_") {
 		return "", fmt.Errorf("fileType Uri must begin with fileTYpe_. found: %s", lastPart)
 	}
-	return strings.TrimPrefix(lastPart, "fileType_"), nil
+	// the model holds file types as tag-value writes them, e.g. SOURCE
+	return strings.ToUpper(strings.TrimPrefix(lastPart, "fileType_")), nil
 }
 
 // populates parser.doc.Files by a list of files which are not
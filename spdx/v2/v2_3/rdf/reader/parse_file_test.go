@@ -128,8 +128,8 @@ func Test_rdfParser2_3_getFileTypeFromUri(t *testing.T) {
 	if err != nil {
 		t.Errorf("error in a valid example: %v", err)
 	}
-	if fileType != "source" {
-		t.Errorf("wrong fileType. expected: %s, found: %s", "source", fileType)
+	if fileType != "SOURCE" {
+		t.Errorf("wrong fileType. expected: %s, found: %s", "SOURCE", fileType)
 	}
 
 	// TestCase 2: Invalid fileType URI format.
@@ -508,7 +508,7 @@ func Test_rdfParser2_3_getFileFromNode(t *testing.T) {
 	if file.LicenseConcluded != expectedLicenseConcluded {
 		t.Errorf("expected %s, found %s", expectedLicenseConcluded, file.LicenseConcluded)
 	}
-	expectedFileType := "source"
+	expectedFileType := "SOURCE"
 	if file.FileTypes[0] != expectedFileType {
 		t.Errorf("expected %s, found %s", expectedFileType, file.FileTypes)
 	}
@@ -594,7 +594,7 @@ func Test_rdfParser2_3_getFileFromNode(t *testing.T) {
 	if len(file.FileTypes) != 1 {
 		t.Errorf("given file should have 1 fileType attribute. found %d", len(file.FileTypes))
 	}
-	expectedFileType = "source"
+	expectedFileType = "SOURCE"
 	if file.FileTypes[0] != expectedFileType {
 		t.Errorf("expected %s, found %s", expectedFileType, file.FileTypes)
 	}
@@ -725,7 +725,7 @@ func Test_rdfParser2_3_getFileFromNode(t *testing.T) {
 	if string(reln.RefB.ElementRefID) != expectedRefBEID {
 		t.Errorf("expected %s, found %s", expectedRefBEID, reln.RefB.ElementRefID)
 	}
-	expectedRelationType := "contains"
+	expectedRelationType := "CONTAINS"
 	if reln.Relationship != expectedRelationType {
 		t.Errorf("expected %s, found %s", expectedRefBEID, reln.RefB.ElementRefID)
 	}
@@ -175,12 +175,10 @@ func (parser *rdfParser2_3) getSpecialLicenseFromNode(node *gordfParser.Node) (l
 	// the license is neither NONE nor NOASSERTION
 	// checking if the license is among the standardLicenses
 	licenseAbbreviation := getLastPartOfURI(uri)
-	for _, stdLicense := range AllStandardLicenseIDS() {
-		if licenseAbbreviation == stdLicense {
-			return SpecialLicense{
-				value: SpecialLicenseValue(stdLicense),
-			}, nil
-		}
+	if isStandardLicense(licenseAbbreviation) {
+		return SpecialLicense{
+			value: SpecialLicenseValue(licenseAbbreviation),
+		}, nil
 	}
 	return lic, fmt.Errorf("found a custom license uri (%s) without any associated fields", uri)
 }
@@ -5,6 +5,7 @@ package reader
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	gordfParser "github.com/spdx/gordf/rdfloader/parser"
 	"github.com/spdx/gordf/rdfwriter"
@@ -59,6 +60,7 @@ func (parser *rdfParser2_3) parseRelationship(triple *gordfParser.Triple) (err e
 			if err != nil {
 				return err
 			}
+			parser.relatedElements[&reln] = subTriple.Object.ID
 
 			relatedSpdxElementTriples := parser.nodeToTriples(subTriple.Object)
 			if len(relatedSpdxElementTriples) == 0 {
@@ -137,7 +139,8 @@ func getReferenceFromURI(uri string) (common.DocElementID, error) {
 	return ExtractDocElementID(fragment)
 }
 
-// note: relationshipType is case sensitive.
+// note: relationshipType is case sensitive. The type is returned as tag-value
+// writes it, e.g. DYNAMIC_LINK for relationshipType_dynamicLink.
 func getRelationshipTypeFromURI(relnTypeURI string) (string, error) {
 	relnTypeURI = strings.TrimSpace(relnTypeURI)
 	lastPart := getLastPartOfURI(relnTypeURI)
@@ -149,8 +152,39 @@ func getRelationshipTypeFromURI(relnTypeURI string) (string, error) {
 	lastPart = strings.TrimSpace(lastPart)
 	for _, validRelationshipType := range AllRelationshipTypes() {
 		if lastPart == validRelationshipType {
-			return lastPart, nil
+			return relationshipTypeName(lastPart), nil
 		}
 	}
 	return "", fmt.Errorf("unknown relationshipType: '%s'", lastPart)
 }
+
+// sets the DocumentRefID of related elements of other documents, which are
+// identified by the namespace of their document in RDF.
+// assumes: the external document references are already parsed.
+func (parser *rdfParser2_3) setExternalDocumentRefs() {
+	for reln, uri := range parser.relatedElements {
+		baseURI, _, err := ExtractSubs(uri, "#")
+		if err != nil || baseURI == parser.doc.DocumentNamespace || reln.RefB.DocumentRefID != "" {
+			continue
+		}
+		for _, ref := range parser.doc.ExternalDocumentReferences {
+			if ref.URI == baseURI {
+				reln.RefB.DocumentRefID = strings.TrimPrefix(ref.DocumentRefID, "DocumentRef-")
+				break
+			}
+		}
+	}
+}
+
+// relationshipTypeName returns the tag-value name of an RDF relationship type:
+// the words of the camel case name, upper case and separated by underscores
+func relationshipTypeName(rdfType string) string {
+	var b strings.Builder
+	for i, c := range rdfType {
+		if unicode.IsUpper(c) && i > 0 {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(c))
+	}
+	return b.String()
+}
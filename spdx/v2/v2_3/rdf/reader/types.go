@@ -21,6 +21,10 @@ type rdfParser2_3 struct {
 	files            map[common.ElementID]*spdx.File
 	assocWithPackage map[common.ElementID]bool
 
+	// URIs of the related elements of relationships, to resolve elements of
+	// other documents once the external document references are parsed.
+	relatedElements map[*spdx.Relationship]string
+
 	// mapping of nodeStrings to parsed object to save double computation.
 	cache map[string]*nodeState
 }
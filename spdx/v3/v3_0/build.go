@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package v3_0
+
+// Build describes an instance of a build, its inputs and parameters.
+// See https://spdx.github.io/spdx-spec/v3.0.1/model/Build/Classes/Build/
+type Build struct {
+	Element
+
+	// build_buildType: a URI describing the type of build
+	// Cardinality: mandatory, one
+	BuildType string `json:"build_buildType"`
+
+	// build_buildId
+	// Cardinality: optional, one
+	BuildID string `json:"build_buildId,omitempty"`
+
+	// build_configSourceEntrypoint
+	// Cardinality: optional, many
+	ConfigSourceEntrypoints []string `json:"build_configSourceEntrypoint,omitempty"`
+
+	// build_configSourceUri
+	// Cardinality: optional, many
+	ConfigSourceURIs []string `json:"build_configSourceUri,omitempty"`
+
+	// build_configSourceDigest
+	// Cardinality: optional, many
+	ConfigSourceDigests []IntegrityMethod `json:"build_configSourceDigest,omitempty"`
+
+	// build_parameter
+	// Cardinality: optional, many
+	Parameters []DictionaryEntry `json:"build_parameter,omitempty"`
+
+	// build_buildStartTime
+	// Cardinality: optional, one
+	BuildStartTime string `json:"build_buildStartTime,omitempty"`
+
+	// build_buildEndTime
+	// Cardinality: optional, one
+	BuildEndTime string `json:"build_buildEndTime,omitempty"`
+
+	// build_environment
+	// Cardinality: optional, many
+	Environment []DictionaryEntry `json:"build_environment,omitempty"`
+}
+
+func (b *Build) Type() string {
+	return TypeBuild
+}
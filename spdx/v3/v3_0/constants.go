@@ -0,0 +1,295 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package v3_0
+
+// Compacted JSON-LD type names of the classes in the model
+const (
+	// Core
+	TypeSpdxDocument                = "SpdxDocument"
+	TypeCreationInfo                = "CreationInfo"
+	TypeRelationship                = "Relationship"
+	TypeLifecycleScopedRelationship = "LifecycleScopedRelationship"
+	TypeAnnotation                  = "Annotation"
+	TypeAgent                       = "Agent"
+	TypePerson                      = "Person"
+	TypeOrganization                = "Organization"
+	TypeSoftwareAgent               = "SoftwareAgent"
+	TypeTool                        = "Tool"
+	TypeBundle                      = "Bundle"
+	TypeBom                         = "Bom"
+	TypeHash                        = "Hash"
+	TypePackageVerificationCode     = "PackageVerificationCode"
+	TypeExternalRef                 = "ExternalRef"
+	TypeExternalIdentifier          = "ExternalIdentifier"
+	TypeExternalMap                 = "ExternalMap"
+	TypeNamespaceMap                = "NamespaceMap"
+	TypePositiveIntegerRange        = "PositiveIntegerRange"
+	TypeDictionaryEntry             = "DictionaryEntry"
+
+	// Software
+	TypePackage           = "software_Package"
+	TypeFile              = "software_File"
+	TypeSnippet           = "software_Snippet"
+	TypeSbom              = "software_Sbom"
+	TypeContentIdentifier = "software_ContentIdentifier"
+
+	// SimpleLicensing and ExpandedLicensing
+	TypeLicenseExpression      = "simplelicensing_LicenseExpression"
+	TypeSimpleLicensingText    = "simplelicensing_SimpleLicensingText"
+	TypeListedLicense          = "expandedlicensing_ListedLicense"
+	TypeCustomLicense          = "expandedlicensing_CustomLicense"
+	TypeListedLicenseException = "expandedlicensing_ListedLicenseException"
+	TypeCustomLicenseAddition  = "expandedlicensing_CustomLicenseAddition"
+
+	// Build
+	TypeBuild = "build_Build"
+)
+
+// Individuals defined by the model that may be used in place of an element reference
+const (
+	NoAssertionElement = "https://spdx.org/rdf/3.0.1/terms/Core/NoAssertionElement"
+	NoneElement        = "https://spdx.org/rdf/3.0.1/terms/Core/NoneElement"
+	NoAssertionLicense = "https://spdx.org/rdf/3.0.1/terms/Licensing/NoAssertion"
+	NoneLicense        = "https://spdx.org/rdf/3.0.1/terms/Licensing/None"
+	SpdxOrganization   = "https://spdx.org/rdf/3.0.1/terms/Core/SpdxOrganization"
+)
+
+// ProfileIdentifierType values
+const (
+	ProfileCore              = "core"
+	ProfileSoftware          = "software"
+	ProfileSimpleLicensing   = "simpleLicensing"
+	ProfileExpandedLicensing = "expandedLicensing"
+	ProfileSecurity          = "security"
+	ProfileBuild             = "build"
+	ProfileAI                = "ai"
+	ProfileDataset           = "dataset"
+	ProfileExtension         = "extension"
+	ProfileLite              = "lite"
+)
+
+// RelationshipType values
+const (
+	RelationshipAffects                    = "affects"
+	RelationshipAmendedBy                  = "amendedBy"
+	RelationshipAncestorOf                 = "ancestorOf"
+	RelationshipAvailableFrom              = "availableFrom"
+	RelationshipConfigures                 = "configures"
+	RelationshipContains                   = "contains"
+	RelationshipCoordinatedBy              = "coordinatedBy"
+	RelationshipCopiedTo                   = "copiedTo"
+	RelationshipDelegatedTo                = "delegatedTo"
+	RelationshipDependsOn                  = "dependsOn"
+	RelationshipDescendantOf               = "descendantOf"
+	RelationshipDescribes                  = "describes"
+	RelationshipDoesNotAffect              = "doesNotAffect"
+	RelationshipExpandsTo                  = "expandsTo"
+	RelationshipExploitCreatedBy           = "exploitCreatedBy"
+	RelationshipFixedBy                    = "fixedBy"
+	RelationshipFixedIn                    = "fixedIn"
+	RelationshipFoundBy                    = "foundBy"
+	RelationshipGenerates                  = "generates"
+	RelationshipHasAddedFile               = "hasAddedFile"
+	RelationshipHasAssessmentFor           = "hasAssessmentFor"
+	RelationshipHasAssociatedVulnerability = "hasAssociatedVulnerability"
+	RelationshipHasConcludedLicense        = "hasConcludedLicense"
+	RelationshipHasDataFile                = "hasDataFile"
+	RelationshipHasDeclaredLicense         = "hasDeclaredLicense"
+	RelationshipHasDeletedFile             = "hasDeletedFile"
+	RelationshipHasDependencyManifest      = "hasDependencyManifest"
+	RelationshipHasDistributionArtifact    = "hasDistributionArtifact"
+	RelationshipHasDocumentation           = "hasDocumentation"
+	RelationshipHasDynamicLink             = "hasDynamicLink"
+	RelationshipHasEvidence                = "hasEvidence"
+	RelationshipHasExample                 = "hasExample"
+	RelationshipHasHost                    = "hasHost"
+	RelationshipHasInput                   = "hasInput"
+	RelationshipHasMetadata                = "hasMetadata"
+	RelationshipHasOptionalComponent       = "hasOptionalComponent"
+	RelationshipHasOptionalDependency      = "hasOptionalDependency"
+	RelationshipHasOutput                  = "hasOutput"
+	RelationshipHasPrerequisite            = "hasPrerequisite"
+	RelationshipHasProvidedDependency      = "hasProvidedDependency"
+	RelationshipHasRequirement             = "hasRequirement"
+	RelationshipHasSpecification           = "hasSpecification"
+	RelationshipHasStaticLink              = "hasStaticLink"
+	RelationshipHasTest                    = "hasTest"
+	RelationshipHasTestCase                = "hasTestCase"
+	RelationshipHasVariant                 = "hasVariant"
+	RelationshipInvokedBy                  = "invokedBy"
+	RelationshipModifiedBy                 = "modifiedBy"
+	RelationshipOther                      = "other"
+	RelationshipPackagedBy                 = "packagedBy"
+	RelationshipPatchedBy                  = "patchedBy"
+	RelationshipPublishedBy                = "publishedBy"
+	RelationshipReportedBy                 = "reportedBy"
+	RelationshipRepublishedBy              = "republishedBy"
+	RelationshipSerializedInArtifact       = "serializedInArtifact"
+	RelationshipTestedOn                   = "testedOn"
+	RelationshipTrainedOn                  = "trainedOn"
+	RelationshipUnderInvestigationFor      = "underInvestigationFor"
+	RelationshipUsesTool                   = "usesTool"
+)
+
+// RelationshipCompleteness values
+const (
+	CompletenessComplete    = "complete"
+	CompletenessIncomplete  = "incomplete"
+	CompletenessNoAssertion = "noAssertion"
+)
+
+// LifecycleScopeType values
+const (
+	LifecycleScopeDesign      = "design"
+	LifecycleScopeDevelopment = "development"
+	LifecycleScopeBuild       = "build"
+	LifecycleScopeTest        = "test"
+	LifecycleScopeRuntime     = "runtime"
+	LifecycleScopeOther       = "other"
+)
+
+// AnnotationType values
+const (
+	AnnotationTypeOther  = "other"
+	AnnotationTypeReview = "review"
+)
+
+// HashAlgorithm values
+const (
+	HashAlgorithmAdler32           = "adler32"
+	HashAlgorithmBlake2b256        = "blake2b256"
+	HashAlgorithmBlake2b384        = "blake2b384"
+	HashAlgorithmBlake2b512        = "blake2b512"
+	HashAlgorithmBlake3            = "blake3"
+	HashAlgorithmCrystalsDilithium = "crystalsDilithium"
+	HashAlgorithmCrystalsKyber     = "crystalsKyber"
+	HashAlgorithmFalcon            = "falcon"
+	HashAlgorithmMD2               = "md2"
+	HashAlgorithmMD4               = "md4"
+	HashAlgorithmMD5               = "md5"
+	HashAlgorithmMD6               = "md6"
+	HashAlgorithmOther             = "other"
+	HashAlgorithmSHA1              = "sha1"
+	HashAlgorithmSHA224            = "sha224"
+	HashAlgorithmSHA256            = "sha256"
+	HashAlgorithmSHA384            = "sha384"
+	HashAlgorithmSHA3_224          = "sha3_224"
+	HashAlgorithmSHA3_256          = "sha3_256"
+	HashAlgorithmSHA3_384          = "sha3_384"
+	HashAlgorithmSHA3_512          = "sha3_512"
+	HashAlgorithmSHA512            = "sha512"
+)
+
+// ExternalIdentifierType values
+const (
+	ExternalIdentifierCPE22         = "cpe22"
+	ExternalIdentifierCPE23         = "cpe23"
+	ExternalIdentifierCVE           = "cve"
+	ExternalIdentifierEmail         = "email"
+	ExternalIdentifierGitoid        = "gitoid"
+	ExternalIdentifierOther         = "other"
+	ExternalIdentifierPackageURL    = "packageUrl"
+	ExternalIdentifierSecurityOther = "securityOther"
+	ExternalIdentifierSwhid         = "swhid"
+	ExternalIdentifierSwid          = "swid"
+	ExternalIdentifierURLScheme     = "urlScheme"
+)
+
+// ExternalRefType values
+const (
+	ExternalRefAltDownloadLocation                   = "altDownloadLocation"
+	ExternalRefAltWebPage                            = "altWebPage"
+	ExternalRefBinaryArtifact                        = "binaryArtifact"
+	ExternalRefBower                                 = "bower"
+	ExternalRefBuildMeta                             = "buildMeta"
+	ExternalRefBuildSystem                           = "buildSystem"
+	ExternalRefCertificationReport                   = "certificationReport"
+	ExternalRefChat                                  = "chat"
+	ExternalRefComponentAnalysisReport               = "componentAnalysisReport"
+	ExternalRefCwe                                   = "cwe"
+	ExternalRefDocumentation                         = "documentation"
+	ExternalRefDynamicAnalysisReport                 = "dynamicAnalysisReport"
+	ExternalRefEolNotice                             = "eolNotice"
+	ExternalRefExportControlAssessment               = "exportControlAssessment"
+	ExternalRefFunding                               = "funding"
+	ExternalRefIssueTracker                          = "issueTracker"
+	ExternalRefLicense                               = "license"
+	ExternalRefMailingList                           = "mailingList"
+	ExternalRefMavenCentral                          = "mavenCentral"
+	ExternalRefMetrics                               = "metrics"
+	ExternalRefNpm                                   = "npm"
+	ExternalRefNuget                                 = "nuget"
+	ExternalRefOther                                 = "other"
+	ExternalRefPrivacyAssessment                     = "privacyAssessment"
+	ExternalRefProductMetadata                       = "productMetadata"
+	ExternalRefPurchaseOrder                         = "purchaseOrder"
+	ExternalRefQualityAssessmentReport               = "qualityAssessmentReport"
+	ExternalRefReleaseHistory                        = "releaseHistory"
+	ExternalRefReleaseNotes                          = "releaseNotes"
+	ExternalRefRiskAssessment                        = "riskAssessment"
+	ExternalRefRuntimeAnalysisReport                 = "runtimeAnalysisReport"
+	ExternalRefSecureSoftwareAttestation             = "secureSoftwareAttestation"
+	ExternalRefSecurityAdversaryModel                = "securityAdversaryModel"
+	ExternalRefSecurityAdvisory                      = "securityAdvisory"
+	ExternalRefSecurityFix                           = "securityFix"
+	ExternalRefSecurityOther                         = "securityOther"
+	ExternalRefSecurityPenTestReport                 = "securityPenTestReport"
+	ExternalRefSecurityPolicy                        = "securityPolicy"
+	ExternalRefSecurityThreatModel                   = "securityThreatModel"
+	ExternalRefSocialMedia                           = "socialMedia"
+	ExternalRefSourceArtifact                        = "sourceArtifact"
+	ExternalRefStaticAnalysisReport                  = "staticAnalysisReport"
+	ExternalRefSupport                               = "support"
+	ExternalRefVcs                                   = "vcs"
+	ExternalRefVulnerabilityDisclosureReport         = "vulnerabilityDisclosureReport"
+	ExternalRefVulnerabilityExploitabilityAssessment = "vulnerabilityExploitabilityAssessment"
+)
+
+// SoftwarePurpose values
+const (
+	PurposeApplication     = "application"
+	PurposeArchive         = "archive"
+	PurposeBom             = "bom"
+	PurposeConfiguration   = "configuration"
+	PurposeContainer       = "container"
+	PurposeData            = "data"
+	PurposeDevice          = "device"
+	PurposeDeviceDriver    = "deviceDriver"
+	PurposeDiskImage       = "diskImage"
+	PurposeDocumentation   = "documentation"
+	PurposeEvidence        = "evidence"
+	PurposeExecutable      = "executable"
+	PurposeFile            = "file"
+	PurposeFilesystemImage = "filesystemImage"
+	PurposeFirmware        = "firmware"
+	PurposeFramework       = "framework"
+	PurposeInstall         = "install"
+	PurposeLibrary         = "library"
+	PurposeManifest        = "manifest"
+	PurposeModel           = "model"
+	PurposeModule          = "module"
+	PurposeOperatingSystem = "operatingSystem"
+	PurposeOther           = "other"
+	PurposePatch           = "patch"
+	PurposePlatform        = "platform"
+	PurposeRequirement     = "requirement"
+	PurposeSource          = "source"
+	PurposeSpecification   = "specification"
+	PurposeTest            = "test"
+)
+
+// FileKindType values
+const (
+	FileKindDirectory = "directory"
+	FileKindFile      = "file"
+)
+
+// SbomType values
+const (
+	SbomTypeAnalyzed = "analyzed"
+	SbomTypeBuild    = "build"
+	SbomTypeDeployed = "deployed"
+	SbomTypeDesign   = "design"
+	SbomTypeRuntime  = "runtime"
+	SbomTypeSource   = "source"
+)
@@ -0,0 +1,142 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package v3_0
+
+// Relationship describes a relationship from one element to one or more others.
+// See https://spdx.github.io/spdx-spec/v3.0.1/model/Core/Classes/Relationship/
+type Relationship struct {
+	Element
+
+	// from: the SPDX ID of the element on the left-hand side of the relationship
+	// Cardinality: mandatory, one
+	From string `json:"from"`
+
+	// to: the SPDX IDs of the elements on the right-hand side of the relationship
+	// Cardinality: mandatory, one or many
+	To []string `json:"to"`
+
+	// relationshipType: one of the RelationshipType values
+	// Cardinality: mandatory, one
+	RelationshipType string `json:"relationshipType"`
+
+	// completeness: one of the RelationshipCompleteness values
+	// Cardinality: optional, one
+	Completeness string `json:"completeness,omitempty"`
+
+	// startTime
+	// Cardinality: optional, one
+	StartTime string `json:"startTime,omitempty"`
+
+	// endTime
+	// Cardinality: optional, one
+	EndTime string `json:"endTime,omitempty"`
+}
+
+func (r *Relationship) Type() string {
+	return TypeRelationship
+}
+
+// LifecycleScopedRelationship is a Relationship that applies to a lifecycle phase, such as build or test
+type LifecycleScopedRelationship struct {
+	Relationship
+
+	// scope: one of the LifecycleScopeType values
+	// Cardinality: optional, one
+	Scope string `json:"scope,omitempty"`
+}
+
+func (r *LifecycleScopedRelationship) Type() string {
+	return TypeLifecycleScopedRelationship
+}
+
+// Annotation is an assertion made in relation to another element
+type Annotation struct {
+	Element
+
+	// annotationType: one of the AnnotationType values
+	// Cardinality: mandatory, one
+	AnnotationType string `json:"annotationType"`
+
+	// subject: the SPDX ID of the annotated element
+	// Cardinality: mandatory, one
+	Subject string `json:"subject"`
+
+	// statement
+	// Cardinality: optional, one
+	Statement string `json:"statement,omitempty"`
+
+	// contentType
+	// Cardinality: optional, one
+	ContentType string `json:"contentType,omitempty"`
+}
+
+func (a *Annotation) Type() string {
+	return TypeAnnotation
+}
+
+// Agent is an entity that can take actions, when it is not known which kind of agent it is
+type Agent struct {
+	Element
+}
+
+func (a *Agent) Type() string {
+	return TypeAgent
+}
+
+// Person is an individual human being
+type Person struct {
+	Agent
+}
+
+func (p *Person) Type() string {
+	return TypePerson
+}
+
+// Organization is a group of people who work together in an organized way
+type Organization struct {
+	Agent
+}
+
+func (o *Organization) Type() string {
+	return TypeOrganization
+}
+
+// SoftwareAgent is a software program given the authority to act on behalf of a person or organization
+type SoftwareAgent struct {
+	Agent
+}
+
+func (a *SoftwareAgent) Type() string {
+	return TypeSoftwareAgent
+}
+
+// Tool is an element of hardware and/or software used to create other elements
+type Tool struct {
+	Element
+}
+
+func (t *Tool) Type() string {
+	return TypeTool
+}
+
+// Bundle is a collection of elements that have a shared context
+type Bundle struct {
+	ElementCollection
+
+	// context: the purpose for which the elements were gathered
+	// Cardinality: optional, one
+	Context string `json:"context,omitempty"`
+}
+
+func (b *Bundle) Type() string {
+	return TypeBundle
+}
+
+// Bom is a bill of materials
+type Bom struct {
+	Bundle
+}
+
+func (b *Bom) Type() string {
+	return TypeBom
+}
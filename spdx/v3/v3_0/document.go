@@ -0,0 +1,206 @@
+// Package v3_0 contains the struct definition for an SPDX 3.0 Document
+// and the elements of the Core, Software, Licensing and Build profiles.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+package v3_0
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Version is the SPDX specification version written to CreationInfo.SpecVersion
+const Version = "3.0.1"
+
+// Context is the JSON-LD context of the SPDX 3.0.1 serialization
+const Context = "https://spdx.org/rdf/3.0.1/spdx-context.jsonld"
+
+// DataLicense is the license identifier every SPDX 3.0 document uses for its own data
+const DataLicense = "CC0-1.0"
+
+// Document is an SPDX 3.0 SpdxDocument together with the graph of elements
+// serialized alongside it.
+// See https://spdx.github.io/spdx-spec/v3.0.1/model/Core/Classes/SpdxDocument/
+type Document struct {
+	ElementCollection
+
+	// dataLicense: the SPDX ID of the license element the document data is released under
+	// Cardinality: optional, one
+	DataLicense string `json:"dataLicense,omitempty"`
+
+	// import: elements referenced in this document but defined elsewhere
+	// Cardinality: optional, many
+	Imports []ExternalMap `json:"import,omitempty"`
+
+	// namespaceMap: prefixes used to shorten SPDX IDs in this document
+	// Cardinality: optional, many
+	NamespaceMap []NamespaceMap `json:"namespaceMap,omitempty"`
+
+	// NOT PART OF SPEC
+	// Elements holds every element of the serialized graph other than the
+	// SpdxDocument itself and the CreationInfo nodes. References between
+	// elements are held as SPDX IDs and can be resolved with Find.
+	Elements []AnyElement `json:"-"`
+}
+
+// Type returns the JSON-LD type name of the SpdxDocument
+func (d *Document) Type() string {
+	return TypeSpdxDocument
+}
+
+// Find returns the element with the given SPDX ID, or nil if there is none in the document
+func (d *Document) Find(spdxID string) AnyElement {
+	if spdxID == "" {
+		return nil
+	}
+	if d.SpdxID == spdxID {
+		return d
+	}
+	for _, e := range d.Elements {
+		if e.Base().SpdxID == spdxID {
+			return e
+		}
+	}
+	return nil
+}
+
+// MarshalJSON writes the document as a JSON-LD graph: the shared CreationInfo
+// nodes first, then the SpdxDocument and then all other elements. If
+// ElementIDs is empty, the IDs of all Elements are written as the element property.
+func (d Document) MarshalJSON() ([]byte, error) {
+	w := graphWriter{ids: map[*CreationInfo]string{}, used: map[string]bool{}}
+
+	w.addCreationInfo(d.CreationInfo)
+	for _, e := range d.Elements {
+		if e == nil {
+			return nil, fmt.Errorf("nil element in SPDX 3 document")
+		}
+		w.addCreationInfo(e.Base().CreationInfo)
+	}
+
+	doc := d
+	if len(doc.ElementIDs) == 0 {
+		for _, e := range d.Elements {
+			doc.ElementIDs = append(doc.ElementIDs, e.Base().SpdxID)
+		}
+	}
+
+	type document Document
+	node, err := w.marshalNode(TypeSpdxDocument, document(doc), d.CreationInfo)
+	if err != nil {
+		return nil, err
+	}
+	w.graph = append(w.graph, node)
+
+	for _, e := range d.Elements {
+		node, err = w.marshalElement(e)
+		if err != nil {
+			return nil, err
+		}
+		w.graph = append(w.graph, node)
+	}
+
+	return json.Marshal(struct {
+		Context string            `json:"@context"`
+		Graph   []json.RawMessage `json:"@graph"`
+	}{
+		Context: Context,
+		Graph:   w.graph,
+	})
+}
+
+// UnmarshalJSON reads a JSON-LD graph in the compacted form used by the
+// SPDX 3.0 serialization specification. The graph must contain exactly one
+// SpdxDocument element.
+func (d *Document) UnmarshalJSON(b []byte) error {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(b, &top); err != nil {
+		return err
+	}
+
+	if err := checkContext(top["@context"]); err != nil {
+		return err
+	}
+
+	var rawNodes []json.RawMessage
+	if graph, ok := top["@graph"]; ok {
+		if err := json.Unmarshal(graph, &rawNodes); err != nil {
+			return fmt.Errorf("invalid @graph: %w", err)
+		}
+	} else {
+		// a document may also be serialized as a single top-level node
+		delete(top, "@context")
+		single, err := json.Marshal(top)
+		if err != nil {
+			return err
+		}
+		rawNodes = append(rawNodes, single)
+	}
+
+	r := graphReader{creationInfos: map[string]*CreationInfo{}}
+
+	// creation info nodes are referenced by blank node ID from the elements,
+	// so they have to be collected before any element is decoded
+	var nodes []node
+	for _, raw := range rawNodes {
+		n, err := parseNode(raw)
+		if err != nil {
+			return err
+		}
+		if n.typ == TypeCreationInfo {
+			if _, err = r.decodeCreationInfo(n.raw); err != nil {
+				return err
+			}
+			continue
+		}
+		nodes = append(nodes, n)
+	}
+
+	var out *Document
+	var elements []AnyElement
+	for _, n := range nodes {
+		if n.typ == TypeSpdxDocument {
+			if out != nil {
+				return fmt.Errorf("more than one %s in graph", TypeSpdxDocument)
+			}
+			type document Document
+			var doc document
+			if err := r.decode(n, &doc); err != nil {
+				return err
+			}
+			if err := r.resolveCreationInfo(n, &doc.Element); err != nil {
+				return err
+			}
+			out = (*Document)(&doc)
+			continue
+		}
+		e, err := r.decodeElement(n)
+		if err != nil {
+			return err
+		}
+		elements = append(elements, e)
+	}
+
+	if out == nil {
+		return fmt.Errorf("graph does not contain an %s", TypeSpdxDocument)
+	}
+
+	out.Elements = elements
+	*d = *out
+	return nil
+}
+
+var _ json.Marshaler = (*Document)(nil)
+var _ json.Unmarshaler = (*Document)(nil)
+
+// checkContext verifies the @context refers to an SPDX 3.0 context
+func checkContext(raw json.RawMessage) error {
+	if len(raw) == 0 {
+		return fmt.Errorf("JSON-LD document does not contain @context")
+	}
+	if !bytes.Contains(raw, []byte("spdx.org/rdf/3.0")) {
+		return fmt.Errorf("unsupported JSON-LD @context: %s", strings.TrimSpace(string(raw)))
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package v3_0
+
+import (
+	"encoding/json"
+)
+
+// AnyElement is implemented by every element type of the model, including
+// Document itself
+type AnyElement interface {
+	// Base returns the properties common to all elements
+	Base() *Element
+
+	// Type returns the compacted JSON-LD type name of the element, e.g. "software_Package"
+	Type() string
+}
+
+// Element holds the properties shared by all SPDX 3.0 elements.
+// See https://spdx.github.io/spdx-spec/v3.0.1/model/Core/Classes/Element/
+type Element struct {
+	// spdxId: the unique IRI of the element
+	// Cardinality: mandatory, one
+	SpdxID string `json:"spdxId"`
+
+	// name
+	// Cardinality: optional, one
+	Name string `json:"name,omitempty"`
+
+	// summary
+	// Cardinality: optional, one
+	Summary string `json:"summary,omitempty"`
+
+	// description
+	// Cardinality: optional, one
+	Description string `json:"description,omitempty"`
+
+	// comment
+	// Cardinality: optional, one
+	Comment string `json:"comment,omitempty"`
+
+	// creationInfo: serialized as a reference to a shared CreationInfo node
+	// Cardinality: mandatory, one
+	CreationInfo *CreationInfo `json:"-"`
+
+	// verifiedUsing
+	// Cardinality: optional, many
+	VerifiedUsing []IntegrityMethod `json:"verifiedUsing,omitempty"`
+
+	// externalRef
+	// Cardinality: optional, many
+	ExternalRefs []ExternalRef `json:"externalRef,omitempty"`
+
+	// externalIdentifier
+	// Cardinality: optional, many
+	ExternalIdentifiers []ExternalIdentifier `json:"externalIdentifier,omitempty"`
+}
+
+// Base returns the receiver, so that every type embedding Element implements AnyElement
+func (e *Element) Base() *Element {
+	return e
+}
+
+// ElementCollection holds the properties shared by Bundle, Bom, Sbom and SpdxDocument
+type ElementCollection struct {
+	Element
+
+	// element: the SPDX IDs of the elements in the collection
+	// Cardinality: optional, many
+	ElementIDs []string `json:"element,omitempty"`
+
+	// rootElement: the SPDX IDs of the main elements of the collection
+	// Cardinality: optional, many
+	RootElementIDs []string `json:"rootElement,omitempty"`
+
+	// profileConformance: the profiles the collection conforms to
+	// Cardinality: optional, many
+	ProfileConformance []string `json:"profileConformance,omitempty"`
+}
+
+// Artifact holds the properties shared by elements that are distinct
+// artifacts, such as packages, files and snippets
+type Artifact struct {
+	Element
+
+	// originatedBy: the SPDX IDs of the agents that originally created the artifact
+	// Cardinality: optional, many
+	OriginatedBy []string `json:"originatedBy,omitempty"`
+
+	// suppliedBy: the SPDX ID of the agent that distributes the artifact
+	// Cardinality: optional, one
+	SuppliedBy string `json:"suppliedBy,omitempty"`
+
+	// builtTime
+	// Cardinality: optional, one
+	BuiltTime string `json:"builtTime,omitempty"`
+
+	// releaseTime
+	// Cardinality: optional, one
+	ReleaseTime string `json:"releaseTime,omitempty"`
+
+	// validUntilTime
+	// Cardinality: optional, one
+	ValidUntilTime string `json:"validUntilTime,omitempty"`
+
+	// standardName
+	// Cardinality: optional, many
+	StandardNames []string `json:"standardName,omitempty"`
+
+	// supportLevel
+	// Cardinality: optional, many
+	SupportLevels []string `json:"supportLevel,omitempty"`
+}
+
+// CreationInfo provides information about the creation of an element.
+// It is serialized once as a blank node and referenced from each element.
+type CreationInfo struct {
+	// NOT PART OF SPEC
+	// ID is the blank node identifier, e.g. "_:creationinfo". One is assigned
+	// when writing if it is empty.
+	ID string `json:"@id,omitempty"`
+
+	// specVersion
+	// Cardinality: mandatory, one
+	SpecVersion string `json:"specVersion"`
+
+	// created: ISO 8601 timestamp
+	// Cardinality: mandatory, one
+	Created string `json:"created"`
+
+	// createdBy: the SPDX IDs of the agents that created the element
+	// Cardinality: mandatory, one or many
+	CreatedBy []string `json:"createdBy"`
+
+	// createdUsing: the SPDX IDs of the tools used to create the element
+	// Cardinality: optional, many
+	CreatedUsing []string `json:"createdUsing,omitempty"`
+
+	// comment
+	// Cardinality: optional, one
+	Comment string `json:"comment,omitempty"`
+}
+
+// IntegrityMethod is either a Hash or a PackageVerificationCode, as indicated by Type
+type IntegrityMethod struct {
+	// Type is TypeHash or TypePackageVerificationCode
+	Type string `json:"type"`
+
+	// algorithm: one of the HashAlgorithm values
+	// Cardinality: mandatory, one
+	Algorithm string `json:"algorithm"`
+
+	// hashValue
+	// Cardinality: mandatory, one
+	HashValue string `json:"hashValue"`
+
+	// packageVerificationCodeExcludedFile: only used by PackageVerificationCode
+	// Cardinality: optional, many
+	PackageVerificationCodeExcludedFiles []string `json:"packageVerificationCodeExcludedFile,omitempty"`
+
+	// comment
+	// Cardinality: optional, one
+	Comment string `json:"comment,omitempty"`
+}
+
+// ExternalRef is a reference to a resource outside the scope of SPDX
+type ExternalRef struct {
+	// externalRefType: one of the ExternalRefType values
+	// Cardinality: optional, one
+	ExternalRefType string `json:"externalRefType,omitempty"`
+
+	// locator
+	// Cardinality: optional, many
+	Locators []string `json:"locator,omitempty"`
+
+	// contentType
+	// Cardinality: optional, one
+	ContentType string `json:"contentType,omitempty"`
+
+	// comment
+	// Cardinality: optional, one
+	Comment string `json:"comment,omitempty"`
+}
+
+// MarshalJSON adds the JSON-LD type to the serialized ExternalRef
+func (r ExternalRef) MarshalJSON() ([]byte, error) {
+	type ref ExternalRef
+	return withType(TypeExternalRef, ref(r))
+}
+
+// ExternalIdentifier is an identifier for the element defined outside of SPDX, such as a purl or CPE
+type ExternalIdentifier struct {
+	// externalIdentifierType: one of the ExternalIdentifierType values
+	// Cardinality: mandatory, one
+	ExternalIdentifierType string `json:"externalIdentifierType"`
+
+	// identifier
+	// Cardinality: mandatory, one
+	Identifier string `json:"identifier"`
+
+	// comment
+	// Cardinality: optional, one
+	Comment string `json:"comment,omitempty"`
+
+	// identifierLocator
+	// Cardinality: optional, many
+	IdentifierLocators []string `json:"identifierLocator,omitempty"`
+
+	// issuingAuthority
+	// Cardinality: optional, one
+	IssuingAuthority string `json:"issuingAuthority,omitempty"`
+}
+
+// MarshalJSON adds the JSON-LD type to the serialized ExternalIdentifier
+func (i ExternalIdentifier) MarshalJSON() ([]byte, error) {
+	type id ExternalIdentifier
+	return withType(TypeExternalIdentifier, id(i))
+}
+
+// ExternalMap describes an element referenced in a document but defined elsewhere
+type ExternalMap struct {
+	// externalSpdxId
+	// Cardinality: mandatory, one
+	ExternalSpdxID string `json:"externalSpdxId"`
+
+	// verifiedUsing
+	// Cardinality: optional, many
+	VerifiedUsing []IntegrityMethod `json:"verifiedUsing,omitempty"`
+
+	// locationHint: where the defining document can be found
+	// Cardinality: optional, one
+	LocationHint string `json:"locationHint,omitempty"`
+
+	// definingArtifact: the SPDX ID of the artifact that defines the element
+	// Cardinality: optional, one
+	DefiningArtifact string `json:"definingArtifact,omitempty"`
+}
+
+// MarshalJSON adds the JSON-LD type to the serialized ExternalMap
+func (m ExternalMap) MarshalJSON() ([]byte, error) {
+	type external ExternalMap
+	return withType(TypeExternalMap, external(m))
+}
+
+// NamespaceMap maps a short prefix to the namespace it abbreviates
+type NamespaceMap struct {
+	// prefix
+	// Cardinality: mandatory, one
+	Prefix string `json:"prefix"`
+
+	// namespace
+	// Cardinality: mandatory, one
+	Namespace string `json:"namespace"`
+}
+
+// MarshalJSON adds the JSON-LD type to the serialized NamespaceMap
+func (m NamespaceMap) MarshalJSON() ([]byte, error) {
+	type namespace NamespaceMap
+	return withType(TypeNamespaceMap, namespace(m))
+}
+
+// PositiveIntegerRange is an inclusive range of positive integers, used by snippets
+type PositiveIntegerRange struct {
+	// beginIntegerRange
+	// Cardinality: mandatory, one
+	Begin int `json:"beginIntegerRange"`
+
+	// endIntegerRange
+	// Cardinality: mandatory, one
+	End int `json:"endIntegerRange"`
+}
+
+// MarshalJSON adds the JSON-LD type to the serialized PositiveIntegerRange
+func (r PositiveIntegerRange) MarshalJSON() ([]byte, error) {
+	type integerRange PositiveIntegerRange
+	return withType(TypePositiveIntegerRange, integerRange(r))
+}
+
+// DictionaryEntry is a key-value pair
+type DictionaryEntry struct {
+	// key
+	// Cardinality: mandatory, one
+	Key string `json:"key"`
+
+	// value
+	// Cardinality: optional, one
+	Value string `json:"value,omitempty"`
+}
+
+// MarshalJSON adds the JSON-LD type to the serialized DictionaryEntry
+func (e DictionaryEntry) MarshalJSON() ([]byte, error) {
+	type entry DictionaryEntry
+	return withType(TypeDictionaryEntry, entry(e))
+}
+
+// ContentIdentifier is a canonical, unique, immutable identifier of the artifact content
+type ContentIdentifier struct {
+	// software_contentIdentifierType: "gitoid" or "swhid"
+	// Cardinality: mandatory, one
+	ContentIdentifierType string `json:"software_contentIdentifierType"`
+
+	// software_contentIdentifierValue
+	// Cardinality: mandatory, one
+	ContentIdentifierValue string `json:"software_contentIdentifierValue"`
+}
+
+// MarshalJSON adds the JSON-LD type to the serialized ContentIdentifier
+func (c ContentIdentifier) MarshalJSON() ([]byte, error) {
+	type id ContentIdentifier
+	return withType(TypeContentIdentifier, id(c))
+}
+
+// UnknownElement preserves an element whose type is not part of the model,
+// e.g. one from the Security or AI profiles, so that it can be written back unchanged
+type UnknownElement struct {
+	Element
+
+	// TypeName is the JSON-LD type of the element
+	TypeName string `json:"-"`
+
+	// Raw is the complete JSON object read from the graph
+	Raw json.RawMessage `json:"-"`
+}
+
+// Type returns the JSON-LD type the element was read with
+func (u *UnknownElement) Type() string {
+	return u.TypeName
+}
@@ -0,0 +1,285 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package v3_0
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// elementTypes maps the compacted JSON-LD type names to constructors for
+// the structs that represent them
+var elementTypes = map[string]func() AnyElement{
+	TypeRelationship:                func() AnyElement { return &Relationship{} },
+	TypeLifecycleScopedRelationship: func() AnyElement { return &LifecycleScopedRelationship{} },
+	TypeAnnotation:                  func() AnyElement { return &Annotation{} },
+	TypeAgent:                       func() AnyElement { return &Agent{} },
+	TypePerson:                      func() AnyElement { return &Person{} },
+	TypeOrganization:                func() AnyElement { return &Organization{} },
+	TypeSoftwareAgent:               func() AnyElement { return &SoftwareAgent{} },
+	TypeTool:                        func() AnyElement { return &Tool{} },
+	TypeBundle:                      func() AnyElement { return &Bundle{} },
+	TypeBom:                         func() AnyElement { return &Bom{} },
+	TypeSbom:                        func() AnyElement { return &Sbom{} },
+	TypePackage:                     func() AnyElement { return &Package{} },
+	TypeFile:                        func() AnyElement { return &File{} },
+	TypeSnippet:                     func() AnyElement { return &Snippet{} },
+	TypeLicenseExpression:           func() AnyElement { return &LicenseExpression{} },
+	TypeSimpleLicensingText:         func() AnyElement { return &SimpleLicensingText{} },
+	TypeListedLicense:               func() AnyElement { return &ListedLicense{} },
+	TypeCustomLicense:               func() AnyElement { return &CustomLicense{} },
+	TypeListedLicenseException:      func() AnyElement { return &ListedLicenseException{} },
+	TypeCustomLicenseAddition:       func() AnyElement { return &CustomLicenseAddition{} },
+	TypeBuild:                       func() AnyElement { return &Build{} },
+}
+
+// multiValued lists the properties with a cardinality of many. The JSON-LD
+// compaction algorithm writes a single value without the surrounding array,
+// so these are wrapped back into arrays before decoding.
+var multiValued = map[string]bool{
+	"to":                                  true,
+	"createdBy":                           true,
+	"createdUsing":                        true,
+	"verifiedUsing":                       true,
+	"externalRef":                         true,
+	"externalIdentifier":                  true,
+	"identifierLocator":                   true,
+	"locator":                             true,
+	"element":                             true,
+	"rootElement":                         true,
+	"profileConformance":                  true,
+	"import":                              true,
+	"namespaceMap":                        true,
+	"originatedBy":                        true,
+	"standardName":                        true,
+	"supportLevel":                        true,
+	"packageVerificationCodeExcludedFile": true,
+	"software_additionalPurpose":          true,
+	"software_attributionText":            true,
+	"software_contentIdentifier":          true,
+	"software_sbomType":                   true,
+	"simplelicensing_customIdToUri":       true,
+	"expandedlicensing_seeAlso":           true,
+	"build_configSourceEntrypoint":        true,
+	"build_configSourceUri":               true,
+	"build_configSourceDigest":            true,
+	"build_parameter":                     true,
+	"build_environment":                   true,
+}
+
+// node is a single, not yet decoded, object from the @graph
+type node struct {
+	typ   string
+	raw   json.RawMessage
+	props map[string]json.RawMessage
+}
+
+// parseNode normalizes the JSON-LD keyword aliases and single-valued arrays
+// of one graph node so that it can be decoded into the model structs
+func parseNode(raw json.RawMessage) (node, error) {
+	var props map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &props); err != nil {
+		return node{}, fmt.Errorf("invalid graph node: %w", err)
+	}
+
+	if t, ok := props["@type"]; ok {
+		if _, ok := props["type"]; !ok {
+			props["type"] = t
+		}
+		delete(props, "@type")
+	}
+
+	var typ string
+	if err := json.Unmarshal(props["type"], &typ); err != nil {
+		return node{}, fmt.Errorf("graph node does not have a type: %s", limit(raw))
+	}
+
+	if id, ok := props["@id"]; ok && typ != TypeCreationInfo {
+		if _, ok := props["spdxId"]; !ok {
+			props["spdxId"] = id
+		}
+		delete(props, "@id")
+	}
+
+	normalized, err := wrapMultiValued(props)
+	if err != nil {
+		return node{}, err
+	}
+
+	return node{typ: typ, raw: normalized, props: props}, nil
+}
+
+// wrapMultiValued wraps the single values of multi-valued properties in
+// arrays and returns the re-serialized object
+func wrapMultiValued(props map[string]json.RawMessage) (json.RawMessage, error) {
+	for name, value := range props {
+		value = bytes.TrimSpace(value)
+		if multiValued[name] && len(value) > 0 && value[0] != '[' {
+			props[name] = append(append(json.RawMessage{'['}, value...), ']')
+		}
+	}
+	return json.Marshal(props)
+}
+
+// graphReader holds the state needed to resolve references while decoding a graph
+type graphReader struct {
+	creationInfos map[string]*CreationInfo
+}
+
+func (r *graphReader) decodeCreationInfo(raw json.RawMessage) (*CreationInfo, error) {
+	var props map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &props); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", TypeCreationInfo, err)
+	}
+	raw, err := wrapMultiValued(props)
+	if err != nil {
+		return nil, err
+	}
+
+	ci := &CreationInfo{}
+	if err := json.Unmarshal(raw, ci); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", TypeCreationInfo, err)
+	}
+	if ci.ID != "" {
+		if _, ok := r.creationInfos[ci.ID]; ok {
+			return nil, fmt.Errorf("duplicate %s node: %s", TypeCreationInfo, ci.ID)
+		}
+		r.creationInfos[ci.ID] = ci
+	}
+	return ci, nil
+}
+
+func (r *graphReader) decode(n node, v interface{}) error {
+	if err := json.Unmarshal(n.raw, v); err != nil {
+		return fmt.Errorf("invalid %s: %w", n.typ, err)
+	}
+	return nil
+}
+
+func (r *graphReader) decodeElement(n node) (AnyElement, error) {
+	newElement, ok := elementTypes[n.typ]
+	if !ok {
+		u := &UnknownElement{TypeName: n.typ, Raw: n.raw}
+		if err := r.decode(n, &u.Element); err != nil {
+			return nil, err
+		}
+		return u, r.resolveCreationInfo(n, &u.Element)
+	}
+
+	e := newElement()
+	if err := r.decode(n, e); err != nil {
+		return nil, err
+	}
+	if e.Base().SpdxID == "" {
+		return nil, fmt.Errorf("%s does not have an spdxId", n.typ)
+	}
+	return e, r.resolveCreationInfo(n, e.Base())
+}
+
+// resolveCreationInfo sets the CreationInfo of the element from either a
+// blank node reference or an embedded CreationInfo object
+func (r *graphReader) resolveCreationInfo(n node, e *Element) error {
+	raw, ok := n.props["creationInfo"]
+	if !ok {
+		return nil
+	}
+
+	var ref string
+	if err := json.Unmarshal(raw, &ref); err == nil {
+		ci, ok := r.creationInfos[ref]
+		if !ok {
+			return fmt.Errorf("%s %s references unknown %s %s", n.typ, e.SpdxID, TypeCreationInfo, ref)
+		}
+		e.CreationInfo = ci
+		return nil
+	}
+
+	ci, err := r.decodeCreationInfo(raw)
+	if err != nil {
+		return err
+	}
+	e.CreationInfo = ci
+	return nil
+}
+
+// graphWriter assigns blank node IDs to CreationInfo values and collects the
+// serialized graph nodes
+type graphWriter struct {
+	ids   map[*CreationInfo]string
+	used  map[string]bool
+	graph []json.RawMessage
+}
+
+// addCreationInfo writes the CreationInfo node the first time it is seen
+func (w *graphWriter) addCreationInfo(ci *CreationInfo) {
+	if ci == nil {
+		return
+	}
+	if _, ok := w.ids[ci]; ok {
+		return
+	}
+
+	id := ci.ID
+	if id == "" || w.used[id] {
+		id = "_:creationinfo"
+		for i := 1; w.used[id]; i++ {
+			id = "_:creationinfo" + strconv.Itoa(i)
+		}
+	}
+	w.ids[ci] = id
+	w.used[id] = true
+
+	c := *ci
+	c.ID = id
+	raw, _ := w.marshalNode(TypeCreationInfo, c, nil)
+	w.graph = append(w.graph, raw)
+}
+
+func (w *graphWriter) marshalElement(e AnyElement) (json.RawMessage, error) {
+	if u, ok := e.(*UnknownElement); ok {
+		return u.Raw, nil
+	}
+	return w.marshalNode(e.Type(), e, e.Base().CreationInfo)
+}
+
+// marshalNode serializes v and prefixes the resulting object with the type
+// and the creationInfo reference
+func (w *graphWriter) marshalNode(typ string, v interface{}, ci *CreationInfo) (json.RawMessage, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(`{"type":`)
+	buf.Write(quote(typ))
+	if ci != nil {
+		buf.WriteString(`,"creationInfo":`)
+		buf.Write(quote(w.ids[ci]))
+	}
+	if len(b) > 2 {
+		buf.WriteByte(',')
+	}
+	buf.Write(b[1:])
+	return buf.Bytes(), nil
+}
+
+// withType serializes one of the nested (non-element) classes with its JSON-LD type
+func withType(typ string, v interface{}) ([]byte, error) {
+	w := graphWriter{}
+	return w.marshalNode(typ, v, nil)
+}
+
+func quote(s string) []byte {
+	b, _ := json.Marshal(s)
+	return b
+}
+
+func limit(b []byte) string {
+	if len(b) > 100 {
+		return string(b[:100]) + "..."
+	}
+	return string(b)
+}
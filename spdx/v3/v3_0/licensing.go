@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package v3_0
+
+// LicenseExpression is a license expression as defined by SPDX specification Annex D,
+// e.g. "MIT OR Apache-2.0"
+type LicenseExpression struct {
+	Element
+
+	// simplelicensing_licenseExpression
+	// Cardinality: mandatory, one
+	LicenseExpression string `json:"simplelicensing_licenseExpression"`
+
+	// simplelicensing_customIdToUri: maps LicenseRef- identifiers in the expression to URIs
+	// Cardinality: optional, many
+	CustomIDToURI []DictionaryEntry `json:"simplelicensing_customIdToUri,omitempty"`
+
+	// simplelicensing_licenseListVersion
+	// Cardinality: optional, one
+	LicenseListVersion string `json:"simplelicensing_licenseListVersion,omitempty"`
+}
+
+func (l *LicenseExpression) Type() string {
+	return TypeLicenseExpression
+}
+
+// SimpleLicensingText holds the text of a license or addition that is not on the SPDX License List
+type SimpleLicensingText struct {
+	Element
+
+	// simplelicensing_licenseText
+	// Cardinality: mandatory, one
+	LicenseText string `json:"simplelicensing_licenseText"`
+}
+
+func (t *SimpleLicensingText) Type() string {
+	return TypeSimpleLicensingText
+}
+
+// License holds the properties shared by ListedLicense and CustomLicense
+type License struct {
+	Element
+
+	// expandedlicensing_licenseText
+	// Cardinality: mandatory, one
+	LicenseText string `json:"expandedlicensing_licenseText"`
+
+	// expandedlicensing_seeAlso
+	// Cardinality: optional, many
+	SeeAlso []string `json:"expandedlicensing_seeAlso,omitempty"`
+
+	// expandedlicensing_isOsiApproved
+	// Cardinality: optional, one
+	IsOsiApproved *bool `json:"expandedlicensing_isOsiApproved,omitempty"`
+
+	// expandedlicensing_isFsfLibre
+	// Cardinality: optional, one
+	IsFsfLibre *bool `json:"expandedlicensing_isFsfLibre,omitempty"`
+
+	// expandedlicensing_isDeprecatedLicenseId
+	// Cardinality: optional, one
+	IsDeprecatedLicenseID *bool `json:"expandedlicensing_isDeprecatedLicenseId,omitempty"`
+
+	// expandedlicensing_obsoletedBy
+	// Cardinality: optional, one
+	ObsoletedBy string `json:"expandedlicensing_obsoletedBy,omitempty"`
+
+	// expandedlicensing_standardLicenseHeader
+	// Cardinality: optional, one
+	StandardLicenseHeader string `json:"expandedlicensing_standardLicenseHeader,omitempty"`
+}
+
+// ListedLicense is a license on the SPDX License List
+type ListedLicense struct {
+	License
+
+	// expandedlicensing_listVersionAdded
+	// Cardinality: optional, one
+	ListVersionAdded string `json:"expandedlicensing_listVersionAdded,omitempty"`
+
+	// expandedlicensing_deprecatedVersion
+	// Cardinality: optional, one
+	DeprecatedVersion string `json:"expandedlicensing_deprecatedVersion,omitempty"`
+}
+
+func (l *ListedLicense) Type() string {
+	return TypeListedLicense
+}
+
+// CustomLicense is a license that is not on the SPDX License List
+type CustomLicense struct {
+	License
+}
+
+func (l *CustomLicense) Type() string {
+	return TypeCustomLicense
+}
+
+// LicenseAddition holds the properties shared by ListedLicenseException and CustomLicenseAddition
+type LicenseAddition struct {
+	Element
+
+	// expandedlicensing_additionText
+	// Cardinality: mandatory, one
+	AdditionText string `json:"expandedlicensing_additionText"`
+
+	// expandedlicensing_seeAlso
+	// Cardinality: optional, many
+	SeeAlso []string `json:"expandedlicensing_seeAlso,omitempty"`
+
+	// expandedlicensing_isDeprecatedAdditionId
+	// Cardinality: optional, one
+	IsDeprecatedAdditionID *bool `json:"expandedlicensing_isDeprecatedAdditionId,omitempty"`
+
+	// expandedlicensing_obsoletedBy
+	// Cardinality: optional, one
+	ObsoletedBy string `json:"expandedlicensing_obsoletedBy,omitempty"`
+}
+
+// ListedLicenseException is a license exception on the SPDX License List
+type ListedLicenseException struct {
+	LicenseAddition
+
+	// expandedlicensing_listVersionAdded
+	// Cardinality: optional, one
+	ListVersionAdded string `json:"expandedlicensing_listVersionAdded,omitempty"`
+
+	// expandedlicensing_deprecatedVersion
+	// Cardinality: optional, one
+	DeprecatedVersion string `json:"expandedlicensing_deprecatedVersion,omitempty"`
+}
+
+func (e *ListedLicenseException) Type() string {
+	return TypeListedLicenseException
+}
+
+// CustomLicenseAddition is a license addition that is not on the SPDX License List
+type CustomLicenseAddition struct {
+	LicenseAddition
+}
+
+func (a *CustomLicenseAddition) Type() string {
+	return TypeCustomLicenseAddition
+}
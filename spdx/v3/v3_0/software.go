@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package v3_0
+
+// SoftwareArtifact holds the properties shared by the Software profile artifacts
+type SoftwareArtifact struct {
+	Artifact
+
+	// software_primaryPurpose: one of the SoftwarePurpose values
+	// Cardinality: optional, one
+	PrimaryPurpose string `json:"software_primaryPurpose,omitempty"`
+
+	// software_additionalPurpose: SoftwarePurpose values
+	// Cardinality: optional, many
+	AdditionalPurposes []string `json:"software_additionalPurpose,omitempty"`
+
+	// software_copyrightText
+	// Cardinality: optional, one
+	CopyrightText string `json:"software_copyrightText,omitempty"`
+
+	// software_attributionText
+	// Cardinality: optional, many
+	AttributionTexts []string `json:"software_attributionText,omitempty"`
+
+	// software_contentIdentifier
+	// Cardinality: optional, many
+	ContentIdentifiers []ContentIdentifier `json:"software_contentIdentifier,omitempty"`
+}
+
+// Package is a distribution unit of software.
+// See https://spdx.github.io/spdx-spec/v3.0.1/model/Software/Classes/Package/
+type Package struct {
+	SoftwareArtifact
+
+	// software_packageVersion
+	// Cardinality: optional, one
+	PackageVersion string `json:"software_packageVersion,omitempty"`
+
+	// software_downloadLocation
+	// Cardinality: optional, one
+	DownloadLocation string `json:"software_downloadLocation,omitempty"`
+
+	// software_packageUrl
+	// Cardinality: optional, one
+	PackageURL string `json:"software_packageUrl,omitempty"`
+
+	// software_homePage
+	// Cardinality: optional, one
+	HomePage string `json:"software_homePage,omitempty"`
+
+	// software_sourceInfo
+	// Cardinality: optional, one
+	SourceInfo string `json:"software_sourceInfo,omitempty"`
+}
+
+func (p *Package) Type() string {
+	return TypePackage
+}
+
+// File is a named sequence of bytes or a directory.
+// See https://spdx.github.io/spdx-spec/v3.0.1/model/Software/Classes/File/
+type File struct {
+	SoftwareArtifact
+
+	// software_fileKind: one of the FileKindType values
+	// Cardinality: optional, one
+	FileKind string `json:"software_fileKind,omitempty"`
+
+	// contentType
+	// Cardinality: optional, one
+	ContentType string `json:"contentType,omitempty"`
+}
+
+func (f *File) Type() string {
+	return TypeFile
+}
+
+// Snippet describes a range of bytes or lines within a File
+type Snippet struct {
+	SoftwareArtifact
+
+	// software_byteRange
+	// Cardinality: optional, one
+	ByteRange *PositiveIntegerRange `json:"software_byteRange,omitempty"`
+
+	// software_lineRange
+	// Cardinality: optional, one
+	LineRange *PositiveIntegerRange `json:"software_lineRange,omitempty"`
+
+	// software_snippetFromFile: the SPDX ID of the File the snippet is taken from
+	// Cardinality: mandatory, one
+	SnippetFromFile string `json:"software_snippetFromFile"`
+}
+
+func (s *Snippet) Type() string {
+	return TypeSnippet
+}
+
+// Sbom is a software bill of materials
+type Sbom struct {
+	Bom
+
+	// software_sbomType: SbomType values
+	// Cardinality: optional, many
+	SbomTypes []string `json:"software_sbomType,omitempty"`
+}
+
+func (s *Sbom) Type() string {
+	return TypeSbom
+}
@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	converter "github.com/anchore/go-struct-converter"
+
+	"github.com/spdx/tools-golang/convert"
+	"github.com/spdx/tools-golang/spdx"
+	spdxcommon "github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+// Canonicalize puts the document into canonical form in place, so that
+// documents with the same content are written as the same bytes. It rewrites
+// relationships into canonical direction and merges duplicates, as
+// NormalizeRelationships does, lowercases checksum values, and sorts packages,
+// files, snippets, relationships, annotations, other licenses, external
+// document references, creators, checksums and package external references.
+// Elements are sorted by SPDX ID.
+func Canonicalize(doc *spdx.Document) {
+	NormalizeRelationships(doc)
+
+	if doc.CreationInfo != nil {
+		sort.SliceStable(doc.CreationInfo.Creators, func(i, j int) bool {
+			a, b := doc.CreationInfo.Creators[i], doc.CreationInfo.Creators[j]
+			if a.CreatorType != b.CreatorType {
+				return a.CreatorType < b.CreatorType
+			}
+			return a.Creator < b.Creator
+		})
+	}
+
+	for i := range doc.ExternalDocumentReferences {
+		canonicalChecksum(&doc.ExternalDocumentReferences[i].Checksum)
+	}
+	sort.SliceStable(doc.ExternalDocumentReferences, func(i, j int) bool {
+		return strings.TrimPrefix(doc.ExternalDocumentReferences[i].DocumentRefID, documentRefPrefix) <
+			strings.TrimPrefix(doc.ExternalDocumentReferences[j].DocumentRefID, documentRefPrefix)
+	})
+
+	files := map[*spdx.File]bool{}
+	for _, pkg := range doc.Packages {
+		if pkg == nil {
+			continue
+		}
+		canonicalChecksums(pkg.PackageChecksums)
+		if pkg.PackageVerificationCode != nil {
+			pkg.PackageVerificationCode.Value = strings.ToLower(pkg.PackageVerificationCode.Value)
+		}
+		sort.SliceStable(pkg.PackageExternalReferences, func(i, j int) bool {
+			a, b := pkg.PackageExternalReferences[i], pkg.PackageExternalReferences[j]
+			if a == nil || b == nil {
+				return b == nil && a != nil
+			}
+			return fmt.Sprintf("%s %s %s", a.Category, a.RefType, a.Locator) <
+				fmt.Sprintf("%s %s %s", b.Category, b.RefType, b.Locator)
+		})
+		sortAnnotations(pkg.Annotations)
+		for _, file := range pkg.Files {
+			canonicalFile(file, files)
+		}
+		sortFiles(pkg.Files)
+	}
+	sort.SliceStable(doc.Packages, func(i, j int) bool {
+		a, b := doc.Packages[i], doc.Packages[j]
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.PackageSPDXIdentifier < b.PackageSPDXIdentifier
+	})
+
+	for _, file := range doc.Files {
+		canonicalFile(file, files)
+	}
+	sortFiles(doc.Files)
+
+	sort.SliceStable(doc.Snippets, func(i, j int) bool {
+		return doc.Snippets[i].SnippetSPDXIdentifier < doc.Snippets[j].SnippetSPDXIdentifier
+	})
+
+	sort.SliceStable(doc.OtherLicenses, func(i, j int) bool {
+		a, b := doc.OtherLicenses[i], doc.OtherLicenses[j]
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.LicenseIdentifier < b.LicenseIdentifier
+	})
+
+	sort.SliceStable(doc.Relationships, func(i, j int) bool {
+		return relationshipKey(doc.Relationships[i]) < relationshipKey(doc.Relationships[j])
+	})
+
+	sort.SliceStable(doc.Annotations, func(i, j int) bool {
+		a, b := doc.Annotations[i], doc.Annotations[j]
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return annotationKey(a) < annotationKey(b)
+	})
+}
+
+// Canonical returns a copy of an SPDX 2 document of any version in canonical
+// form, as described by Canonicalize, leaving doc unchanged. The copy has the
+// same version as doc.
+func Canonical(doc spdxcommon.AnyDocument) (spdxcommon.AnyDocument, error) {
+	doc = convert.FromPtr(doc)
+	out := &spdx.Document{}
+	switch doc.(type) {
+	case v2_3.Document:
+		// copy, so that the document passed in stays as it is
+		if err := converter.Convert(doc, out); err != nil {
+			return nil, fmt.Errorf("unable to copy document: %w", err)
+		}
+	case v2_1.Document, v2_2.Document:
+		if err := convert.Document(doc, out); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported document type: %s", convert.Describe(doc))
+	}
+	Canonicalize(out)
+
+	switch doc := doc.(type) {
+	case v2_1.Document:
+		older := &v2_1.Document{}
+		if err := convert.Document(out, older); err != nil {
+			return nil, err
+		}
+		older.SPDXVersion = doc.SPDXVersion
+		return older, nil
+	case v2_2.Document:
+		older := &v2_2.Document{}
+		if err := convert.Document(out, older); err != nil {
+			return nil, err
+		}
+		older.SPDXVersion = doc.SPDXVersion
+		return older, nil
+	}
+	return out, nil
+}
+
+func canonicalFile(file *spdx.File, done map[*spdx.File]bool) {
+	if file == nil || done[file] {
+		return
+	}
+	done[file] = true
+	canonicalChecksums(file.Checksums)
+	sortAnnotations(file.Annotations)
+}
+
+func canonicalChecksum(checksum *common.Checksum) {
+	checksum.Value = strings.ToLower(checksum.Value)
+}
+
+// canonicalChecksums lowercases the checksum values and sorts the checksums by algorithm
+func canonicalChecksums(checksums []common.Checksum) {
+	for i := range checksums {
+		canonicalChecksum(&checksums[i])
+	}
+	sort.SliceStable(checksums, func(i, j int) bool {
+		if checksums[i].Algorithm != checksums[j].Algorithm {
+			return checksums[i].Algorithm < checksums[j].Algorithm
+		}
+		return checksums[i].Value < checksums[j].Value
+	})
+}
+
+func sortFiles(files []*spdx.File) {
+	sort.SliceStable(files, func(i, j int) bool {
+		a, b := files[i], files[j]
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return a.FileSPDXIdentifier < b.FileSPDXIdentifier
+	})
+}
+
+func sortAnnotations(annotations []spdx.Annotation) {
+	sort.SliceStable(annotations, func(i, j int) bool {
+		return annotationKey(&annotations[i]) < annotationKey(&annotations[j])
+	})
+}
+
+// annotationKey orders annotations by subject, date, annotator, type and comment
+func annotationKey(a *spdx.Annotation) string {
+	return strings.Join([]string{
+		common.RenderDocElementID(a.AnnotationSPDXIdentifier),
+		a.AnnotationDate,
+		a.Annotator.AnnotatorType,
+		a.Annotator.Annotator,
+		a.AnnotationType,
+		a.AnnotationComment,
+	}, "\x00")
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	v2_2_example "github.com/spdx/tools-golang/spdx/v2/v2_2/example"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+)
+
+// shuffled returns the example document with its lists in reverse order, an
+// uppercase checksum and a relationship written in inverse direction
+func shuffled() spdx.Document {
+	doc := example.Copy()
+	reverse := func(n int, swap func(i, j int)) {
+		for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+			swap(i, j)
+		}
+	}
+	reverse(len(doc.Packages), func(i, j int) { doc.Packages[i], doc.Packages[j] = doc.Packages[j], doc.Packages[i] })
+	reverse(len(doc.Files), func(i, j int) { doc.Files[i], doc.Files[j] = doc.Files[j], doc.Files[i] })
+	reverse(len(doc.Relationships), func(i, j int) {
+		doc.Relationships[i], doc.Relationships[j] = doc.Relationships[j], doc.Relationships[i]
+	})
+	reverse(len(doc.Annotations), func(i, j int) { doc.Annotations[i], doc.Annotations[j] = doc.Annotations[j], doc.Annotations[i] })
+	reverse(len(doc.OtherLicenses), func(i, j int) {
+		doc.OtherLicenses[i], doc.OtherLicenses[j] = doc.OtherLicenses[j], doc.OtherLicenses[i]
+	})
+	for _, pkg := range doc.Packages {
+		reverse(len(pkg.Files), func(i, j int) { pkg.Files[i], pkg.Files[j] = pkg.Files[j], pkg.Files[i] })
+		reverse(len(pkg.PackageChecksums), func(i, j int) {
+			pkg.PackageChecksums[i], pkg.PackageChecksums[j] = pkg.PackageChecksums[j], pkg.PackageChecksums[i]
+		})
+		for i := range pkg.PackageChecksums {
+			pkg.PackageChecksums[i].Value = strings.ToUpper(pkg.PackageChecksums[i].Value)
+		}
+	}
+	for _, r := range doc.Relationships {
+		if r.Relationship == common.TypeRelationshipContains && r.RefA.ElementRefID == "JenaLib" {
+			r.RefA, r.RefB, r.Relationship = r.RefB, r.RefA, common.TypeRelationshipContainedBy
+		}
+	}
+	return doc
+}
+
+func Test_Canonicalize(t *testing.T) {
+	want := example.Copy()
+	Canonicalize(&want)
+	got := shuffled()
+	Canonicalize(&got)
+	require.Equal(t, want, got)
+
+	var ids []common.ElementID
+	for _, pkg := range got.Packages {
+		ids = append(ids, pkg.PackageSPDXIdentifier)
+		for _, c := range pkg.PackageChecksums {
+			require.Equal(t, strings.ToLower(c.Value), c.Value)
+		}
+	}
+	require.Equal(t, []common.ElementID{"CentOS-7", "Package", "Saxon", "fromDoap-0", "fromDoap-1"}, ids)
+
+	for i := 1; i < len(got.Relationships); i++ {
+		require.Less(t, relationshipKey(got.Relationships[i-1]), relationshipKey(got.Relationships[i]))
+	}
+	for _, r := range got.Relationships {
+		require.NotEqual(t, common.TypeRelationshipContainedBy, r.Relationship)
+	}
+}
+
+func Test_Canonical(t *testing.T) {
+	doc := shuffled()
+	canonical, err := Canonical(&doc)
+	require.NoError(t, err)
+	require.Equal(t, shuffled(), doc, "document passed in changed")
+
+	want := example.Copy()
+	Canonicalize(&want)
+	require.Equal(t, &want, canonical)
+
+	older := v2_2_example.Copy()
+	canonical, err = Canonical(older)
+	require.NoError(t, err)
+	require.IsType(t, &v2_2.Document{}, canonical)
+	require.Equal(t, v2_2.Version, canonical.(*v2_2.Document).SPDXVersion)
+	require.Equal(t, v2_2_example.Copy(), older, "document passed in changed")
+
+	// canonical form is a fixed point
+	again, err := Canonical(canonical)
+	require.NoError(t, err)
+	require.Equal(t, canonical, again)
+
+	_, err = Canonical(struct{}{})
+	require.Error(t, err)
+}
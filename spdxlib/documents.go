@@ -10,7 +10,7 @@ import (
 
 // ValidateDocument returns an error if the Document is found to be invalid, or nil if the Document is valid.
 // Currently, this only verifies that all Element IDs mentioned in Relationships exist in the Document as either a
-// Package or an UnpackagedFile.
+// Package or an UnpackagedFile. Use Validate to check the whole Document against the specification.
 func ValidateDocument(doc *spdx.Document) error {
 	// cache a map of package IDs for quick lookups
 	validElementIDs := make(map[common.ElementID]bool)
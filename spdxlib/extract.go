@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	converter "github.com/anchore/go-struct-converter"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// ExtractOptions describes the document created by Extract
+type ExtractOptions struct {
+	// DocumentName and DocumentNamespace are mandatory
+	DocumentName      string
+	DocumentNamespace string
+
+	// Types are the relationship types followed from the package, in the
+	// direction they are written, and their inverses in the opposite
+	// direction. DEPENDS_ON also follows DEPENDENCY_OF and its variants, such
+	// as RUNTIME_DEPENDENCY_OF. Types defaults to CONTAINS and DEPENDS_ON.
+	Types []string
+
+	// ParentRefID is the ID of the ExternalDocumentRef to the parent
+	// document, without the "DocumentRef-" prefix; it defaults to "parent"
+	ParentRefID string
+
+	// ParentChecksum is the SHA1 checksum of the parent document
+	ParentChecksum common.Checksum
+
+	// Creators defaults to the creators of the parent document
+	Creators []common.Creator
+
+	// Created defaults to the current time
+	Created string
+}
+
+// Extract returns a standalone document holding the package with the given
+// ID and the elements reachable from it, leaving doc unchanged. Elements are
+// reachable through relationships of the chosen types, a package through its
+// Files, a file through its snippets, and a snippet through its file. The new
+// document DESCRIBES the package, keeps the relationships and annotations of
+// the elements it holds, and the OtherLicenses and ExternalDocumentReferences
+// they refer to. References to elements left behind become references to the
+// parent document, for which an ExternalDocumentRef is added.
+func Extract(doc *spdx.Document, id common.ElementID, options ExtractOptions) (*spdx.Document, error) {
+	if options.DocumentName == "" || options.DocumentNamespace == "" {
+		return nil, fmt.Errorf("extracted document needs a name and a namespace")
+	}
+
+	parent := &spdx.Document{}
+	if err := converter.Convert(doc, parent); err != nil {
+		return nil, fmt.Errorf("unable to copy document: %w", err)
+	}
+	idx := NewIndex(parent)
+	if e, ok := idx.Element(id); !ok || e.Kind != KindPackage {
+		return nil, fmt.Errorf("package %s not found", common.RenderElementID(id))
+	}
+
+	included := map[common.ElementID]bool{}
+	for _, reached := range extractionGraph(parent, idx, options.Types).Reachable(id, Traversal{}) {
+		included[reached] = true
+	}
+	included[id] = true
+
+	sub := &spdx.Document{
+		SPDXVersion:       spdx.Version,
+		DataLicense:       spdx.DataLicense,
+		SPDXIdentifier:    defaultDocumentID,
+		DocumentName:      options.DocumentName,
+		DocumentNamespace: options.DocumentNamespace,
+		CreationInfo:      &spdx.CreationInfo{Creators: options.Creators, Created: options.Created},
+	}
+	if parent.CreationInfo != nil {
+		sub.CreationInfo.LicenseListVersion = parent.CreationInfo.LicenseListVersion
+		if len(sub.CreationInfo.Creators) == 0 {
+			sub.CreationInfo.Creators = parent.CreationInfo.Creators
+		}
+	}
+	if sub.CreationInfo.Created == "" {
+		sub.CreationInfo.Created = time.Now().UTC().Format(dateFormat)
+	}
+
+	files := map[common.ElementID]bool{}
+	for _, pkg := range parent.Packages {
+		if pkg != nil && included[pkg.PackageSPDXIdentifier] {
+			sub.Packages = append(sub.Packages, pkg)
+			for _, file := range pkg.Files {
+				if file != nil {
+					files[file.FileSPDXIdentifier] = true
+				}
+			}
+		}
+	}
+	for _, e := range idx.Files() {
+		if included[e.ID] && !files[e.ID] {
+			sub.Files = append(sub.Files, e.File)
+			files[e.ID] = true
+		}
+	}
+	for _, snippet := range parent.Snippets {
+		if included[snippet.SnippetSPDXIdentifier] {
+			sub.Snippets = append(sub.Snippets, snippet)
+		}
+	}
+
+	sub.Relationships = append(sub.Relationships, &spdx.Relationship{
+		RefA:         common.MakeDocElementID("", string(defaultDocumentID)),
+		RefB:         common.MakeDocElementID("", string(id)),
+		Relationship: common.TypeRelationshipDescribe,
+	})
+	for _, r := range parent.Relationships {
+		if r == nil {
+			continue
+		}
+		if a, ok := localID(r.RefA); ok && included[a] {
+			sub.Relationships = append(sub.Relationships, r)
+		}
+	}
+	for _, a := range parent.Annotations {
+		if a == nil {
+			continue
+		}
+		if subject, ok := localID(a.AnnotationSPDXIdentifier); ok && included[subject] {
+			sub.Annotations = append(sub.Annotations, a)
+		}
+	}
+
+	// point references to elements left behind at the parent document
+	parentRef := options.ParentRefID
+	if parentRef == "" {
+		parentRef = "parent"
+	}
+	parentRef = uniqueDocumentRef(parent, parentRef)
+	parentUsed := false
+	rw := &rewriter{
+		element: func(ref common.DocElementID) common.DocElementID {
+			if local, ok := localID(ref); ok && !included[local] && local != defaultDocumentID {
+				parentUsed = true
+				ref.DocumentRefID = parentRef
+			}
+			return ref
+		},
+	}
+	// only the relationships and annotations refer to other elements; the
+	// elements themselves keep their IDs
+	rw.rewrite(&spdx.Document{Relationships: sub.Relationships[1:], Annotations: sub.Annotations})
+	removeDuplicateRelationships(sub)
+
+	usedLicenses, usedDocRefs := references(sub)
+	for _, l := range parent.OtherLicenses {
+		if l != nil && usedLicenses[l.LicenseIdentifier] {
+			sub.OtherLicenses = append(sub.OtherLicenses, l)
+		}
+	}
+	for _, ref := range parent.ExternalDocumentReferences {
+		if usedDocRefs[strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)] {
+			sub.ExternalDocumentReferences = append(sub.ExternalDocumentReferences, ref)
+		}
+	}
+	if parentUsed {
+		if parent.DocumentNamespace == "" {
+			return nil, fmt.Errorf("parent document has no namespace to refer to elements left behind")
+		}
+		sub.ExternalDocumentReferences = append(sub.ExternalDocumentReferences, spdx.ExternalDocumentRef{
+			DocumentRefID: documentRefPrefix + parentRef,
+			URI:           parent.DocumentNamespace,
+			Checksum:      options.ParentChecksum,
+		})
+	}
+
+	return sub, nil
+}
+
+// extractionGraph returns the graph of the relationships Extract follows,
+// together with the edges from packages to their files, from files to their
+// snippets and from snippets to their files
+func extractionGraph(doc *spdx.Document, idx *Index, types []string) *Graph {
+	if len(types) == 0 {
+		types = []string{common.TypeRelationshipContains, common.TypeRelationshipDependsOn}
+	}
+	forward, backward := map[string]bool{}, map[string]bool{}
+	for _, t := range types {
+		forward[t] = true
+		if inverse, ok := InverseRelationship(t); ok {
+			backward[inverse] = true
+		}
+		if t == common.TypeRelationshipDependsOn {
+			for dependencyOf := range dependencyOfTypes {
+				backward[dependencyOf] = true
+			}
+		}
+	}
+
+	g := newGraph()
+	for _, id := range idx.IDs() {
+		g.addNode(id)
+	}
+	for _, r := range doc.Relationships {
+		if r == nil {
+			continue
+		}
+		a, okA := localID(r.RefA)
+		b, okB := localID(r.RefB)
+		if !okA || !okB {
+			continue
+		}
+		if forward[r.Relationship] {
+			g.addEdge(&Edge{From: a, To: b, Type: r.Relationship, Relationship: r})
+		}
+		if backward[r.Relationship] {
+			g.addEdge(&Edge{From: b, To: a, Type: r.Relationship, Relationship: r})
+		}
+	}
+
+	for _, pkg := range doc.Packages {
+		if pkg == nil {
+			continue
+		}
+		for _, file := range pkg.Files {
+			if file != nil {
+				g.addEdge(&Edge{From: pkg.PackageSPDXIdentifier, To: file.FileSPDXIdentifier, Type: common.TypeRelationshipContains})
+			}
+		}
+	}
+	for _, e := range idx.Files() {
+		for _, snippet := range idx.FileSnippets(e.ID) {
+			g.addEdge(&Edge{From: e.ID, To: snippet.SnippetSPDXIdentifier, Type: common.TypeRelationshipContains})
+			g.addEdge(&Edge{From: snippet.SnippetSPDXIdentifier, To: e.ID, Type: common.TypeRelationshipContainedBy})
+		}
+	}
+	return g
+}
+
+// uniqueDocumentRef returns id, or id with a "-<n>" suffix if the document
+// already has an ExternalDocumentRef with that ID
+func uniqueDocumentRef(doc *spdx.Document, id string) string {
+	used := map[string]bool{}
+	for _, ref := range doc.ExternalDocumentReferences {
+		used[strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)] = true
+	}
+	candidate := id
+	for n := 2; used[candidate]; n++ {
+		candidate = fmt.Sprintf("%s-%d", id, n)
+	}
+	return candidate
+}
+
+// references returns the LicenseRef- identifiers and the DocumentRef IDs,
+// without prefix, that the document refers to
+func references(doc *spdx.Document) (map[string]bool, map[string]bool) {
+	licenses, docRefs := map[string]bool{}, map[string]bool{}
+	rw := &rewriter{
+		element: func(ref common.DocElementID) common.DocElementID {
+			if ref.DocumentRefID != "" {
+				docRefs[strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)] = true
+			}
+			return ref
+		},
+		licenseRef: func(ref string) string {
+			if colon := strings.Index(ref, ":"); colon >= 0 {
+				docRefs[strings.TrimPrefix(ref[:colon], documentRefPrefix)] = true
+			} else {
+				licenses[ref] = true
+			}
+			return ref
+		},
+	}
+	// OtherLicenses define licenses rather than refer to them
+	otherLicenses := doc.OtherLicenses
+	doc.OtherLicenses = nil
+	rw.rewrite(doc)
+	doc.OtherLicenses = otherLicenses
+	return licenses, docRefs
+}
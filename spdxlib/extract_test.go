@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+)
+
+var parentChecksum = common.Checksum{Algorithm: common.SHA1, Value: "d6a770ba38583ed4bb4525bd96e50461655d2759"}
+
+func Test_ExtractExample(t *testing.T) {
+	doc := example.Copy()
+	sub, err := Extract(&doc, "Package", ExtractOptions{
+		DocumentName:      "glibc",
+		DocumentNamespace: "https://example.com/glibc",
+		ParentChecksum:    parentChecksum,
+		Created:           "2024-03-01T10:00:00Z",
+	})
+	require.NoError(t, err)
+
+	require.Len(t, sub.Packages, 1)
+	require.Equal(t, "glibc", sub.Packages[0].PackageName)
+	require.Len(t, sub.Files, 1)
+	require.Equal(t, common.ElementID("JenaLib"), sub.Files[0].FileSPDXIdentifier)
+	require.Empty(t, sub.Snippets)
+	require.Equal(t, doc.CreationInfo.Creators, sub.CreationInfo.Creators)
+
+	// Saxon is linked, not contained, so it stays in the parent
+	require.Equal(t, []*spdx.Relationship{
+		rel("DOCUMENT", common.TypeRelationshipDescribe, "Package"),
+		rel("Package", common.TypeRelationshipContains, "JenaLib"),
+		{RefA: common.MakeDocElementID("", "Package"), RefB: common.MakeDocElementID("parent", "Saxon"), Relationship: common.TypeRelationshipDynamicLink},
+		rel("JenaLib", common.TypeRelationshipContains, "Package"),
+	}, sub.Relationships)
+	require.Equal(t, []spdx.ExternalDocumentRef{
+		{DocumentRefID: "DocumentRef-parent", URI: doc.DocumentNamespace, Checksum: parentChecksum},
+	}, sub.ExternalDocumentReferences)
+
+	var licenses []string
+	for _, l := range sub.OtherLicenses {
+		licenses = append(licenses, l.LicenseIdentifier)
+	}
+	require.Equal(t, []string{"LicenseRef-1", "LicenseRef-2", "LicenseRef-3"}, licenses)
+
+	require.False(t, Validate(sub).HasErrors(), Validate(sub).Err())
+
+	// the parent is left unchanged
+	require.Equal(t, example.Copy(), doc)
+}
+
+func Test_ExtractTypes(t *testing.T) {
+	doc := &spdx.Document{
+		DocumentNamespace: "https://example.com/parent",
+		ExternalDocumentReferences: []spdx.ExternalDocumentRef{
+			{DocumentRefID: "DocumentRef-parent", URI: "https://example.com/other", Checksum: parentChecksum},
+			{DocumentRefID: "DocumentRef-unused", URI: "https://example.com/unused", Checksum: parentChecksum},
+		},
+		Packages: []*spdx.Package{
+			{PackageSPDXIdentifier: "app", PackageLicenseDeclared: "DocumentRef-parent:LicenseRef-1"},
+			{PackageSPDXIdentifier: "lib"},
+			{PackageSPDXIdentifier: "zlib"},
+			{PackageSPDXIdentifier: "other"},
+		},
+		Files: []*spdx.File{{FileSPDXIdentifier: "source", FileName: "./source.c"}},
+		Snippets: []spdx.Snippet{
+			{SnippetSPDXIdentifier: "snippet", SnippetFromFileSPDXIdentifier: "source"},
+		},
+		Relationships: []*spdx.Relationship{
+			rel("lib", common.TypeRelationshipRuntimeDependencyOf, "app"),
+			rel("lib", common.TypeRelationshipGeneratedFrom, "snippet"),
+			rel("zlib", common.TypeRelationshipDependencyOf, "lib"),
+			rel("other", common.TypeRelationshipDependsOn, "app"),
+		},
+		Annotations: []*spdx.Annotation{
+			{AnnotationSPDXIdentifier: common.MakeDocElementID("", "lib"), AnnotationComment: "kept"},
+			{AnnotationSPDXIdentifier: common.MakeDocElementID("", "other"), AnnotationComment: "left behind"},
+		},
+	}
+	options := ExtractOptions{DocumentName: "app", DocumentNamespace: "https://example.com/app"}
+
+	// dependencies are followed whichever way they are written
+	sub, err := Extract(doc, "app", options)
+	require.NoError(t, err)
+	require.Len(t, sub.Packages, 3)
+	require.Empty(t, sub.Files)
+	require.Len(t, sub.Relationships, 4)
+	require.Equal(t, common.MakeDocElementID("parent-2", "snippet"), sub.Relationships[2].RefB)
+	require.Len(t, sub.Annotations, 1)
+	require.Equal(t, "kept", sub.Annotations[0].AnnotationComment)
+	require.Equal(t, []spdx.ExternalDocumentRef{
+		doc.ExternalDocumentReferences[0],
+		{DocumentRefID: "DocumentRef-parent-2", URI: "https://example.com/parent"},
+	}, sub.ExternalDocumentReferences)
+
+	// a snippet brings its file
+	options.Types = []string{common.TypeRelationshipGeneratedFrom}
+	sub, err = Extract(doc, "lib", options)
+	require.NoError(t, err)
+	require.Len(t, sub.Packages, 1)
+	require.Len(t, sub.Files, 1)
+	require.Len(t, sub.Snippets, 1)
+	require.Equal(t, common.MakeDocElementID("parent-2", "app"), sub.Relationships[1].RefB)
+	require.Equal(t, common.MakeDocElementID("", "snippet"), sub.Relationships[2].RefB)
+}
+
+func Test_ExtractErrors(t *testing.T) {
+	doc := example.Copy()
+	options := ExtractOptions{DocumentName: "file", DocumentNamespace: "https://example.com/file"}
+
+	_, err := Extract(&doc, "JenaLib", options)
+	require.Error(t, err)
+	_, err = Extract(&doc, "missing", options)
+	require.Error(t, err)
+	_, err = Extract(&doc, "Package", ExtractOptions{})
+	require.Error(t, err)
+
+	doc.DocumentNamespace = ""
+	_, err = Extract(&doc, "Package", options)
+	require.Error(t, err)
+}
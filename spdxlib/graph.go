@@ -0,0 +1,459 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// dependencyOfTypes are the relationship types that state that RefA is a dependency of RefB
+var dependencyOfTypes = map[string]bool{
+	common.TypeRelationshipDependencyOf:         true,
+	common.TypeRelationshipBuildDependencyOf:    true,
+	common.TypeRelationshipDevDependencyOf:      true,
+	common.TypeRelationshipOptionalDependencyOf: true,
+	common.TypeRelationshipProvidedDependencyOf: true,
+	common.TypeRelationshipTestDependencyOf:     true,
+	common.TypeRelationshipRuntimeDependencyOf:  true,
+}
+
+// Edge is a relationship between two elements of the same document
+type Edge struct {
+	From common.ElementID
+	To   common.ElementID
+
+	// Type is the relationship type as written in the document
+	Type string
+
+	// Relationship is the relationship the edge was built from
+	Relationship *spdx.Relationship
+}
+
+// String renders the relationship the edge was built from, e.g.
+// "SPDXRef-lib RUNTIME_DEPENDENCY_OF SPDXRef-app"
+func (e Edge) String() string {
+	if e.Relationship != nil {
+		return fmt.Sprintf("%s %s %s", common.RenderDocElementID(e.Relationship.RefA), e.Type, common.RenderDocElementID(e.Relationship.RefB))
+	}
+	return fmt.Sprintf("%s %s %s", common.RenderElementID(e.From), e.Type, common.RenderElementID(e.To))
+}
+
+// Path is a sequence of edges that leads from one element to another
+type Path []Edge
+
+// String renders the path as one relationship per line
+func (p Path) String() string {
+	var b strings.Builder
+	for _, e := range p {
+		fmt.Fprintf(&b, "%s\n", e)
+	}
+	return b.String()
+}
+
+// Direction selects the edges a traversal follows
+type Direction int
+
+const (
+	// Forward follows edges from From to To
+	Forward Direction = iota
+	// Backward follows edges from To to From
+	Backward
+	// Both follows edges in either direction
+	Both
+)
+
+// Order is the order in which a traversal visits elements
+type Order int
+
+const (
+	BreadthFirst Order = iota
+	DepthFirst
+)
+
+// Traversal configures a walk over a Graph. The zero value walks forward,
+// breadth first, over edges of every type, without a depth limit.
+type Traversal struct {
+	Order     Order
+	Direction Direction
+
+	// Types limits the walk to edges of these relationship types; empty means all types
+	Types []string
+
+	// MaxDepth limits the number of edges between the start and a visited element; 0 means no limit
+	MaxDepth int
+}
+
+// Step is an element reached by a walk
+type Step struct {
+	ID    common.ElementID
+	Depth int
+
+	// Via is the edge the element was reached through, or nil for the start element
+	Via *Edge
+}
+
+// Graph is a view of the relationships of a document as typed edges between
+// element IDs. Relationships with an external document, NONE or NOASSERTION
+// on either side are not part of the graph. Like Index, it is a snapshot of
+// the document.
+type Graph struct {
+	nodes    []common.ElementID
+	known    map[common.ElementID]bool
+	outgoing map[common.ElementID][]*Edge
+	incoming map[common.ElementID][]*Edge
+}
+
+// NewGraph builds the graph of the relationships of the document. Every
+// element of the document is a node, even when it has no relationships.
+func NewGraph(doc *spdx.Document) *Graph {
+	g := newGraph()
+	for _, id := range NewIndex(doc).IDs() {
+		g.addNode(id)
+	}
+
+	for _, r := range doc.Relationships {
+		if r == nil {
+			continue
+		}
+		from, ok := localID(r.RefA)
+		if !ok {
+			continue
+		}
+		to, ok := localID(r.RefB)
+		if !ok {
+			continue
+		}
+		g.addEdge(&Edge{From: from, To: to, Type: r.Relationship, Relationship: r})
+	}
+	return g
+}
+
+func newGraph() *Graph {
+	return &Graph{
+		known:    map[common.ElementID]bool{},
+		outgoing: map[common.ElementID][]*Edge{},
+		incoming: map[common.ElementID][]*Edge{},
+	}
+}
+
+func (g *Graph) addNode(id common.ElementID) {
+	if !g.known[id] {
+		g.known[id] = true
+		g.nodes = append(g.nodes, id)
+	}
+}
+
+func (g *Graph) addEdge(e *Edge) {
+	g.addNode(e.From)
+	g.addNode(e.To)
+	g.outgoing[e.From] = append(g.outgoing[e.From], e)
+	g.incoming[e.To] = append(g.incoming[e.To], e)
+}
+
+// Nodes returns the IDs of the elements of the graph: the elements of the
+// document, then any undefined ID that a relationship refers to
+func (g *Graph) Nodes() []common.ElementID {
+	return g.nodes
+}
+
+// Has returns true if the element is a node of the graph
+func (g *Graph) Has(id common.ElementID) bool {
+	return g.known[id]
+}
+
+// Outgoing returns the edges from the element, in document order
+func (g *Graph) Outgoing(id common.ElementID) []*Edge {
+	return g.outgoing[id]
+}
+
+// Incoming returns the edges to the element, in document order
+func (g *Graph) Incoming(id common.ElementID) []*Edge {
+	return g.incoming[id]
+}
+
+// Filter returns a graph with the same nodes and only the edges of the given types
+func (g *Graph) Filter(types ...string) *Graph {
+	allowed := typeSet(types)
+	filtered := newGraph()
+	for _, id := range g.nodes {
+		filtered.addNode(id)
+	}
+	for _, id := range g.nodes {
+		for _, e := range g.outgoing[id] {
+			if allowed(e.Type) {
+				filtered.addEdge(e)
+			}
+		}
+	}
+	return filtered
+}
+
+// Dependencies returns the dependency graph: an edge from every element to
+// each of its dependencies. DEPENDS_ON relationships keep their direction and
+// DEPENDENCY_OF and its variants, such as BUILD_DEPENDENCY_OF, are reversed,
+// so that "A DEPENDS_ON B" and "B DEPENDENCY_OF A" give the same edge. The
+// edges keep the relationship type as written.
+func (g *Graph) Dependencies() *Graph {
+	deps := newGraph()
+	for _, id := range g.nodes {
+		deps.addNode(id)
+	}
+	for _, id := range g.nodes {
+		for _, e := range g.outgoing[id] {
+			switch {
+			case e.Type == common.TypeRelationshipDependsOn:
+				deps.addEdge(e)
+			case dependencyOfTypes[e.Type]:
+				deps.addEdge(&Edge{From: e.To, To: e.From, Type: e.Type, Relationship: e.Relationship})
+			}
+		}
+	}
+	return deps
+}
+
+// next returns the edges a traversal follows from the element, and the
+// element at the other end of each edge
+func (g *Graph) next(id common.ElementID, t Traversal, allowed func(string) bool) ([]*Edge, []common.ElementID) {
+	var edges []*Edge
+	var ids []common.ElementID
+	if t.Direction == Forward || t.Direction == Both {
+		for _, e := range g.outgoing[id] {
+			if allowed(e.Type) {
+				edges = append(edges, e)
+				ids = append(ids, e.To)
+			}
+		}
+	}
+	if t.Direction == Backward || t.Direction == Both {
+		for _, e := range g.incoming[id] {
+			if allowed(e.Type) {
+				edges = append(edges, e)
+				ids = append(ids, e.From)
+			}
+		}
+	}
+	return edges, ids
+}
+
+// Walk visits every element reachable from start once, starting with start
+// itself, until visit returns false. Edges are followed in document order.
+func (g *Graph) Walk(start common.ElementID, t Traversal, visit func(Step) bool) {
+	if !g.known[start] {
+		return
+	}
+	allowed := typeSet(t.Types)
+	visited := map[common.ElementID]bool{start: true}
+
+	if t.Order == DepthFirst {
+		var walk func(step Step) bool
+		walk = func(step Step) bool {
+			if !visit(step) {
+				return false
+			}
+			if t.MaxDepth > 0 && step.Depth >= t.MaxDepth {
+				return true
+			}
+			edges, ids := g.next(step.ID, t, allowed)
+			for i, id := range ids {
+				if visited[id] {
+					continue
+				}
+				visited[id] = true
+				if !walk(Step{ID: id, Depth: step.Depth + 1, Via: edges[i]}) {
+					return false
+				}
+			}
+			return true
+		}
+		walk(Step{ID: start})
+		return
+	}
+
+	queue := []Step{{ID: start}}
+	for len(queue) > 0 {
+		step := queue[0]
+		queue = queue[1:]
+		if !visit(step) {
+			return
+		}
+		if t.MaxDepth > 0 && step.Depth >= t.MaxDepth {
+			continue
+		}
+		edges, ids := g.next(step.ID, t, allowed)
+		for i, id := range ids {
+			if visited[id] {
+				continue
+			}
+			visited[id] = true
+			queue = append(queue, Step{ID: id, Depth: step.Depth + 1, Via: edges[i]})
+		}
+	}
+}
+
+// Reachable returns the elements reachable from start, excluding start, in
+// the order the traversal visits them
+func (g *Graph) Reachable(start common.ElementID, t Traversal) []common.ElementID {
+	var ids []common.ElementID
+	g.Walk(start, t, func(step Step) bool {
+		if step.Via != nil {
+			ids = append(ids, step.ID)
+		}
+		return true
+	})
+	return ids
+}
+
+// TransitiveDependencies returns the direct and indirect dependencies of the
+// element, nearest first, as defined by Dependencies
+func (g *Graph) TransitiveDependencies(id common.ElementID) []common.ElementID {
+	return g.Dependencies().Reachable(id, Traversal{})
+}
+
+// TransitiveDependents returns the elements that depend on the element,
+// directly or indirectly, nearest first
+func (g *Graph) TransitiveDependents(id common.ElementID) []common.ElementID {
+	return g.Dependencies().Reachable(id, Traversal{Direction: Backward})
+}
+
+// ShortestPath returns a path with the fewest edges from one element to
+// another, following the edges the traversal allows; Order and MaxDepth are
+// ignored. With the Backward or Both direction, edges of the path may point
+// towards from. ShortestPath returns false if to is not reachable.
+func (g *Graph) ShortestPath(from common.ElementID, to common.ElementID, t Traversal) (Path, bool) {
+	t.Order = BreadthFirst
+	t.MaxDepth = 0
+
+	via := map[common.ElementID]*Edge{}
+	found := false
+	g.Walk(from, t, func(step Step) bool {
+		if step.Via != nil {
+			via[step.ID] = step.Via
+		}
+		found = step.ID == to
+		return !found
+	})
+	if !found {
+		return nil, false
+	}
+
+	var path Path
+	for id := to; id != from; {
+		e := via[id]
+		path = append(Path{*e}, path...)
+		if e.To == id {
+			id = e.From
+		} else {
+			id = e.To
+		}
+	}
+	return path, true
+}
+
+// WhyIncluded explains why an element is part of a product: it returns the
+// shortest chain of dependencies from the product to the element, e.g.
+// "app DEPENDS_ON lib" then "zlib RUNTIME_DEPENDENCY_OF lib".
+func (g *Graph) WhyIncluded(product common.ElementID, id common.ElementID) (Path, bool) {
+	return g.Dependencies().ShortestPath(product, id, Traversal{})
+}
+
+// Cycles returns the cycles of the graph, considering only edges of the given
+// types, or every edge if no type is given. Each cycle is a set of elements
+// that can all reach each other, returned in graph order; an element with an
+// edge to itself is a cycle on its own.
+func (g *Graph) Cycles(types ...string) [][]common.ElementID {
+	allowed := typeSet(types)
+
+	// Tarjan's strongly connected components
+	index := map[common.ElementID]int{}
+	lowlink := map[common.ElementID]int{}
+	onStack := map[common.ElementID]bool{}
+	var stack []common.ElementID
+	var components [][]common.ElementID
+
+	var connect func(id common.ElementID)
+	connect = func(id common.ElementID) {
+		index[id] = len(index)
+		lowlink[id] = index[id]
+		stack = append(stack, id)
+		onStack[id] = true
+
+		selfLoop := false
+		for _, e := range g.outgoing[id] {
+			if !allowed(e.Type) {
+				continue
+			}
+			if e.To == id {
+				selfLoop = true
+			}
+			if _, seen := index[e.To]; !seen {
+				connect(e.To)
+				lowlink[id] = minInt(lowlink[id], lowlink[e.To])
+			} else if onStack[e.To] {
+				lowlink[id] = minInt(lowlink[id], index[e.To])
+			}
+		}
+
+		if lowlink[id] != index[id] {
+			return
+		}
+		var component []common.ElementID
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == id {
+				break
+			}
+		}
+		if len(component) > 1 || selfLoop {
+			components = append(components, component)
+		}
+	}
+
+	for _, id := range g.nodes {
+		if _, seen := index[id]; !seen {
+			connect(id)
+		}
+	}
+
+	// report each cycle, and the cycles, in graph order
+	order := map[common.ElementID]int{}
+	for i, id := range g.nodes {
+		order[id] = i
+	}
+	for _, component := range components {
+		sort.Slice(component, func(i, j int) bool { return order[component[i]] < order[component[j]] })
+	}
+	sort.Slice(components, func(i, j int) bool { return order[components[i][0]] < order[components[j][0]] })
+	return components
+}
+
+// HasCycle returns true if the graph has a cycle over edges of the given types
+func (g *Graph) HasCycle(types ...string) bool {
+	return len(g.Cycles(types...)) > 0
+}
+
+// typeSet returns a predicate for relationship types; no type allows every type
+func typeSet(types []string) func(string) bool {
+	if len(types) == 0 {
+		return func(string) bool { return true }
+	}
+	set := map[string]bool{}
+	for _, t := range types {
+		set[t] = true
+	}
+	return func(t string) bool { return set[t] }
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
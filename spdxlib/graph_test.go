@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+func rel(a common.ElementID, relationship string, b common.ElementID) *spdx.Relationship {
+	return &spdx.Relationship{RefA: common.MakeDocElementID("", string(a)), RefB: common.MakeDocElementID("", string(b)), Relationship: relationship}
+}
+
+// graphDocument describes app, which depends on lib and tool; lib depends on zlib
+func graphDocument() *spdx.Document {
+	return &spdx.Document{
+		SPDXIdentifier: "DOCUMENT",
+		Packages: []*spdx.Package{
+			{PackageName: "app", PackageSPDXIdentifier: "app"},
+			{PackageName: "lib", PackageSPDXIdentifier: "lib"},
+			{PackageName: "zlib", PackageSPDXIdentifier: "zlib"},
+			{PackageName: "tool", PackageSPDXIdentifier: "tool"},
+			{PackageName: "unrelated", PackageSPDXIdentifier: "unrelated"},
+		},
+		Relationships: []*spdx.Relationship{
+			rel("DOCUMENT", common.TypeRelationshipDescribe, "app"),
+			rel("app", common.TypeRelationshipDependsOn, "lib"),
+			rel("zlib", common.TypeRelationshipRuntimeDependencyOf, "lib"),
+			rel("tool", common.TypeRelationshipBuildDependencyOf, "app"),
+			rel("app", common.TypeRelationshipDependsOn, "tool"),
+			{RefA: common.MakeDocElementID("", "app"), RefB: common.MakeDocElementID("other", "pkg"), Relationship: common.TypeRelationshipDependsOn},
+			{RefA: common.MakeDocElementID("", "zlib"), RefB: common.MakeDocElementSpecial("NONE"), Relationship: common.TypeRelationshipDependsOn},
+		},
+	}
+}
+
+func Test_GraphEdges(t *testing.T) {
+	doc := graphDocument()
+	g := NewGraph(doc)
+
+	require.Equal(t, []common.ElementID{"DOCUMENT", "app", "lib", "zlib", "tool", "unrelated"}, g.Nodes())
+	require.True(t, g.Has("unrelated"))
+	require.False(t, g.Has("pkg"))
+
+	// relationships with other documents and special values are left out
+	require.Len(t, g.Outgoing("app"), 2)
+	require.Len(t, g.Outgoing("zlib"), 1)
+	require.Equal(t, Edge{From: "zlib", To: "lib", Type: common.TypeRelationshipRuntimeDependencyOf, Relationship: doc.Relationships[2]}, *g.Incoming("lib")[1])
+	require.Equal(t, "SPDXRef-zlib RUNTIME_DEPENDENCY_OF SPDXRef-lib", g.Incoming("lib")[1].String())
+
+	filtered := g.Filter(common.TypeRelationshipDescribe)
+	require.Equal(t, g.Nodes(), filtered.Nodes())
+	require.Len(t, filtered.Outgoing("DOCUMENT"), 1)
+	require.Empty(t, filtered.Outgoing("app"))
+}
+
+func Test_GraphWalk(t *testing.T) {
+	g := NewGraph(graphDocument())
+
+	require.Equal(t, []common.ElementID{"app", "lib", "tool"}, g.Reachable("DOCUMENT", Traversal{}))
+	require.Equal(t, []common.ElementID{"app"}, g.Reachable("DOCUMENT", Traversal{MaxDepth: 1}))
+	require.Equal(t, []common.ElementID{"app", "zlib", "DOCUMENT", "tool"}, g.Reachable("lib", Traversal{Direction: Backward}))
+	require.Equal(t, []common.ElementID{"app", "zlib", "tool"}, g.Reachable("lib", Traversal{Direction: Both, Types: []string{common.TypeRelationshipDependsOn, common.TypeRelationshipRuntimeDependencyOf}}))
+	require.Empty(t, g.Reachable("unrelated", Traversal{}))
+	require.Empty(t, g.Reachable("missing", Traversal{}))
+
+	var breadth, depth []Step
+	g.Walk("app", Traversal{Direction: Both}, func(s Step) bool {
+		breadth = append(breadth, s)
+		return true
+	})
+	g.Walk("app", Traversal{Direction: Both, Order: DepthFirst}, func(s Step) bool {
+		depth = append(depth, s)
+		return true
+	})
+	ids := func(steps []Step) []common.ElementID {
+		var out []common.ElementID
+		for _, s := range steps {
+			out = append(out, s.ID)
+		}
+		return out
+	}
+	require.Equal(t, []common.ElementID{"app", "lib", "tool", "DOCUMENT", "zlib"}, ids(breadth))
+	require.Equal(t, []common.ElementID{"app", "lib", "zlib", "tool", "DOCUMENT"}, ids(depth))
+	require.Nil(t, depth[0].Via)
+	require.Equal(t, 2, depth[2].Depth)
+	require.Equal(t, common.TypeRelationshipRuntimeDependencyOf, depth[2].Via.Type)
+
+	// returning false stops the walk
+	visited := 0
+	g.Walk("DOCUMENT", Traversal{Order: DepthFirst}, func(s Step) bool {
+		visited++
+		return s.ID != "app"
+	})
+	require.Equal(t, 2, visited)
+}
+
+func Test_GraphDependencies(t *testing.T) {
+	g := NewGraph(graphDocument())
+
+	// DEPENDS_ON and the DEPENDENCY_OF types give the same edges
+	deps := g.Dependencies()
+	require.Len(t, deps.Outgoing("app"), 3)
+	require.Equal(t, []common.ElementID{"lib", "tool"}, deps.Reachable("app", Traversal{MaxDepth: 1}))
+	require.Empty(t, deps.Outgoing("DOCUMENT"))
+
+	require.Equal(t, []common.ElementID{"lib", "tool", "zlib"}, g.TransitiveDependencies("app"))
+	require.Equal(t, []common.ElementID{"lib", "app"}, g.TransitiveDependents("zlib"))
+	require.Empty(t, g.TransitiveDependencies("zlib"))
+
+	path, ok := g.WhyIncluded("app", "zlib")
+	require.True(t, ok)
+	require.Equal(t, "SPDXRef-app DEPENDS_ON SPDXRef-lib\nSPDXRef-zlib RUNTIME_DEPENDENCY_OF SPDXRef-lib\n", path.String())
+	require.Equal(t, common.ElementID("lib"), path[1].From)
+	require.Equal(t, common.ElementID("zlib"), path[1].To)
+
+	_, ok = g.WhyIncluded("zlib", "app")
+	require.False(t, ok)
+}
+
+func Test_GraphShortestPath(t *testing.T) {
+	g := NewGraph(graphDocument())
+
+	path, ok := g.ShortestPath("DOCUMENT", "lib", Traversal{})
+	require.True(t, ok)
+	require.Len(t, path, 2)
+	require.Equal(t, common.TypeRelationshipDescribe, path[0].Type)
+
+	_, ok = g.ShortestPath("DOCUMENT", "zlib", Traversal{})
+	require.False(t, ok)
+
+	path, ok = g.ShortestPath("DOCUMENT", "zlib", Traversal{Direction: Both})
+	require.True(t, ok)
+	require.Equal(t, "SPDXRef-DOCUMENT DESCRIBES SPDXRef-app\nSPDXRef-app DEPENDS_ON SPDXRef-lib\nSPDXRef-zlib RUNTIME_DEPENDENCY_OF SPDXRef-lib\n", path.String())
+
+	path, ok = g.ShortestPath("app", "app", Traversal{})
+	require.True(t, ok)
+	require.Empty(t, path)
+}
+
+func Test_GraphCycles(t *testing.T) {
+	doc := graphDocument()
+	require.False(t, NewGraph(doc).Dependencies().HasCycle())
+
+	// tool BUILD_DEPENDENCY_OF app and app DEPENDS_ON tool are the same edge;
+	// a cycle needs the dependency to go the other way
+	doc.Relationships = append(doc.Relationships,
+		rel("lib", common.TypeRelationshipDependencyOf, "zlib"),
+		rel("unrelated", common.TypeRelationshipDependsOn, "unrelated"),
+		rel("tool", common.TypeRelationshipGeneratedFrom, "app"),
+	)
+	g := NewGraph(doc)
+	require.Equal(t, [][]common.ElementID{{"lib", "zlib"}, {"unrelated"}}, g.Dependencies().Cycles())
+	require.Equal(t, [][]common.ElementID{{"app", "tool"}, {"unrelated"}}, g.Cycles(common.TypeRelationshipDependsOn, common.TypeRelationshipGeneratedFrom))
+	require.False(t, g.HasCycle(common.TypeRelationshipDescribe))
+	require.True(t, g.HasCycle())
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// IDGenerator returns a new ID for an element of a document. Generators are
+// called once per element, in document order, and may keep state between
+// calls; create a new generator for each document.
+type IDGenerator func(e *Element) (common.ElementID, error)
+
+// RegenerateIDs renames every package, file and snippet of the document with
+// an ID from the generator, as RewriteIDs does. Generated IDs that are already
+// taken get a "-<n>" suffix. It returns the new ID of each element, keyed by
+// its previous ID; the document is left unchanged if it returns an error.
+func RegenerateIDs(doc *spdx.Document, generate IDGenerator) (map[common.ElementID]common.ElementID, error) {
+	idx := NewIndex(doc)
+	renamed := map[common.ElementID]common.ElementID{}
+	used := map[common.ElementID]bool{idx.IDs()[0]: true}
+	for _, id := range idx.IDs()[1:] {
+		e, _ := idx.Element(id)
+		newID, err := generate(e)
+		if err != nil {
+			return nil, err
+		}
+		candidate := newID
+		for n := 2; used[candidate]; n++ {
+			candidate = common.ElementID(fmt.Sprintf("%s-%d", newID, n))
+		}
+		used[candidate] = true
+		renamed[id] = candidate
+	}
+
+	err := RewriteIDs(doc, func(id common.ElementID) common.ElementID {
+		if newID, ok := renamed[id]; ok {
+			return newID
+		}
+		return id
+	})
+	if err != nil {
+		return nil, err
+	}
+	return renamed, nil
+}
+
+// SequentialIDs numbers the elements of each kind in document order:
+// "Package-1", "Package-2", "File-1" and so on
+func SequentialIDs() IDGenerator {
+	counts := map[ElementKind]int{}
+	return func(e *Element) (common.ElementID, error) {
+		counts[e.Kind]++
+		return common.ElementID(fmt.Sprintf("%s-%d", e.Kind, counts[e.Kind])), nil
+	}
+}
+
+// ContentHashIDs names each element after the SHA256 of its content, without
+// its ID and the IDs it refers to, e.g. "File-3f2a...". Elements keep their IDs
+// when the document is rebuilt or reordered, as long as their content is the
+// same. Elements with the same content are told apart by document order.
+func ContentHashIDs() IDGenerator {
+	return func(e *Element) (common.ElementID, error) {
+		var content interface{}
+		switch e.Kind {
+		case KindPackage:
+			pkg := *e.Package
+			pkg.PackageSPDXIdentifier = ""
+			pkg.Files = nil
+			content = pkg
+		case KindFile:
+			file := *e.File
+			file.FileSPDXIdentifier = ""
+			content = file
+		case KindSnippet:
+			snippet := *e.Snippet
+			snippet.SnippetSPDXIdentifier = ""
+			snippet.SnippetFromFileSPDXIdentifier = ""
+			snippet.Ranges = make([]common.SnippetRange, len(e.Snippet.Ranges))
+			for i, r := range e.Snippet.Ranges {
+				r.StartPointer.FileSPDXIdentifier = ""
+				r.EndPointer.FileSPDXIdentifier = ""
+				snippet.Ranges[i] = r
+			}
+			content = snippet
+		default:
+			return "", fmt.Errorf("cannot hash %s %s", e.Kind, common.RenderElementID(e.ID))
+		}
+
+		b, err := json.Marshal(content)
+		if err != nil {
+			return "", fmt.Errorf("unable to hash %s: %w", common.RenderElementID(e.ID), err)
+		}
+		sum := sha256.Sum256(b)
+		return common.ElementID(fmt.Sprintf("%s-%s", e.Kind, hex.EncodeToString(sum[:16]))), nil
+	}
+}
+
+// UUIDIDs names each element with a random (version 4) UUID read from
+// random, e.g. "Package-0b5e4c3a-...". If random is nil, crypto/rand is used.
+func UUIDIDs(random io.Reader) IDGenerator {
+	if random == nil {
+		random = rand.Reader
+	}
+	return func(e *Element) (common.ElementID, error) {
+		var u [16]byte
+		if _, err := io.ReadFull(random, u[:]); err != nil {
+			return "", fmt.Errorf("unable to generate UUID: %w", err)
+		}
+		u[6] = (u[6] & 0x0f) | 0x40
+		u[8] = (u[8] & 0x3f) | 0x80
+		return common.ElementID(fmt.Sprintf("%s-%x-%x-%x-%x-%x", e.Kind, u[0:4], u[4:6], u[6:8], u[8:10], u[10:])), nil
+	}
+}
@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+)
+
+func Test_RewriteIDs(t *testing.T) {
+	doc := example.Copy()
+	snippet := doc.Snippets[0]
+	doc.Files[0].Snippets = map[common.ElementID]*spdx.Snippet{snippet.SnippetSPDXIdentifier: &snippet}
+	doc.Annotations[0].AnnotationSPDXIdentifier = common.MakeDocElementID("", "DoapSource")
+	doc.Packages[0].Annotations = []spdx.Annotation{{
+		Annotator:                spdx.Annotator{Annotator: "Jane Doe", AnnotatorType: "Person"},
+		AnnotationDate:           "2010-01-29T18:30:22Z",
+		AnnotationType:           "REVIEW",
+		AnnotationSPDXIdentifier: common.MakeDocElementID("", string(doc.Packages[0].PackageSPDXIdentifier)),
+		AnnotationComment:        "A package annotation",
+	}}
+
+	err := RewriteIDs(&doc, func(id common.ElementID) common.ElementID {
+		return "x-" + id
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, common.ElementID("DOCUMENT"), doc.SPDXIdentifier)
+	require.Equal(t, common.ElementID("x-Package"), doc.Packages[0].PackageSPDXIdentifier)
+	require.Equal(t, common.ElementID("x-DoapSource"), doc.Files[0].FileSPDXIdentifier)
+	require.Equal(t, common.ElementID("x-Snippet"), doc.Snippets[0].SnippetSPDXIdentifier)
+	require.Equal(t, common.ElementID("x-DoapSource"), doc.Snippets[0].SnippetFromFileSPDXIdentifier)
+	for _, r := range doc.Snippets[0].Ranges {
+		require.Equal(t, common.ElementID("x-DoapSource"), r.StartPointer.FileSPDXIdentifier)
+		require.Equal(t, common.ElementID("x-DoapSource"), r.EndPointer.FileSPDXIdentifier)
+	}
+	require.Contains(t, doc.Files[0].Snippets, common.ElementID("x-Snippet"))
+	require.Equal(t, common.ElementID("x-DoapSource"), doc.Annotations[0].AnnotationSPDXIdentifier.ElementRefID)
+	require.Equal(t, common.ElementID("x-Package"), doc.Packages[0].Annotations[0].AnnotationSPDXIdentifier.ElementRefID)
+
+	require.Equal(t, []*spdx.Relationship{
+		{
+			RefA:                common.MakeDocElementID("", "DOCUMENT"),
+			RefB:                common.MakeDocElementID("", "x-Package"),
+			Relationship:        common.TypeRelationshipContains,
+			RelationshipComment: "A relationship comment",
+		},
+		{
+			RefA:         common.MakeDocElementID("", "DOCUMENT"),
+			RefB:         common.MakeDocElementID("spdx-tool-1.2", "ToolsElement"),
+			Relationship: common.TypeRelationshipCopyOf,
+		},
+		rel("DOCUMENT", common.TypeRelationshipDescribe, "x-File"),
+		rel("DOCUMENT", common.TypeRelationshipDescribe, "x-Package"),
+		rel("x-Package", common.TypeRelationshipContains, "x-JenaLib"),
+		rel("x-Package", common.TypeRelationshipDynamicLink, "x-Saxon"),
+		{
+			RefA:         common.MakeDocElementID("", "x-CommonsLangSrc"),
+			RefB:         common.MakeDocElementSpecial("NOASSERTION"),
+			Relationship: common.TypeRelationshipGeneratedFrom,
+		},
+		rel("x-JenaLib", common.TypeRelationshipContains, "x-Package"),
+		rel("x-File", common.TypeRelationshipGeneratedFrom, "x-fromDoap-0"),
+	}, doc.Relationships)
+	require.NoError(t, Validate(&doc).Err())
+}
+
+func Test_RewriteIDsRejected(t *testing.T) {
+	for name, mapping := range map[string]func(common.ElementID) common.ElementID{
+		"duplicate": func(id common.ElementID) common.ElementID {
+			if id == "Saxon" {
+				return "Package"
+			}
+			return id
+		},
+		"document": func(id common.ElementID) common.ElementID {
+			if id == "Saxon" {
+				return "DOCUMENT"
+			}
+			return id
+		},
+		"invalid": func(id common.ElementID) common.ElementID {
+			return id + ":1"
+		},
+		"empty": func(id common.ElementID) common.ElementID {
+			return ""
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			doc := example.Copy()
+			require.Error(t, RewriteIDs(&doc, mapping))
+			require.Equal(t, example.Copy(), doc)
+		})
+	}
+}
+
+func Test_RegenerateIDs(t *testing.T) {
+	doc := example.Copy()
+	renamed, err := RegenerateIDs(&doc, SequentialIDs())
+	require.NoError(t, err)
+	require.Equal(t, map[common.ElementID]common.ElementID{
+		"Package":        "Package-1",
+		"fromDoap-1":     "Package-2",
+		"fromDoap-0":     "Package-3",
+		"Saxon":          "Package-4",
+		"CentOS-7":       "Package-5",
+		"DoapSource":     "File-1",
+		"CommonsLangSrc": "File-2",
+		"JenaLib":        "File-3",
+		"File":           "File-4",
+		"Snippet":        "Snippet-1",
+	}, renamed)
+	require.Equal(t, common.ElementID("File-1"), doc.Snippets[0].SnippetFromFileSPDXIdentifier)
+	require.NoError(t, Validate(&doc).Err())
+}
+
+func Test_ContentHashIDs(t *testing.T) {
+	doc := example.Copy()
+	renamed, err := RegenerateIDs(&doc, ContentHashIDs())
+	require.NoError(t, err)
+	for old, id := range renamed {
+		e, ok := NewIndex(&doc).Element(id)
+		require.True(t, ok, old)
+		require.True(t, strings.HasPrefix(string(id), e.Kind.String()+"-"), id)
+	}
+	require.NoError(t, Validate(&doc).Err())
+
+	// the IDs do not depend on the order of the elements or their previous IDs
+	reordered := example.Copy()
+	reordered.Packages[0], reordered.Packages[4] = reordered.Packages[4], reordered.Packages[0]
+	reordered.Files[0], reordered.Files[1] = reordered.Files[1], reordered.Files[0]
+	require.NoError(t, RewriteIDs(&reordered, func(id common.ElementID) common.ElementID {
+		return "old-" + id
+	}))
+	again, err := RegenerateIDs(&reordered, ContentHashIDs())
+	require.NoError(t, err)
+	for old, id := range renamed {
+		require.Equal(t, id, again["old-"+old], old)
+	}
+
+	// changing an element changes its ID
+	changed := example.Copy()
+	changed.Packages[0].PackageVersion = "2.12"
+	changedIDs, err := RegenerateIDs(&changed, ContentHashIDs())
+	require.NoError(t, err)
+	require.NotEqual(t, renamed["Package"], changedIDs["Package"])
+	require.Equal(t, renamed["Saxon"], changedIDs["Saxon"])
+}
+
+func Test_UUIDIDs(t *testing.T) {
+	doc := example.Copy()
+	random := bytes.NewReader(bytes.Repeat([]byte{0xff}, 16*10))
+	renamed, err := RegenerateIDs(&doc, UUIDIDs(random))
+	require.NoError(t, err)
+	require.Equal(t, common.ElementID("Package-ffffffff-ffff-4fff-bfff-ffffffffffff"), renamed["Package"])
+	require.Equal(t, common.ElementID("Package-ffffffff-ffff-4fff-bfff-ffffffffffff-2"), renamed["fromDoap-1"])
+
+	// running out of randomness leaves the document unchanged
+	doc = example.Copy()
+	_, err = RegenerateIDs(&doc, UUIDIDs(bytes.NewReader(nil)))
+	require.Error(t, err)
+	require.Equal(t, example.Copy(), doc)
+
+	doc = example.Copy()
+	renamed, err = RegenerateIDs(&doc, UUIDIDs(nil))
+	require.NoError(t, err)
+	require.NotEqual(t, renamed["Package"], renamed["Saxon"])
+}
@@ -0,0 +1,302 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// ElementKind is the kind of an indexed element
+type ElementKind int
+
+// The kinds of elements that can be looked up by SPDX identifier
+const (
+	KindDocument ElementKind = iota
+	KindPackage
+	KindFile
+	KindSnippet
+)
+
+func (k ElementKind) String() string {
+	switch k {
+	case KindDocument:
+		return "Document"
+	case KindPackage:
+		return "Package"
+	case KindFile:
+		return "File"
+	case KindSnippet:
+		return "Snippet"
+	}
+	return "Unknown"
+}
+
+// Element is an element of an indexed document. Exactly one of Package, File
+// and Snippet is set, unless Kind is KindDocument.
+type Element struct {
+	ID   common.ElementID
+	Kind ElementKind
+
+	Package *spdx.Package
+	File    *spdx.File
+	Snippet *spdx.Snippet
+
+	// Owner is the package whose Files list holds the file, or nil if the
+	// element is defined at document level. A file listed both in a package
+	// and in Document.Files is owned by the package.
+	Owner *spdx.Package
+}
+
+// InPackage returns true if the element is defined inside a package
+func (e *Element) InPackage() bool {
+	return e.Owner != nil
+}
+
+// Index resolves the SPDX identifiers of a document in constant time, and
+// records the relationships of each element. It is a snapshot: build a new
+// Index after changing the document.
+type Index struct {
+	doc      *spdx.Document
+	elements map[common.ElementID]*Element
+
+	// ids are the IDs of the elements in document order
+	ids []common.ElementID
+
+	// files are the file elements in document order
+	files []*Element
+
+	// fileOwners and packageFiles are built from Package.Files and from
+	// CONTAINS and CONTAINED_BY relationships between packages and files
+	fileOwners   map[common.ElementID][]*spdx.Package
+	packageFiles map[common.ElementID][]*spdx.File
+
+	// fileSnippets holds the snippets of each file, from Document.Snippets and File.Snippets
+	fileSnippets map[common.ElementID][]*spdx.Snippet
+
+	outgoing map[common.ElementID][]*spdx.Relationship
+	incoming map[common.ElementID][]*spdx.Relationship
+}
+
+// defaultDocumentID is the ID of a document that does not set SPDXIdentifier
+const defaultDocumentID common.ElementID = "DOCUMENT"
+
+// NewIndex indexes the packages, files, snippets and relationships of the document
+func NewIndex(doc *spdx.Document) *Index {
+	idx := &Index{
+		doc:          doc,
+		elements:     map[common.ElementID]*Element{},
+		fileOwners:   map[common.ElementID][]*spdx.Package{},
+		packageFiles: map[common.ElementID][]*spdx.File{},
+		fileSnippets: map[common.ElementID][]*spdx.Snippet{},
+		outgoing:     map[common.ElementID][]*spdx.Relationship{},
+		incoming:     map[common.ElementID][]*spdx.Relationship{},
+	}
+
+	documentID := doc.SPDXIdentifier
+	if documentID == "" {
+		documentID = defaultDocumentID
+	}
+	idx.add(&Element{ID: documentID, Kind: KindDocument})
+
+	for _, pkg := range doc.Packages {
+		if pkg == nil {
+			continue
+		}
+		idx.add(&Element{ID: pkg.PackageSPDXIdentifier, Kind: KindPackage, Package: pkg})
+		for _, file := range pkg.Files {
+			if file == nil {
+				continue
+			}
+			idx.add(&Element{ID: file.FileSPDXIdentifier, Kind: KindFile, File: file, Owner: pkg})
+			idx.own(pkg, file)
+		}
+	}
+	for _, file := range doc.Files {
+		if file == nil {
+			continue
+		}
+		idx.add(&Element{ID: file.FileSPDXIdentifier, Kind: KindFile, File: file})
+	}
+
+	for i := range doc.Snippets {
+		snippet := &doc.Snippets[i]
+		idx.add(&Element{ID: snippet.SnippetSPDXIdentifier, Kind: KindSnippet, Snippet: snippet, Owner: idx.owner(snippet.SnippetFromFileSPDXIdentifier)})
+		idx.fileSnippets[snippet.SnippetFromFileSPDXIdentifier] = append(idx.fileSnippets[snippet.SnippetFromFileSPDXIdentifier], snippet)
+	}
+	for _, e := range idx.files {
+		ids := make([]common.ElementID, 0, len(e.File.Snippets))
+		for id := range e.File.Snippets {
+			ids = append(ids, id)
+		}
+		for _, id := range SortElementIDs(ids) {
+			snippet := e.File.Snippets[id]
+			if snippet == nil {
+				continue
+			}
+			if _, ok := idx.elements[snippet.SnippetSPDXIdentifier]; ok {
+				continue
+			}
+			idx.add(&Element{ID: snippet.SnippetSPDXIdentifier, Kind: KindSnippet, Snippet: snippet, Owner: e.Owner})
+			idx.fileSnippets[e.ID] = append(idx.fileSnippets[e.ID], snippet)
+		}
+	}
+
+	for _, r := range doc.Relationships {
+		if r == nil {
+			continue
+		}
+		if id, ok := localID(r.RefA); ok {
+			idx.outgoing[id] = append(idx.outgoing[id], r)
+		}
+		if id, ok := localID(r.RefB); ok {
+			idx.incoming[id] = append(idx.incoming[id], r)
+		}
+
+		switch r.Relationship {
+		case common.TypeRelationshipContains:
+			idx.relateOwner(r.RefA, r.RefB)
+		case common.TypeRelationshipContainedBy:
+			idx.relateOwner(r.RefB, r.RefA)
+		}
+	}
+
+	return idx
+}
+
+// add indexes an element; the first definition of an ID wins
+func (idx *Index) add(e *Element) {
+	if _, ok := idx.elements[e.ID]; ok {
+		return
+	}
+	idx.elements[e.ID] = e
+	idx.ids = append(idx.ids, e.ID)
+	if e.Kind == KindFile {
+		idx.files = append(idx.files, e)
+	}
+}
+
+// owner returns the package that holds the file, if any
+func (idx *Index) owner(fileID common.ElementID) *spdx.Package {
+	if e, ok := idx.elements[fileID]; ok {
+		return e.Owner
+	}
+	return nil
+}
+
+// relateOwner records a CONTAINS relationship from a package to a file
+func (idx *Index) relateOwner(pkgRef common.DocElementID, fileRef common.DocElementID) {
+	pkgID, ok := localID(pkgRef)
+	if !ok {
+		return
+	}
+	fileID, ok := localID(fileRef)
+	if !ok {
+		return
+	}
+	pkg, file := idx.Package(pkgID), idx.File(fileID)
+	if pkg != nil && file != nil {
+		idx.own(pkg, file)
+	}
+}
+
+func (idx *Index) own(pkg *spdx.Package, file *spdx.File) {
+	for _, owner := range idx.fileOwners[file.FileSPDXIdentifier] {
+		if owner == pkg {
+			return
+		}
+	}
+	idx.fileOwners[file.FileSPDXIdentifier] = append(idx.fileOwners[file.FileSPDXIdentifier], pkg)
+	idx.packageFiles[pkg.PackageSPDXIdentifier] = append(idx.packageFiles[pkg.PackageSPDXIdentifier], file)
+}
+
+// localID returns the element ID of a reference to an element of this document
+func localID(ref common.DocElementID) (common.ElementID, bool) {
+	if ref.DocumentRefID != "" || ref.SpecialID != "" || ref.ElementRefID == "" {
+		return "", false
+	}
+	return ref.ElementRefID, true
+}
+
+// Document returns the indexed document
+func (idx *Index) Document() *spdx.Document {
+	return idx.doc
+}
+
+// Element returns the element with the given ID
+func (idx *Index) Element(id common.ElementID) (*Element, bool) {
+	e, ok := idx.elements[id]
+	return e, ok
+}
+
+// IDs returns the IDs of the elements of the document: the document itself,
+// then the packages with their files, the document-level files and the snippets
+func (idx *Index) IDs() []common.ElementID {
+	return idx.ids
+}
+
+// Resolve returns the element a DocElementID refers to. References to other
+// documents and the special values NONE and NOASSERTION do not resolve.
+func (idx *Index) Resolve(ref common.DocElementID) (*Element, bool) {
+	id, ok := localID(ref)
+	if !ok {
+		return nil, false
+	}
+	return idx.Element(id)
+}
+
+// Package returns the package with the given ID, or nil
+func (idx *Index) Package(id common.ElementID) *spdx.Package {
+	if e, ok := idx.elements[id]; ok {
+		return e.Package
+	}
+	return nil
+}
+
+// File returns the file with the given ID, or nil
+func (idx *Index) File(id common.ElementID) *spdx.File {
+	if e, ok := idx.elements[id]; ok {
+		return e.File
+	}
+	return nil
+}
+
+// Snippet returns the snippet with the given ID, or nil
+func (idx *Index) Snippet(id common.ElementID) *spdx.Snippet {
+	if e, ok := idx.elements[id]; ok {
+		return e.Snippet
+	}
+	return nil
+}
+
+// Files returns the files of the document, including those inside packages,
+// in document order
+func (idx *Index) Files() []*Element {
+	return idx.files
+}
+
+// FileOwners returns the packages that list the file in Package.Files or
+// contain it according to a CONTAINS or CONTAINED_BY relationship
+func (idx *Index) FileOwners(id common.ElementID) []*spdx.Package {
+	return idx.fileOwners[id]
+}
+
+// PackageFiles returns the files of the package, as defined for FileOwners
+func (idx *Index) PackageFiles(id common.ElementID) []*spdx.File {
+	return idx.packageFiles[id]
+}
+
+// FileSnippets returns the snippets taken from the file
+func (idx *Index) FileSnippets(id common.ElementID) []*spdx.Snippet {
+	return idx.fileSnippets[id]
+}
+
+// Outgoing returns the relationships whose RefA is the element, in document order
+func (idx *Index) Outgoing(id common.ElementID) []*spdx.Relationship {
+	return idx.outgoing[id]
+}
+
+// Incoming returns the relationships whose RefB is the element, in document order
+func (idx *Index) Incoming(id common.ElementID) []*spdx.Relationship {
+	return idx.incoming[id]
+}
@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+)
+
+func Test_IndexExample(t *testing.T) {
+	doc := example.Copy()
+	idx := NewIndex(&doc)
+	require.Same(t, &doc, idx.Document())
+
+	e, ok := idx.Element("DOCUMENT")
+	require.True(t, ok)
+	require.Equal(t, KindDocument, e.Kind)
+
+	e, ok = idx.Element("Saxon")
+	require.True(t, ok)
+	require.Equal(t, KindPackage, e.Kind)
+	require.Same(t, doc.Packages[3], e.Package)
+	require.Same(t, doc.Packages[3], idx.Package("Saxon"))
+	require.Nil(t, idx.File("Saxon"))
+
+	e, ok = idx.Resolve(common.MakeDocElementID("", "JenaLib"))
+	require.True(t, ok)
+	require.Equal(t, KindFile, e.Kind)
+	require.Same(t, doc.Files[2], e.File)
+	require.False(t, e.InPackage())
+
+	e, ok = idx.Element("Snippet")
+	require.True(t, ok)
+	require.Equal(t, KindSnippet, e.Kind)
+	require.Same(t, &doc.Snippets[0], idx.Snippet("Snippet"))
+	require.Equal(t, []*spdx.Snippet{&doc.Snippets[0]}, idx.FileSnippets("DoapSource"))
+
+	_, ok = idx.Element("missing")
+	require.False(t, ok)
+	_, ok = idx.Resolve(common.MakeDocElementID("spdx-tool-1.2", "ToolsElement"))
+	require.False(t, ok)
+	_, ok = idx.Resolve(common.MakeDocElementSpecial("NOASSERTION"))
+	require.False(t, ok)
+
+	require.Len(t, idx.Files(), len(doc.Files))
+	for i, f := range idx.Files() {
+		require.Same(t, doc.Files[i], f.File)
+	}
+
+	// Package CONTAINS JenaLib; JenaLib CONTAINS Package relates no file
+	require.Equal(t, []*spdx.Package{doc.Packages[0]}, idx.FileOwners("JenaLib"))
+	require.Equal(t, []*spdx.File{doc.Files[2]}, idx.PackageFiles("Package"))
+	require.Empty(t, idx.PackageFiles("JenaLib"))
+
+	require.Len(t, idx.Outgoing("DOCUMENT"), 4)
+	require.Len(t, idx.Incoming("Package"), 3)
+	require.Equal(t, common.TypeRelationshipGeneratedFrom, idx.Outgoing("File")[0].Relationship)
+	require.Empty(t, idx.Incoming("ToolsElement"))
+}
+
+func Test_IndexPackageFiles(t *testing.T) {
+	file := &spdx.File{FileSPDXIdentifier: "file", FileName: "./file.c"}
+	doc := &spdx.Document{
+		Packages: []*spdx.Package{
+			{PackageSPDXIdentifier: "pkg", Files: []*spdx.File{file}},
+			{PackageSPDXIdentifier: "other"},
+		},
+		// a package file repeated at document level stays owned by the package
+		Files: []*spdx.File{file},
+		Relationships: []*spdx.Relationship{
+			{RefA: common.MakeDocElementID("", "pkg"), RefB: common.MakeDocElementID("", "file"), Relationship: common.TypeRelationshipContains},
+			{RefA: common.MakeDocElementID("", "file"), RefB: common.MakeDocElementID("", "other"), Relationship: common.TypeRelationshipContainedBy},
+		},
+	}
+	file.Snippets = map[common.ElementID]*spdx.Snippet{
+		"b": {SnippetSPDXIdentifier: "b", SnippetFromFileSPDXIdentifier: "file"},
+		"a": {SnippetSPDXIdentifier: "a", SnippetFromFileSPDXIdentifier: "file"},
+	}
+	idx := NewIndex(doc)
+
+	e, ok := idx.Element("file")
+	require.True(t, ok)
+	require.True(t, e.InPackage())
+	require.Same(t, doc.Packages[0], e.Owner)
+	require.Len(t, idx.Files(), 1)
+
+	require.Equal(t, doc.Packages, idx.FileOwners("file"))
+	require.Equal(t, []*spdx.File{file}, idx.PackageFiles("pkg"))
+	require.Equal(t, []*spdx.File{file}, idx.PackageFiles("other"))
+
+	snippets := idx.FileSnippets("file")
+	require.Len(t, snippets, 2)
+	require.Equal(t, common.ElementID("a"), snippets[0].SnippetSPDXIdentifier)
+	e, ok = idx.Element("b")
+	require.True(t, ok)
+	require.Same(t, doc.Packages[0], e.Owner)
+
+	e, ok = idx.Element("DOCUMENT")
+	require.True(t, ok)
+	require.Equal(t, KindDocument, e.Kind)
+}
@@ -0,0 +1,579 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	converter "github.com/anchore/go-struct-converter"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// MergeOptions describes the document created by Merge
+type MergeOptions struct {
+	// DocumentName and DocumentNamespace are mandatory
+	DocumentName      string
+	DocumentNamespace string
+
+	// Creators defaults to the creators of the merged documents
+	Creators []common.Creator
+
+	// Created defaults to the current time
+	Created string
+
+	CreatorComment string
+}
+
+// MergeResult is the result of Merge
+type MergeResult struct {
+	Document *spdx.Document
+
+	// IDs maps the element IDs of each merged document, in the order the
+	// documents were given, to their IDs in the merged document
+	IDs []map[common.ElementID]common.ElementID
+
+	// LicenseIDs maps the LicenseRef- identifiers of each merged document to
+	// their identifiers in the merged document
+	LicenseIDs []map[string]string
+}
+
+// Merge combines the documents into a new document, leaving them unchanged.
+//
+// Element IDs and LicenseRef- identifiers are kept unless they collide with
+// those of a previous document, in which case a "-<n>" suffix makes them
+// unique; every reference to a renamed element or license is rewritten. A
+// package with the same purl or checksum as a package of a previous document
+// is merged into it, together with its files, matched by name. A license with
+// the same identifier and text as one of a previous document is merged too.
+//
+// References of the form "DocumentRef-x:SPDXRef-y" to one of the merged
+// documents become references within the merged document, and the
+// ExternalDocumentRef is dropped. The SPDX identifier of each merged document
+// maps to that of the new document, so the elements each document DESCRIBES
+// are described by the merged document.
+func Merge(options MergeOptions, docs ...*spdx.Document) (*MergeResult, error) {
+	if options.DocumentName == "" || options.DocumentNamespace == "" {
+		return nil, fmt.Errorf("merged document needs a name and a namespace")
+	}
+
+	m := &merger{
+		docs:       make([]*spdx.Document, len(docs)),
+		namespaces: map[string]int{},
+		used:       map[string]bool{string(defaultDocumentID): true},
+		licenses:   map[string]*spdx.OtherLicense{},
+		docRefs:    map[string]mergedDocRef{},
+		result: &MergeResult{
+			IDs:        make([]map[common.ElementID]common.ElementID, len(docs)),
+			LicenseIDs: make([]map[string]string, len(docs)),
+		},
+	}
+
+	// work on copies, since merging rewrites IDs
+	for i, doc := range docs {
+		if doc == nil {
+			return nil, fmt.Errorf("document %d is nil", i)
+		}
+		m.docs[i] = &spdx.Document{}
+		if err := converter.Convert(doc, m.docs[i]); err != nil {
+			return nil, fmt.Errorf("unable to copy document %d: %w", i, err)
+		}
+		if doc.DocumentNamespace != "" {
+			if _, ok := m.namespaces[doc.DocumentNamespace]; !ok {
+				m.namespaces[doc.DocumentNamespace] = i
+			}
+		}
+	}
+
+	m.localRefs = make([]map[string]int, len(docs))
+	m.renamedRefs = make([]map[string]string, len(docs))
+	for i := range m.docs {
+		m.assign(i)
+	}
+
+	merged := &spdx.Document{
+		SPDXVersion:       spdx.Version,
+		DataLicense:       spdx.DataLicense,
+		SPDXIdentifier:    defaultDocumentID,
+		DocumentName:      options.DocumentName,
+		DocumentNamespace: options.DocumentNamespace,
+		CreationInfo:      m.creationInfo(options),
+	}
+	m.result.Document = merged
+	for i, doc := range m.docs {
+		m.rewriter(i).rewrite(doc)
+		m.add(merged, i)
+	}
+	removeDuplicateRelationships(merged)
+
+	return m.result, nil
+}
+
+type merger struct {
+	docs   []*spdx.Document
+	result *MergeResult
+
+	// namespaces maps the namespace of each merged document to its index
+	namespaces map[string]int
+
+	// used holds the element IDs and license identifiers of the merged document
+	used map[string]bool
+
+	// packages are the packages of the merged document, before rewriting
+	packages []mergedPackage
+
+	// licenses are the licenses of the merged document by new identifier
+	licenses map[string]*spdx.OtherLicense
+
+	// docRefs are the external document references of the merged document
+	// by new ID, without the "DocumentRef-" prefix
+	docRefs map[string]mergedDocRef
+
+	// localRefs maps the DocumentRef IDs of each document that refer to a
+	// merged document to that document's index; renamedRefs maps the other
+	// DocumentRef IDs to their IDs in the merged document. Both are keyed by
+	// IDs without the "DocumentRef-" prefix.
+	localRefs   []map[string]int
+	renamedRefs []map[string]string
+}
+
+type mergedPackage struct {
+	pkg *spdx.Package
+	doc int
+}
+
+type mergedDocRef struct {
+	ref spdx.ExternalDocumentRef
+	doc int
+}
+
+// unique returns id, or id with the smallest "-<n>" suffix that is not used yet, and marks it used
+func (m *merger) unique(id string) string {
+	candidate := id
+	for n := 2; m.used[candidate]; n++ {
+		candidate = fmt.Sprintf("%s-%d", id, n)
+	}
+	m.used[candidate] = true
+	return candidate
+}
+
+// assign decides the new IDs of the elements, licenses and external document references of document i
+func (m *merger) assign(i int) {
+	doc := m.docs[i]
+	ids := map[common.ElementID]common.ElementID{}
+	m.result.IDs[i] = ids
+
+	docID := doc.SPDXIdentifier
+	if docID == "" {
+		docID = defaultDocumentID
+	}
+	ids[docID] = defaultDocumentID
+
+	assignFile := func(file *spdx.File) {
+		if file == nil {
+			return
+		}
+		if _, ok := ids[file.FileSPDXIdentifier]; !ok {
+			ids[file.FileSPDXIdentifier] = common.ElementID(m.unique(string(file.FileSPDXIdentifier)))
+		}
+	}
+
+	for _, pkg := range doc.Packages {
+		if pkg == nil {
+			continue
+		}
+		if same, ok := m.identicalPackage(i, pkg); ok {
+			sameIDs := m.result.IDs[same.doc]
+			ids[pkg.PackageSPDXIdentifier] = sameIDs[same.pkg.PackageSPDXIdentifier]
+			for _, file := range pkg.Files {
+				if file == nil {
+					continue
+				}
+				if existing := sameFile(same.pkg.Files, file); existing != nil {
+					ids[file.FileSPDXIdentifier] = sameIDs[existing.FileSPDXIdentifier]
+				} else {
+					assignFile(file)
+				}
+			}
+			continue
+		}
+		ids[pkg.PackageSPDXIdentifier] = common.ElementID(m.unique(string(pkg.PackageSPDXIdentifier)))
+		m.packages = append(m.packages, mergedPackage{pkg: pkg, doc: i})
+		for _, file := range pkg.Files {
+			assignFile(file)
+		}
+	}
+	for _, file := range doc.Files {
+		assignFile(file)
+	}
+
+	assignSnippet := func(snippet *spdx.Snippet) {
+		if _, ok := ids[snippet.SnippetSPDXIdentifier]; !ok {
+			ids[snippet.SnippetSPDXIdentifier] = common.ElementID(m.unique(string(snippet.SnippetSPDXIdentifier)))
+		}
+	}
+	for j := range doc.Snippets {
+		assignSnippet(&doc.Snippets[j])
+	}
+	for _, file := range append(packageFiles(doc), doc.Files...) {
+		if file == nil {
+			continue
+		}
+		for _, id := range SortElementIDs(snippetIDs(file)) {
+			if snippet := file.Snippets[id]; snippet != nil {
+				assignSnippet(snippet)
+			}
+		}
+	}
+
+	licenseIDs := map[string]string{}
+	m.result.LicenseIDs[i] = licenseIDs
+	for _, l := range doc.OtherLicenses {
+		if l == nil {
+			continue
+		}
+		if existing, ok := m.licenses[l.LicenseIdentifier]; ok && existing.ExtractedText == l.ExtractedText {
+			licenseIDs[l.LicenseIdentifier] = l.LicenseIdentifier
+			continue
+		}
+		id := m.unique(l.LicenseIdentifier)
+		licenseIDs[l.LicenseIdentifier] = id
+		m.licenses[id] = l
+	}
+
+	m.localRefs[i] = map[string]int{}
+	m.renamedRefs[i] = map[string]string{}
+	for _, ref := range doc.ExternalDocumentReferences {
+		id := strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)
+		if j, ok := m.namespaces[ref.URI]; ok {
+			m.localRefs[i][id] = j
+			continue
+		}
+		renamed := ""
+		for existingID, existing := range m.docRefs {
+			if existing.ref.URI == ref.URI && existing.ref.Checksum == ref.Checksum {
+				renamed = existingID
+				break
+			}
+		}
+		if renamed == "" {
+			renamed = strings.TrimPrefix(m.unique(documentRefPrefix+id), documentRefPrefix)
+			m.docRefs[renamed] = mergedDocRef{ref: ref, doc: i}
+		}
+		m.renamedRefs[i][id] = renamed
+	}
+}
+
+// identicalPackage returns a package of a previous document with a purl or checksum in common with pkg
+func (m *merger) identicalPackage(i int, pkg *spdx.Package) (mergedPackage, bool) {
+	for _, same := range m.packages {
+		if same.doc == i {
+			continue
+		}
+		other := same.pkg
+		for _, purl := range purls(pkg) {
+			for _, otherPurl := range purls(other) {
+				if purl == otherPurl {
+					return same, true
+				}
+			}
+		}
+		for _, c := range pkg.PackageChecksums {
+			for _, otherChecksum := range other.PackageChecksums {
+				if c.Value != "" && c.Algorithm == otherChecksum.Algorithm && strings.EqualFold(c.Value, otherChecksum.Value) {
+					return same, true
+				}
+			}
+		}
+	}
+	return mergedPackage{}, false
+}
+
+func purls(pkg *spdx.Package) []string {
+	var out []string
+	for _, ref := range pkg.PackageExternalReferences {
+		if ref != nil && ref.RefType == common.TypePackageManagerPURL && ref.Locator != "" {
+			out = append(out, ref.Locator)
+		}
+	}
+	return out
+}
+
+// sameFile returns the file of files with the name of file
+func sameFile(files []*spdx.File, file *spdx.File) *spdx.File {
+	for _, f := range files {
+		if f != nil && f.FileName == file.FileName {
+			return f
+		}
+	}
+	return nil
+}
+
+func packageFiles(doc *spdx.Document) []*spdx.File {
+	var files []*spdx.File
+	for _, pkg := range doc.Packages {
+		if pkg != nil {
+			files = append(files, pkg.Files...)
+		}
+	}
+	return files
+}
+
+func snippetIDs(file *spdx.File) []common.ElementID {
+	ids := make([]common.ElementID, 0, len(file.Snippets))
+	for id := range file.Snippets {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// rewriter returns the rewriter of the references of document i
+func (m *merger) rewriter(i int) *rewriter {
+	return &rewriter{
+		element: func(ref common.DocElementID) common.DocElementID {
+			docRef := strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)
+			switch {
+			case docRef == "":
+				if id, ok := m.result.IDs[i][ref.ElementRefID]; ok {
+					ref.ElementRefID = id
+				}
+			case m.isLocal(i, docRef):
+				j := m.localRefs[i][docRef]
+				ref.DocumentRefID = ""
+				if id, ok := m.result.IDs[j][ref.ElementRefID]; ok {
+					ref.ElementRefID = id
+				}
+			default:
+				if renamed, ok := m.renamedRefs[i][docRef]; ok {
+					ref.DocumentRefID = renamed
+				}
+			}
+			return ref
+		},
+		licenseRef: func(ref string) string {
+			docRef := ""
+			if colon := strings.Index(ref, ":"); colon >= 0 {
+				docRef, ref = strings.TrimPrefix(ref[:colon], documentRefPrefix), ref[colon+1:]
+			}
+			switch {
+			case docRef == "":
+				if id, ok := m.result.LicenseIDs[i][ref]; ok {
+					return id
+				}
+				return ref
+			case m.isLocal(i, docRef):
+				if id, ok := m.result.LicenseIDs[m.localRefs[i][docRef]][ref]; ok {
+					return id
+				}
+				return ref
+			}
+			if renamed, ok := m.renamedRefs[i][docRef]; ok {
+				docRef = renamed
+			}
+			return documentRefPrefix + docRef + ":" + ref
+		},
+	}
+}
+
+func (m *merger) isLocal(i int, docRef string) bool {
+	_, ok := m.localRefs[i][docRef]
+	return ok
+}
+
+// add adds the rewritten document i to the merged document
+func (m *merger) add(merged *spdx.Document, i int) {
+	doc := m.docs[i]
+
+	packages := map[common.ElementID]*spdx.Package{}
+	files := map[common.ElementID]bool{}
+	for _, pkg := range merged.Packages {
+		packages[pkg.PackageSPDXIdentifier] = pkg
+		for _, file := range pkg.Files {
+			files[file.FileSPDXIdentifier] = true
+		}
+	}
+	for _, file := range merged.Files {
+		files[file.FileSPDXIdentifier] = true
+	}
+
+	for _, pkg := range doc.Packages {
+		if pkg == nil {
+			continue
+		}
+		same, ok := packages[pkg.PackageSPDXIdentifier]
+		if !ok {
+			merged.Packages = append(merged.Packages, pkg)
+			packages[pkg.PackageSPDXIdentifier] = pkg
+			for _, file := range pkg.Files {
+				if file != nil {
+					files[file.FileSPDXIdentifier] = true
+				}
+			}
+			continue
+		}
+		for _, file := range pkg.Files {
+			if file != nil && !files[file.FileSPDXIdentifier] {
+				same.Files = append(same.Files, file)
+				files[file.FileSPDXIdentifier] = true
+			}
+		}
+		for _, a := range pkg.Annotations {
+			if !containsAnnotation(same.Annotations, a) {
+				same.Annotations = append(same.Annotations, a)
+			}
+		}
+	}
+	for _, file := range doc.Files {
+		if file != nil && !files[file.FileSPDXIdentifier] {
+			merged.Files = append(merged.Files, file)
+			files[file.FileSPDXIdentifier] = true
+		}
+	}
+
+	snippets := map[common.ElementID]bool{}
+	for _, s := range merged.Snippets {
+		snippets[s.SnippetSPDXIdentifier] = true
+	}
+	for _, s := range doc.Snippets {
+		if !snippets[s.SnippetSPDXIdentifier] {
+			merged.Snippets = append(merged.Snippets, s)
+			snippets[s.SnippetSPDXIdentifier] = true
+		}
+	}
+
+	// licenses and external document references merged into those of a
+	// previous document are left out
+	for _, l := range doc.OtherLicenses {
+		if l != nil && m.licenses[l.LicenseIdentifier] == l {
+			merged.OtherLicenses = append(merged.OtherLicenses, l)
+		}
+	}
+
+	for _, ref := range doc.ExternalDocumentReferences {
+		id := strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)
+		if m.isLocal(i, id) {
+			continue
+		}
+		renamed := m.renamedRefs[i][id]
+		if owner := m.docRefs[renamed]; owner.doc != i || owner.ref != ref {
+			continue
+		}
+		if strings.HasPrefix(ref.DocumentRefID, documentRefPrefix) {
+			ref.DocumentRefID = documentRefPrefix + renamed
+		} else {
+			ref.DocumentRefID = renamed
+		}
+		merged.ExternalDocumentReferences = append(merged.ExternalDocumentReferences, ref)
+	}
+
+	merged.Relationships = append(merged.Relationships, doc.Relationships...)
+	for _, a := range doc.Annotations {
+		if a != nil && !containsAnnotationPtr(merged.Annotations, a) {
+			merged.Annotations = append(merged.Annotations, a)
+		}
+	}
+	merged.Reviews = append(merged.Reviews, doc.Reviews...)
+}
+
+func containsAnnotation(annotations []spdx.Annotation, a spdx.Annotation) bool {
+	for _, other := range annotations {
+		if reflect.DeepEqual(other, a) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAnnotationPtr(annotations []*spdx.Annotation, a *spdx.Annotation) bool {
+	for _, other := range annotations {
+		if reflect.DeepEqual(other, a) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeDuplicateRelationships keeps the first of identical relationships, merging their comments
+func removeDuplicateRelationships(doc *spdx.Document) {
+	var relationships []*spdx.Relationship
+	seen := map[string]*spdx.Relationship{}
+	for _, r := range doc.Relationships {
+		if r == nil {
+			continue
+		}
+		key := relationshipKey(r)
+		if first, ok := seen[key]; ok {
+			first.RelationshipComment = mergeComments(first.RelationshipComment, r.RelationshipComment)
+			continue
+		}
+		seen[key] = r
+		relationships = append(relationships, r)
+	}
+	doc.Relationships = relationships
+}
+
+// creationInfo builds the creation information of the merged document
+func (m *merger) creationInfo(options MergeOptions) *spdx.CreationInfo {
+	info := &spdx.CreationInfo{
+		Creators:       options.Creators,
+		Created:        options.Created,
+		CreatorComment: options.CreatorComment,
+	}
+	if info.Created == "" {
+		info.Created = time.Now().UTC().Format(dateFormat)
+	}
+
+	for _, doc := range m.docs {
+		if doc.CreationInfo == nil {
+			continue
+		}
+		if len(options.Creators) == 0 {
+			for _, c := range doc.CreationInfo.Creators {
+				if !containsCreator(info.Creators, c) {
+					info.Creators = append(info.Creators, c)
+				}
+			}
+		}
+		// the merged document may use licenses of the most recent list
+		if newerLicenseList(doc.CreationInfo.LicenseListVersion, info.LicenseListVersion) {
+			info.LicenseListVersion = doc.CreationInfo.LicenseListVersion
+		}
+	}
+	return info
+}
+
+func containsCreator(creators []common.Creator, c common.Creator) bool {
+	for _, other := range creators {
+		if other == c {
+			return true
+		}
+	}
+	return false
+}
+
+// newerLicenseList returns true if License List version a is more recent than b
+func newerLicenseList(a string, b string) bool {
+	if a == "" {
+		return false
+	}
+	if b == "" {
+		return true
+	}
+	partsA, partsB := strings.Split(a, "."), strings.Split(b, ".")
+	for k := 0; k < len(partsA) && k < len(partsB); k++ {
+		na, errA := strconv.Atoi(partsA[k])
+		nb, errB := strconv.Atoi(partsB[k])
+		if errA != nil || errB != nil {
+			return partsA[k] > partsB[k]
+		}
+		if na != nb {
+			return na > nb
+		}
+	}
+	return len(partsA) > len(partsB)
+}
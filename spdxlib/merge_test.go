@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+func purlRef(purl string) []*spdx.PackageExternalReference {
+	return []*spdx.PackageExternalReference{{Category: common.CategoryPackageManager, RefType: common.TypePackageManagerPURL, Locator: purl}}
+}
+
+// componentDocuments returns the SBOM of an application, which depends on a
+// library described by a second SBOM; both include zlib
+func componentDocuments() (*spdx.Document, *spdx.Document) {
+	tools := spdx.ExternalDocumentRef{
+		DocumentRefID: "DocumentRef-tools",
+		URI:           "https://example.com/tools",
+		Checksum:      common.Checksum{Algorithm: common.SHA1, Value: "d6a770ba38583ed4bb4525bd96e50461655d2759"},
+	}
+	app := &spdx.Document{
+		SPDXIdentifier:    "DOCUMENT",
+		DocumentName:      "app",
+		DocumentNamespace: "https://example.com/app",
+		ExternalDocumentReferences: []spdx.ExternalDocumentRef{
+			{DocumentRefID: "DocumentRef-lib", URI: "https://example.com/lib"},
+			tools,
+		},
+		CreationInfo: &spdx.CreationInfo{
+			LicenseListVersion: "3.9",
+			Creators:           []common.Creator{{CreatorType: "Tool", Creator: "builder-1.0"}},
+		},
+		Packages: []*spdx.Package{
+			{PackageName: "app", PackageSPDXIdentifier: "app", PackageLicenseDeclared: "LicenseRef-1", PackageExternalReferences: purlRef("pkg:generic/app@1.0")},
+			{
+				PackageName: "zlib", PackageSPDXIdentifier: "zlib", PackageExternalReferences: purlRef("pkg:generic/zlib@1.3"),
+				Files: []*spdx.File{{FileName: "./inflate.c", FileSPDXIdentifier: "File-1"}},
+			},
+		},
+		Files:         []*spdx.File{{FileName: "./README", FileSPDXIdentifier: "File-2"}},
+		OtherLicenses: []*spdx.OtherLicense{{LicenseIdentifier: "LicenseRef-1", ExtractedText: "app license"}},
+		Relationships: []*spdx.Relationship{
+			rel("DOCUMENT", common.TypeRelationshipDescribe, "app"),
+			rel("app", common.TypeRelationshipDependsOn, "zlib"),
+			{RefA: common.MakeDocElementID("", "app"), RefB: common.MakeDocElementID("lib", "app"), Relationship: common.TypeRelationshipDependsOn},
+		},
+	}
+	lib := &spdx.Document{
+		SPDXIdentifier:             "DOCUMENT",
+		DocumentName:               "lib",
+		DocumentNamespace:          "https://example.com/lib",
+		ExternalDocumentReferences: []spdx.ExternalDocumentRef{tools},
+		CreationInfo: &spdx.CreationInfo{
+			LicenseListVersion: "3.21",
+			Creators: []common.Creator{
+				{CreatorType: "Tool", Creator: "builder-1.0"},
+				{CreatorType: "Organization", Creator: "Lib Authors"},
+			},
+		},
+		Packages: []*spdx.Package{
+			{
+				PackageName: "lib", PackageSPDXIdentifier: "app", PackageLicenseDeclared: "LicenseRef-1 AND MIT",
+				Annotations: []spdx.Annotation{{AnnotationComment: "reviewed"}},
+			},
+			{
+				PackageName: "zlib", PackageSPDXIdentifier: "Package-zlib", PackageExternalReferences: purlRef("pkg:generic/zlib@1.3"),
+				Files: []*spdx.File{
+					{FileName: "./inflate.c", FileSPDXIdentifier: "File-1"},
+					{FileName: "./deflate.c", FileSPDXIdentifier: "File-2", LicenseConcluded: "LicenseRef-1"},
+				},
+			},
+		},
+		OtherLicenses: []*spdx.OtherLicense{{LicenseIdentifier: "LicenseRef-1", ExtractedText: "lib license"}},
+		Relationships: []*spdx.Relationship{
+			rel("DOCUMENT", common.TypeRelationshipDescribe, "app"),
+			rel("app", common.TypeRelationshipDependsOn, "Package-zlib"),
+			{RefA: common.MakeDocElementID("", "app"), RefB: common.MakeDocElementID("tools", "gcc"), Relationship: common.TypeRelationshipBuildToolOf},
+		},
+		Annotations: []*spdx.Annotation{{AnnotationSPDXIdentifier: common.MakeDocElementID("", "app"), AnnotationComment: "lib annotation"}},
+	}
+	return app, lib
+}
+
+func Test_Merge(t *testing.T) {
+	app, lib := componentDocuments()
+	result, err := Merge(MergeOptions{
+		DocumentName:      "product",
+		DocumentNamespace: "https://example.com/product",
+		Created:           "2024-03-01T10:00:00Z",
+	}, app, lib)
+	require.NoError(t, err)
+	doc := result.Document
+
+	require.Equal(t, spdx.Version, doc.SPDXVersion)
+	require.Equal(t, common.ElementID("DOCUMENT"), doc.SPDXIdentifier)
+	require.Equal(t, "https://example.com/product", doc.DocumentNamespace)
+	require.Equal(t, &spdx.CreationInfo{
+		LicenseListVersion: "3.21",
+		Creators: []common.Creator{
+			{CreatorType: "Tool", Creator: "builder-1.0"},
+			{CreatorType: "Organization", Creator: "Lib Authors"},
+		},
+		Created: "2024-03-01T10:00:00Z",
+	}, doc.CreationInfo)
+
+	require.Equal(t, []map[common.ElementID]common.ElementID{
+		{"DOCUMENT": "DOCUMENT", "app": "app", "zlib": "zlib", "File-1": "File-1", "File-2": "File-2"},
+		{"DOCUMENT": "DOCUMENT", "app": "app-2", "Package-zlib": "zlib", "File-1": "File-1", "File-2": "File-2-2"},
+	}, result.IDs)
+	require.Equal(t, []map[string]string{
+		{"LicenseRef-1": "LicenseRef-1"},
+		{"LicenseRef-1": "LicenseRef-1-2"},
+	}, result.LicenseIDs)
+
+	// zlib is in both documents and merged, with the files of both
+	require.Len(t, doc.Packages, 3)
+	require.Equal(t, common.ElementID("zlib"), doc.Packages[1].PackageSPDXIdentifier)
+	require.Len(t, doc.Packages[1].Files, 2)
+	require.Equal(t, common.ElementID("File-2-2"), doc.Packages[1].Files[1].FileSPDXIdentifier)
+	require.Equal(t, "LicenseRef-1-2", doc.Packages[1].Files[1].LicenseConcluded)
+	require.Equal(t, "lib", doc.Packages[2].PackageName)
+	require.Equal(t, common.ElementID("app-2"), doc.Packages[2].PackageSPDXIdentifier)
+	require.Equal(t, "LicenseRef-1-2 AND MIT", doc.Packages[2].PackageLicenseDeclared)
+	require.Equal(t, "LicenseRef-1", doc.Packages[0].PackageLicenseDeclared)
+	require.Len(t, doc.Files, 1)
+
+	require.Equal(t, []*spdx.OtherLicense{
+		{LicenseIdentifier: "LicenseRef-1", ExtractedText: "app license"},
+		{LicenseIdentifier: "LicenseRef-1-2", ExtractedText: "lib license"},
+	}, doc.OtherLicenses)
+
+	// the reference to the lib document is now internal; tools is referenced once
+	require.Equal(t, app.ExternalDocumentReferences[1:], doc.ExternalDocumentReferences)
+	require.Equal(t, []*spdx.Relationship{
+		rel("DOCUMENT", common.TypeRelationshipDescribe, "app"),
+		rel("app", common.TypeRelationshipDependsOn, "zlib"),
+		rel("app", common.TypeRelationshipDependsOn, "app-2"),
+		rel("DOCUMENT", common.TypeRelationshipDescribe, "app-2"),
+		rel("app-2", common.TypeRelationshipDependsOn, "zlib"),
+		{RefA: common.MakeDocElementID("", "app-2"), RefB: common.MakeDocElementID("tools", "gcc"), Relationship: common.TypeRelationshipBuildToolOf},
+	}, doc.Relationships)
+	require.Equal(t, common.MakeDocElementID("", "app-2"), doc.Annotations[0].AnnotationSPDXIdentifier)
+
+	require.Equal(t, []common.ElementID{"zlib", "app-2"}, NewGraph(doc).TransitiveDependencies("app"))
+
+	// the inputs are left unchanged
+	original, _ := componentDocuments()
+	require.Equal(t, original, app)
+}
+
+func Test_MergeIdenticalLicensesAndChecksums(t *testing.T) {
+	a := &spdx.Document{
+		DocumentNamespace: "https://example.com/a",
+		Packages: []*spdx.Package{
+			{PackageSPDXIdentifier: "Package", PackageChecksums: []common.Checksum{{Algorithm: common.SHA256, Value: "ABCD"}}},
+		},
+		OtherLicenses: []*spdx.OtherLicense{{LicenseIdentifier: "LicenseRef-1", ExtractedText: "text"}},
+	}
+	b := &spdx.Document{
+		DocumentNamespace: "https://example.com/b",
+		Packages: []*spdx.Package{
+			{PackageSPDXIdentifier: "Package", PackageChecksums: []common.Checksum{{Algorithm: common.SHA256, Value: "abcd"}}, PackageLicenseConcluded: "LicenseRef-1"},
+			{PackageSPDXIdentifier: "Other", PackageChecksums: []common.Checksum{{Algorithm: common.SHA1, Value: "abcd"}}},
+		},
+		OtherLicenses: []*spdx.OtherLicense{{LicenseIdentifier: "LicenseRef-1", ExtractedText: "text"}},
+	}
+
+	result, err := Merge(MergeOptions{DocumentName: "merged", DocumentNamespace: "https://example.com/merged"}, a, b)
+	require.NoError(t, err)
+	require.Len(t, result.Document.Packages, 2)
+	require.Len(t, result.Document.OtherLicenses, 1)
+	require.Equal(t, common.ElementID("Package"), result.IDs[1]["Package"])
+	require.Equal(t, "LicenseRef-1", result.LicenseIDs[1]["LicenseRef-1"])
+	require.NotEmpty(t, result.Document.CreationInfo.Created)
+}
+
+func Test_MergeErrors(t *testing.T) {
+	_, err := Merge(MergeOptions{DocumentName: "merged"}, &spdx.Document{})
+	require.Error(t, err)
+
+	_, err = Merge(MergeOptions{DocumentName: "merged", DocumentNamespace: "https://example.com/merged"}, &spdx.Document{}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// canonicalTypes maps each relationship type that has an inverse to its
+// canonical inverse. Of each pair, the type listed first in the specification
+// is canonical, which agrees with the JSON reader folding CONTAINED_BY into
+// CONTAINS and DESCRIBED_BY into DESCRIBES.
+var canonicalTypes = map[string]string{
+	common.TypeRelationshipDescribeBy:      common.TypeRelationshipDescribe,
+	common.TypeRelationshipContainedBy:     common.TypeRelationshipContains,
+	common.TypeRelationshipDependencyOf:    common.TypeRelationshipDependsOn,
+	common.TypeRelationshipGeneratedFrom:   common.TypeRelationshipGenerates,
+	common.TypeRelationshipDescendantOf:    common.TypeRelationshipAncestorOf,
+	common.TypeRelationshipHasPrerequisite: common.TypeRelationshipPrerequisiteFor,
+}
+
+// InverseRelationship returns the type that states the same relationship with
+// RefA and RefB swapped, e.g. DEPENDENCY_OF for DEPENDS_ON. It returns false
+// for types without an inverse.
+func InverseRelationship(relationship string) (string, bool) {
+	if canonical, ok := canonicalTypes[relationship]; ok {
+		return canonical, true
+	}
+	for inverse, canonical := range canonicalTypes {
+		if canonical == relationship {
+			return inverse, true
+		}
+	}
+	return "", false
+}
+
+// CanonicalRelationship returns a copy of the relationship in canonical
+// direction: "B DEPENDENCY_OF A" becomes "A DEPENDS_ON B". Relationships that
+// are already canonical, or whose type has no inverse, are copied unchanged,
+// and so are relationships to NONE or NOASSERTION, which cannot be RefA.
+func CanonicalRelationship(r *spdx.Relationship) *spdx.Relationship {
+	c := *r
+	if canonical, ok := canonicalTypes[r.Relationship]; ok && r.RefB.SpecialID == "" {
+		c.RefA, c.RefB, c.Relationship = r.RefB, r.RefA, canonical
+	}
+	return &c
+}
+
+// NormalizeRelationships rewrites every relationship of the document into
+// canonical direction and merges duplicates, keeping the first occurrence of
+// each relationship in place. The comments of merged relationships are kept,
+// one per line. It returns the number of relationships removed as duplicates.
+func NormalizeRelationships(doc *spdx.Document) int {
+	var relationships []*spdx.Relationship
+	seen := map[string]*spdx.Relationship{}
+	removed := 0
+
+	for _, r := range doc.Relationships {
+		if r == nil {
+			continue
+		}
+		c := CanonicalRelationship(r)
+		key := relationshipKey(c)
+		first, ok := seen[key]
+		if !ok {
+			seen[key] = c
+			relationships = append(relationships, c)
+			continue
+		}
+		removed++
+		first.RelationshipComment = mergeComments(first.RelationshipComment, c.RelationshipComment)
+	}
+
+	doc.Relationships = relationships
+	return removed
+}
+
+// relationshipKey identifies a relationship regardless of its comment
+func relationshipKey(r *spdx.Relationship) string {
+	return fmt.Sprintf("%v-%v->%v", common.RenderDocElementID(r.RefA), r.Relationship, common.RenderDocElementID(r.RefB))
+}
+
+// mergeComments appends comment to comments unless it is empty or already one of its lines
+func mergeComments(comments string, comment string) string {
+	if comment == "" {
+		return comments
+	}
+	if comments == "" {
+		return comment
+	}
+	for _, line := range strings.Split(comments, "\n") {
+		if line == comment {
+			return comments
+		}
+	}
+	return comments + "\n" + comment
+}
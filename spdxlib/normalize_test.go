@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+)
+
+func Test_InverseRelationship(t *testing.T) {
+	for a, b := range map[string]string{
+		common.TypeRelationshipDependsOn:       common.TypeRelationshipDependencyOf,
+		common.TypeRelationshipGeneratedFrom:   common.TypeRelationshipGenerates,
+		common.TypeRelationshipContainedBy:     common.TypeRelationshipContains,
+		common.TypeRelationshipDescribe:        common.TypeRelationshipDescribeBy,
+		common.TypeRelationshipAncestorOf:      common.TypeRelationshipDescendantOf,
+		common.TypeRelationshipHasPrerequisite: common.TypeRelationshipPrerequisiteFor,
+	} {
+		inverse, ok := InverseRelationship(a)
+		require.True(t, ok, a)
+		require.Equal(t, b, inverse)
+		inverse, ok = InverseRelationship(b)
+		require.True(t, ok, b)
+		require.Equal(t, a, inverse)
+	}
+
+	_, ok := InverseRelationship(common.TypeRelationshipBuildDependencyOf)
+	require.False(t, ok)
+}
+
+func Test_CanonicalRelationship(t *testing.T) {
+	r := rel("lib", common.TypeRelationshipDependencyOf, "app")
+	r.RelationshipComment = "comment"
+	require.Equal(t, &spdx.Relationship{
+		RefA:                common.MakeDocElementID("", "app"),
+		RefB:                common.MakeDocElementID("", "lib"),
+		Relationship:        common.TypeRelationshipDependsOn,
+		RelationshipComment: "comment",
+	}, CanonicalRelationship(r))
+	// the input is not modified
+	require.Equal(t, common.TypeRelationshipDependencyOf, r.Relationship)
+
+	r = rel("app", common.TypeRelationshipRuntimeDependencyOf, "lib")
+	require.Equal(t, r, CanonicalRelationship(r))
+}
+
+func Test_NormalizeRelationships(t *testing.T) {
+	doc := &spdx.Document{
+		Relationships: []*spdx.Relationship{
+			rel("DOCUMENT", common.TypeRelationshipDescribe, "app"),
+			{RefA: common.MakeDocElementID("", "app"), RefB: common.MakeDocElementID("", "lib"), Relationship: common.TypeRelationshipDependsOn, RelationshipComment: "from the lock file"},
+			rel("app", common.TypeRelationshipDescribeBy, "DOCUMENT"),
+			{RefA: common.MakeDocElementID("", "lib"), RefB: common.MakeDocElementID("", "app"), Relationship: common.TypeRelationshipDependencyOf, RelationshipComment: "from the manifest"},
+			rel("binary", common.TypeRelationshipGeneratedFrom, "source"),
+			{RefA: common.MakeDocElementID("", "app"), RefB: common.MakeDocElementID("", "lib"), Relationship: common.TypeRelationshipDependsOn, RelationshipComment: "from the lock file"},
+			nil,
+			{RefA: common.MakeDocElementID("", "app"), RefB: common.MakeDocElementID("ext", "lib"), Relationship: common.TypeRelationshipDependsOn},
+		},
+	}
+
+	require.Equal(t, 3, NormalizeRelationships(doc))
+	require.Equal(t, []*spdx.Relationship{
+		rel("DOCUMENT", common.TypeRelationshipDescribe, "app"),
+		{RefA: common.MakeDocElementID("", "app"), RefB: common.MakeDocElementID("", "lib"), Relationship: common.TypeRelationshipDependsOn, RelationshipComment: "from the lock file\nfrom the manifest"},
+		rel("source", common.TypeRelationshipGenerates, "binary"),
+		{RefA: common.MakeDocElementID("", "app"), RefB: common.MakeDocElementID("ext", "lib"), Relationship: common.TypeRelationshipDependsOn},
+	}, doc.Relationships)
+
+	// normalizing twice changes nothing
+	require.Equal(t, 0, NormalizeRelationships(doc))
+	require.Len(t, doc.Relationships, 4)
+}
+
+func Test_NormalizeExample(t *testing.T) {
+	doc := example.Copy()
+	require.Equal(t, 0, NormalizeRelationships(&doc))
+	require.Len(t, doc.Relationships, 9)
+
+	// a relationship to NOASSERTION keeps its direction
+	require.Equal(t, rel("File", common.TypeRelationshipGeneratedFrom, "fromDoap-0"), example.Copy().Relationships[8])
+	require.Equal(t, rel("fromDoap-0", common.TypeRelationshipGenerates, "File"), doc.Relationships[8])
+	require.Equal(t, common.TypeRelationshipGeneratedFrom, doc.Relationships[6].Relationship)
+	require.Equal(t, common.MakeDocElementSpecial("NOASSERTION"), doc.Relationships[6].RefB)
+}
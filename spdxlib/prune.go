@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// PruneOptions selects the rules Prune applies. Dangling relationships and
+// unused licenses are removed unless kept; orphan files are kept unless
+// removed.
+type PruneOptions struct {
+	// KeepDanglingRelationships keeps relationships to elements that are not
+	// defined in the document, or to documents that are not referenced by an
+	// ExternalDocumentRef
+	KeepDanglingRelationships bool
+
+	// KeepUnusedLicenses keeps OtherLicenses whose LicenseRef- is not used in
+	// any license field
+	KeepUnusedLicenses bool
+
+	// RemoveOrphanFiles removes document-level files that no package CONTAINS
+	// and nothing DESCRIBES, together with their snippets and annotations
+	RemoveOrphanFiles bool
+}
+
+// PruneResult lists everything Prune removed
+type PruneResult struct {
+	Relationships []*spdx.Relationship
+	OtherLicenses []*spdx.OtherLicense
+	Files         []*spdx.File
+	Snippets      []spdx.Snippet
+	Annotations   []*spdx.Annotation
+}
+
+// Removed returns the number of items removed
+func (r *PruneResult) Removed() int {
+	return len(r.Relationships) + len(r.OtherLicenses) + len(r.Files) + len(r.Snippets) + len(r.Annotations)
+}
+
+// String describes the removed items, one per line
+func (r *PruneResult) String() string {
+	var b strings.Builder
+	for _, f := range r.Files {
+		fmt.Fprintf(&b, "removed file %s (%s)\n", common.RenderElementID(f.FileSPDXIdentifier), f.FileName)
+	}
+	for _, s := range r.Snippets {
+		fmt.Fprintf(&b, "removed snippet %s\n", common.RenderElementID(s.SnippetSPDXIdentifier))
+	}
+	for _, a := range r.Annotations {
+		fmt.Fprintf(&b, "removed annotation of %s by %s\n", common.RenderDocElementID(a.AnnotationSPDXIdentifier), a.Annotator.Annotator)
+	}
+	for _, rel := range r.Relationships {
+		fmt.Fprintf(&b, "removed relationship %s %s %s\n", common.RenderDocElementID(rel.RefA), rel.Relationship, common.RenderDocElementID(rel.RefB))
+	}
+	for _, l := range r.OtherLicenses {
+		fmt.Fprintf(&b, "removed license %s\n", l.LicenseIdentifier)
+	}
+	return b.String()
+}
+
+// Prune removes debris that makes a document fail validation, and optionally
+// orphan files, in place. Orphan files are removed first, so that
+// relationships to them are removed as dangling and licenses only they used
+// are removed as unused.
+func Prune(doc *spdx.Document, options PruneOptions) *PruneResult {
+	result := &PruneResult{}
+
+	if options.RemoveOrphanFiles {
+		pruneOrphanFiles(doc, result)
+	}
+	if !options.KeepDanglingRelationships {
+		pruneDanglingRelationships(doc, result)
+	}
+	if !options.KeepUnusedLicenses {
+		pruneUnusedLicenses(doc, result)
+	}
+
+	return result
+}
+
+func pruneOrphanFiles(doc *spdx.Document, result *PruneResult) {
+	idx := NewIndex(doc)
+	orphans := map[common.ElementID]bool{}
+	var files []*spdx.File
+	for _, file := range doc.Files {
+		if file == nil {
+			continue
+		}
+		if e, _ := idx.Element(file.FileSPDXIdentifier); e.File == file && isOrphan(idx, e) {
+			orphans[file.FileSPDXIdentifier] = true
+			result.Files = append(result.Files, file)
+			continue
+		}
+		files = append(files, file)
+	}
+	if len(orphans) == 0 {
+		return
+	}
+	doc.Files = files
+
+	removed := map[common.ElementID]bool{}
+	for id := range orphans {
+		removed[id] = true
+		for _, snippet := range idx.FileSnippets(id) {
+			removed[snippet.SnippetSPDXIdentifier] = true
+		}
+	}
+	var snippets []spdx.Snippet
+	for _, snippet := range doc.Snippets {
+		if removed[snippet.SnippetSPDXIdentifier] {
+			result.Snippets = append(result.Snippets, snippet)
+			continue
+		}
+		snippets = append(snippets, snippet)
+	}
+	doc.Snippets = snippets
+
+	var annotations []*spdx.Annotation
+	for _, a := range doc.Annotations {
+		if a != nil {
+			if id, ok := localID(a.AnnotationSPDXIdentifier); ok && removed[id] {
+				result.Annotations = append(result.Annotations, a)
+				continue
+			}
+		}
+		annotations = append(annotations, a)
+	}
+	doc.Annotations = annotations
+}
+
+// isOrphan returns true if the document-level file is not contained in a
+// package and not described by any element
+func isOrphan(idx *Index, e *Element) bool {
+	if e.InPackage() || len(idx.FileOwners(e.ID)) > 0 {
+		return false
+	}
+	for _, r := range idx.Incoming(e.ID) {
+		if r.Relationship == common.TypeRelationshipDescribe {
+			return false
+		}
+	}
+	for _, r := range idx.Outgoing(e.ID) {
+		if r.Relationship == common.TypeRelationshipDescribeBy {
+			return false
+		}
+	}
+	return true
+}
+
+func pruneDanglingRelationships(doc *spdx.Document, result *PruneResult) {
+	idx := NewIndex(doc)
+	docRefs := map[string]bool{}
+	for _, ref := range doc.ExternalDocumentReferences {
+		docRefs[strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)] = true
+	}
+	exists := func(ref common.DocElementID) bool {
+		switch {
+		case ref.SpecialID != "":
+			return true
+		case ref.DocumentRefID != "":
+			return docRefs[strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)] && ref.ElementRefID != ""
+		}
+		_, ok := idx.Resolve(ref)
+		return ok
+	}
+
+	var relationships []*spdx.Relationship
+	for _, r := range doc.Relationships {
+		if r != nil && (!exists(r.RefA) || !exists(r.RefB)) {
+			result.Relationships = append(result.Relationships, r)
+			continue
+		}
+		relationships = append(relationships, r)
+	}
+	doc.Relationships = relationships
+}
+
+func pruneUnusedLicenses(doc *spdx.Document, result *PruneResult) {
+	used, _ := references(doc)
+	var licenses []*spdx.OtherLicense
+	for _, l := range doc.OtherLicenses {
+		if l != nil && !used[l.LicenseIdentifier] {
+			result.OtherLicenses = append(result.OtherLicenses, l)
+			continue
+		}
+		licenses = append(licenses, l)
+	}
+	doc.OtherLicenses = licenses
+}
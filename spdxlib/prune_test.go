@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+)
+
+func Test_PruneExample(t *testing.T) {
+	doc := example.Copy()
+	result := Prune(&doc, PruneOptions{})
+
+	var removed []string
+	for _, l := range result.OtherLicenses {
+		removed = append(removed, l.LicenseIdentifier)
+	}
+	require.Equal(t, []string{"LicenseRef-4", "LicenseRef-Beerware-4.2"}, removed)
+	require.Empty(t, result.Relationships)
+	require.Equal(t, 2, result.Removed())
+
+	// the source files of the example are neither contained nor described
+	doc = example.Copy()
+	result = Prune(&doc, PruneOptions{RemoveOrphanFiles: true})
+	var files []common.ElementID
+	for _, f := range result.Files {
+		files = append(files, f.FileSPDXIdentifier)
+	}
+	require.Equal(t, []common.ElementID{"DoapSource", "CommonsLangSrc"}, files)
+	require.Len(t, result.Snippets, 1)
+	require.Len(t, result.Relationships, 1)
+	require.NoError(t, Validate(&doc).Err())
+}
+
+func Test_Prune(t *testing.T) {
+	debris := func() spdx.Document {
+		doc := example.Copy()
+		doc.OtherLicenses = []*spdx.OtherLicense{doc.OtherLicenses[0], doc.OtherLicenses[1], doc.OtherLicenses[4]}
+		doc.Relationships = append(doc.Relationships,
+			rel("Package", common.TypeRelationshipContains, "DoapSource"),
+			rel("Package", common.TypeRelationshipContains, "CommonsLangSrc"),
+			rel("Package", common.TypeRelationshipDependsOn, "missing"),
+			&spdx.Relationship{
+				RefA:         common.MakeDocElementID("", "Package"),
+				RefB:         common.MakeDocElementID("undeclared", "lib"),
+				Relationship: common.TypeRelationshipDependsOn,
+			},
+		)
+		doc.OtherLicenses = append(doc.OtherLicenses, &spdx.OtherLicense{
+			LicenseIdentifier: "LicenseRef-orphan",
+			ExtractedText:     "orphan",
+		})
+		doc.Files = append(doc.Files, &spdx.File{
+			FileName:           "./orphan.c",
+			FileSPDXIdentifier: "orphan",
+			Checksums:          []common.Checksum{{Algorithm: common.SHA1, Value: "d6a770ba38583ed4bb4525bd96e50461655d2758"}},
+			LicenseConcluded:   "LicenseRef-orphan",
+			FileCopyrightText:  "NOASSERTION",
+		})
+		doc.Snippets = append(doc.Snippets, spdx.Snippet{
+			SnippetSPDXIdentifier:         "orphan-snippet",
+			SnippetFromFileSPDXIdentifier: "orphan",
+			SnippetLicenseConcluded:       "NOASSERTION",
+			SnippetCopyrightText:          "NOASSERTION",
+			Ranges: []common.SnippetRange{{
+				StartPointer: common.SnippetRangePointer{Offset: 1, FileSPDXIdentifier: "orphan"},
+				EndPointer:   common.SnippetRangePointer{Offset: 2, FileSPDXIdentifier: "orphan"},
+			}},
+		})
+		doc.Relationships = append(doc.Relationships, rel("orphan", common.TypeRelationshipGeneratedFrom, "Package"))
+		doc.Annotations = append(doc.Annotations, &spdx.Annotation{
+			Annotator:                common.Annotator{Annotator: "Jane Doe", AnnotatorType: "Person"},
+			AnnotationDate:           "2010-01-29T18:30:22Z",
+			AnnotationType:           "OTHER",
+			AnnotationSPDXIdentifier: common.MakeDocElementID("", "orphan-snippet"),
+			AnnotationComment:        "orphan",
+		})
+		return doc
+	}
+
+	doc := debris()
+	require.True(t, Validate(&doc).HasErrors())
+	result := Prune(&doc, PruneOptions{})
+	require.Len(t, result.Relationships, 2)
+	require.Empty(t, result.Files)
+	require.Len(t, result.OtherLicenses, 0)
+	require.Len(t, doc.Files, 5)
+	require.NoError(t, Validate(&doc).Err())
+
+	doc = debris()
+	result = Prune(&doc, PruneOptions{RemoveOrphanFiles: true})
+	require.Len(t, result.Files, 1)
+	require.Equal(t, common.ElementID("orphan"), result.Files[0].FileSPDXIdentifier)
+	require.Len(t, result.Snippets, 1)
+	require.Len(t, result.Annotations, 1)
+	require.Len(t, result.Relationships, 3)
+	require.Len(t, result.OtherLicenses, 1)
+	require.Equal(t, "LicenseRef-orphan", result.OtherLicenses[0].LicenseIdentifier)
+	require.Equal(t, 7, result.Removed())
+	require.Equal(t, `removed file SPDXRef-orphan (./orphan.c)
+removed snippet SPDXRef-orphan-snippet
+removed annotation of SPDXRef-orphan-snippet by Jane Doe
+removed relationship SPDXRef-Package DEPENDS_ON SPDXRef-missing
+removed relationship SPDXRef-Package DEPENDS_ON DocumentRef-undeclared:SPDXRef-lib
+removed relationship SPDXRef-orphan GENERATED_FROM SPDXRef-Package
+removed license LicenseRef-orphan
+`, result.String())
+	require.Len(t, doc.Files, 4)
+	require.Len(t, doc.Snippets, 1)
+	require.NoError(t, Validate(&doc).Err())
+
+	doc = debris()
+	result = Prune(&doc, PruneOptions{KeepDanglingRelationships: true, KeepUnusedLicenses: true})
+	require.Zero(t, result.Removed())
+	require.Equal(t, debris(), doc)
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/rdf"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+// the RDF reader returns the document ID, relationship and file types in the
+// form of the other formats
+func readRDF(t *testing.T) *spdx.Document {
+	f, err := os.Open("../examples/sample-docs/rdf/SPDXRdfExample-v2.2.spdx.rdf")
+	require.NoError(t, err)
+	defer f.Close()
+
+	doc, err := rdf.Read(f)
+	require.NoError(t, err)
+	require.Equal(t, common.ElementID("DOCUMENT"), doc.SPDXIdentifier)
+	return doc
+}
+
+func Test_RewriteIDsRDF(t *testing.T) {
+	doc := readRDF(t)
+
+	err := spdxlib.RewriteIDs(doc, func(id common.ElementID) common.ElementID {
+		return "X" + id
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, common.ElementID("DOCUMENT"), doc.SPDXIdentifier)
+	for _, r := range doc.Relationships {
+		if r.RefA.ElementRefID != "DOCUMENT" {
+			require.Regexp(t, "^X", r.RefA.ElementRefID)
+		}
+		if r.RefB.DocumentRefID == "" {
+			require.Regexp(t, "^X", r.RefB.ElementRefID)
+		}
+	}
+	var targets []common.ElementID
+	for _, a := range doc.Annotations {
+		targets = append(targets, a.AnnotationSPDXIdentifier.ElementRefID)
+	}
+	require.ElementsMatch(t, []common.ElementID{"DOCUMENT", "XFile", "XPackage"}, targets)
+}
+
+func Test_PruneRDF(t *testing.T) {
+	doc := readRDF(t)
+	relationships := len(doc.Relationships)
+	annotations := len(doc.Annotations)
+
+	result := spdxlib.Prune(doc, spdxlib.PruneOptions{RemoveOrphanFiles: true})
+	require.Empty(t, result.Files)
+	require.Empty(t, result.Annotations)
+	require.Empty(t, result.Relationships)
+	require.Len(t, doc.Files, 1)
+	require.Len(t, doc.Relationships, relationships)
+	require.Len(t, doc.Annotations, annotations)
+}
+
+func Test_ValidateRDF(t *testing.T) {
+	doc := readRDF(t)
+
+	findings := spdxlib.Validate(doc)
+	require.False(t, findings.HasErrors(), findings.Err())
+}
@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// licenseRefPattern matches the license references of a license expression
+var licenseRefPattern = regexp.MustCompile(`(DocumentRef-[A-Za-z0-9.\-]+:)?LicenseRef-[A-Za-z0-9.\-]+`)
+
+// rewriter rewrites every reference to an element or a license in a document
+type rewriter struct {
+	// element returns the new reference for a reference to an element
+	element func(ref common.DocElementID) common.DocElementID
+
+	// licenseRef returns the new reference for a license reference as written
+	// in a license expression, e.g. "LicenseRef-1" or "DocumentRef-x:LicenseRef-1"
+	licenseRef func(ref string) string
+}
+
+// RewriteIDs renames the elements of the document: mapping returns the new ID
+// of each package, file and snippet, given its current ID, and every reference
+// to the element is updated with it, including relationships, annotations,
+// snippet ranges and the keys of File.Snippets. The ID of the document itself
+// and references to elements of other documents are kept. The new IDs are
+// checked before the document is changed, so the document is left unchanged
+// if mapping gives an invalid ID or the same ID to two elements.
+func RewriteIDs(doc *spdx.Document, mapping func(id common.ElementID) common.ElementID) error {
+	idx := NewIndex(doc)
+	documentID := idx.IDs()[0]
+
+	renamed := map[common.ElementID]common.ElementID{}
+	owners := map[common.ElementID]common.ElementID{documentID: documentID}
+	for _, id := range idx.IDs()[1:] {
+		newID := mapping(id)
+		if !idString.MatchString(string(newID)) {
+			return fmt.Errorf("invalid new identifier %q for %s", common.RenderElementID(newID), common.RenderElementID(id))
+		}
+		if other, ok := owners[newID]; ok {
+			return fmt.Errorf("%s and %s are both renamed to %s", common.RenderElementID(other), common.RenderElementID(id), common.RenderElementID(newID))
+		}
+		owners[newID] = id
+		renamed[id] = newID
+	}
+
+	rw := &rewriter{
+		element: func(ref common.DocElementID) common.DocElementID {
+			if ref.DocumentRefID != "" || ref.ElementRefID == documentID {
+				return ref
+			}
+			if newID, ok := renamed[ref.ElementRefID]; ok {
+				ref.ElementRefID = newID
+			} else {
+				// a reference to an element that is not defined is kept
+				// consistent with the elements that are
+				ref.ElementRefID = mapping(ref.ElementRefID)
+			}
+			return ref
+		},
+	}
+	rw.rewrite(doc)
+	return nil
+}
+
+// id rewrites the ID of an element of the document
+func (rw *rewriter) id(id common.ElementID) common.ElementID {
+	if rw.element == nil || id == "" {
+		return id
+	}
+	return rw.element(common.DocElementID{ElementRefID: id}).ElementRefID
+}
+
+func (rw *rewriter) ref(ref common.DocElementID) common.DocElementID {
+	if rw.element == nil || ref.SpecialID != "" || ref.ElementRefID == "" {
+		return ref
+	}
+	return rw.element(ref)
+}
+
+func (rw *rewriter) expression(expression string) string {
+	if rw.licenseRef == nil {
+		return expression
+	}
+	return licenseRefPattern.ReplaceAllStringFunc(expression, rw.licenseRef)
+}
+
+func (rw *rewriter) expressions(expressions []string) {
+	for i, e := range expressions {
+		expressions[i] = rw.expression(e)
+	}
+}
+
+// rewrite rewrites the document in place. A file listed both in a package and
+// at document level, or a snippet listed both in its file and at document
+// level, is rewritten once.
+func (rw *rewriter) rewrite(doc *spdx.Document) {
+	doc.SPDXIdentifier = rw.id(doc.SPDXIdentifier)
+
+	files := map[*spdx.File]bool{}
+	snippets := map[*spdx.Snippet]bool{}
+	rewriteFile := func(file *spdx.File) {
+		if file == nil || files[file] {
+			return
+		}
+		files[file] = true
+		file.FileSPDXIdentifier = rw.id(file.FileSPDXIdentifier)
+		file.LicenseConcluded = rw.expression(file.LicenseConcluded)
+		rw.expressions(file.LicenseInfoInFiles)
+		rw.annotations(file.Annotations)
+		if len(file.Snippets) > 0 {
+			rewritten := map[common.ElementID]*spdx.Snippet{}
+			for _, snippet := range file.Snippets {
+				if snippet == nil {
+					continue
+				}
+				rw.snippet(snippet, snippets)
+				rewritten[snippet.SnippetSPDXIdentifier] = snippet
+			}
+			file.Snippets = rewritten
+		}
+	}
+
+	for _, pkg := range doc.Packages {
+		if pkg == nil {
+			continue
+		}
+		pkg.PackageSPDXIdentifier = rw.id(pkg.PackageSPDXIdentifier)
+		pkg.PackageLicenseConcluded = rw.expression(pkg.PackageLicenseConcluded)
+		pkg.PackageLicenseDeclared = rw.expression(pkg.PackageLicenseDeclared)
+		rw.expressions(pkg.PackageLicenseInfoFromFiles)
+		rw.annotations(pkg.Annotations)
+		for _, file := range pkg.Files {
+			rewriteFile(file)
+		}
+	}
+	for _, file := range doc.Files {
+		rewriteFile(file)
+	}
+	for i := range doc.Snippets {
+		rw.snippet(&doc.Snippets[i], snippets)
+	}
+
+	for _, l := range doc.OtherLicenses {
+		if l != nil {
+			l.LicenseIdentifier = rw.expression(l.LicenseIdentifier)
+		}
+	}
+	for _, r := range doc.Relationships {
+		if r != nil {
+			r.RefA = rw.ref(r.RefA)
+			r.RefB = rw.ref(r.RefB)
+		}
+	}
+	for _, a := range doc.Annotations {
+		if a != nil {
+			a.AnnotationSPDXIdentifier = rw.ref(a.AnnotationSPDXIdentifier)
+		}
+	}
+}
+
+// annotations rewrites the annotations of a package or a file
+func (rw *rewriter) annotations(annotations []spdx.Annotation) {
+	for i := range annotations {
+		annotations[i].AnnotationSPDXIdentifier = rw.ref(annotations[i].AnnotationSPDXIdentifier)
+	}
+}
+
+func (rw *rewriter) snippet(snippet *spdx.Snippet, done map[*spdx.Snippet]bool) {
+	if done[snippet] {
+		return
+	}
+	done[snippet] = true
+	snippet.SnippetSPDXIdentifier = rw.id(snippet.SnippetSPDXIdentifier)
+	snippet.SnippetFromFileSPDXIdentifier = rw.id(snippet.SnippetFromFileSPDXIdentifier)
+	// the ranges may be shared with a copy of the snippet, which is rewritten separately
+	snippet.Ranges = append([]common.SnippetRange(nil), snippet.Ranges...)
+	for i := range snippet.Ranges {
+		r := &snippet.Ranges[i]
+		r.StartPointer.FileSPDXIdentifier = rw.id(r.StartPointer.FileSPDXIdentifier)
+		r.EndPointer.FileSPDXIdentifier = rw.id(r.EndPointer.FileSPDXIdentifier)
+	}
+	snippet.SnippetLicenseConcluded = rw.expression(snippet.SnippetLicenseConcluded)
+	rw.expressions(snippet.LicenseInfoInSnippet)
+}
@@ -0,0 +1,1040 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spdx/tools-golang/convert"
+	"github.com/spdx/tools-golang/licensing"
+	"github.com/spdx/tools-golang/licensing/licenselist"
+	"github.com/spdx/tools-golang/spdx"
+	spdxcommon "github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+// Severity is how serious a validation Finding is
+type Severity int
+
+const (
+	// SeverityError means the document does not conform to the specification
+	SeverityError Severity = iota
+
+	// SeverityWarning means the document conforms to the specification, but
+	// contains something that is likely a mistake, such as an unknown license ID
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return fmt.Sprintf("severity(%d)", int(s))
+}
+
+// Finding is a single problem found by Validate
+type Finding struct {
+	Severity Severity
+
+	// ElementID is the identifier of the element the finding is about, as it
+	// appears in a document, e.g. "SPDXRef-DOCUMENT", "SPDXRef-Package" or
+	// "LicenseRef-1"
+	ElementID string
+
+	// Field is the name of the struct field holding the problem, e.g. "PackageDownloadLocation"
+	Field string
+
+	// Section is the section of the specification that defines the field, e.g. "7.7"
+	Section string
+
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s %s (section %s): %s", f.Severity, f.ElementID, f.Field, f.Section, f.Message)
+}
+
+// Findings is the list of findings of Validate, in document order
+type Findings []Finding
+
+// Errors returns the findings with SeverityError
+func (f Findings) Errors() Findings {
+	return f.filter(SeverityError)
+}
+
+// Warnings returns the findings with SeverityWarning
+func (f Findings) Warnings() Findings {
+	return f.filter(SeverityWarning)
+}
+
+// HasErrors returns true if the document does not conform to the specification
+func (f Findings) HasErrors() bool {
+	return len(f.Errors()) > 0
+}
+
+// Err returns an error listing every SeverityError finding, or nil if there are none
+func (f Findings) Err() error {
+	errs := f.Errors()
+	if len(errs) == 0 {
+		return nil
+	}
+	lines := make([]string, 0, len(errs))
+	for _, finding := range errs {
+		lines = append(lines, finding.String())
+	}
+	return fmt.Errorf("document is invalid:\n%s", strings.Join(lines, "\n"))
+}
+
+func (f Findings) filter(severity Severity) Findings {
+	var out Findings
+	for _, finding := range f {
+		if finding.Severity == severity {
+			out = append(out, finding)
+		}
+	}
+	return out
+}
+
+// sections maps field names to the sections of the SPDX 2.3 specification;
+// rules.section maps them to the sections of the other versions
+var sections = map[string]string{
+	"SPDXVersion":                   "6.1",
+	"DataLicense":                   "6.2",
+	"SPDXIdentifier":                "6.3",
+	"DocumentName":                  "6.4",
+	"DocumentNamespace":             "6.5",
+	"ExternalDocumentReferences":    "6.6",
+	"LicenseListVersion":            "6.7",
+	"Creators":                      "6.8",
+	"Created":                       "6.9",
+	"PackageName":                   "7.1",
+	"PackageSPDXIdentifier":         "7.2",
+	"PackageVersion":                "7.3",
+	"PackageFileName":               "7.4",
+	"PackageSupplier":               "7.5",
+	"PackageOriginator":             "7.6",
+	"PackageDownloadLocation":       "7.7",
+	"FilesAnalyzed":                 "7.8",
+	"PackageVerificationCode":       "7.9",
+	"PackageChecksums":              "7.10",
+	"PackageHomePage":               "7.11",
+	"PackageLicenseConcluded":       "7.13",
+	"PackageLicenseInfoFromFiles":   "7.14",
+	"PackageLicenseDeclared":        "7.15",
+	"PackageCopyrightText":          "7.17",
+	"PackageExternalReferences":     "7.21",
+	"PackageAttributionTexts":       "7.23",
+	"PrimaryPackagePurpose":         "7.24",
+	"ReleaseDate":                   "7.25",
+	"BuiltDate":                     "7.26",
+	"ValidUntilDate":                "7.27",
+	"FileName":                      "8.1",
+	"FileSPDXIdentifier":            "8.2",
+	"FileTypes":                     "8.3",
+	"Checksums":                     "8.4",
+	"LicenseConcluded":              "8.5",
+	"LicenseInfoInFiles":            "8.6",
+	"FileCopyrightText":             "8.8",
+	"FileAttributionTexts":          "8.15",
+	"SnippetSPDXIdentifier":         "9.1",
+	"SnippetFromFileSPDXIdentifier": "9.2",
+	"Ranges":                        "9.3",
+	"SnippetLicenseConcluded":       "9.5",
+	"LicenseInfoInSnippet":          "9.6",
+	"SnippetCopyrightText":          "9.8",
+	"SnippetAttributionTexts":       "9.11",
+	"LicenseIdentifier":             "10.1",
+	"ExtractedText":                 "10.2",
+	"Relationship":                  "11.1",
+	"RefA":                          "11.1",
+	"RefB":                          "11.1",
+	"Annotator":                     "12.1",
+	"AnnotationDate":                "12.2",
+	"AnnotationType":                "12.3",
+	"AnnotationSPDXIdentifier":      "12.4",
+	"AnnotationComment":             "12.5",
+	"Reviewer":                      "13.1",
+	"ReviewDate":                    "13.2",
+}
+
+const (
+	spdxRefPrefix     = "SPDXRef-"
+	documentRefPrefix = "DocumentRef-"
+	licenseRefPrefix  = "LicenseRef-"
+	noAssertion       = "NOASSERTION"
+	none              = "NONE"
+	dateFormat        = "2006-01-02T15:04:05Z"
+)
+
+var (
+	idString           = regexp.MustCompile(`^[A-Za-z0-9.\-]+$`)
+	licenseListVersion = regexp.MustCompile(`^[0-9]+\.[0-9]+$`)
+	hexString          = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+)
+
+// checksumLengths is the number of hex digits of each checksum algorithm;
+// zero means the length is variable
+var checksumLengths = map[common.ChecksumAlgorithm]int{
+	common.SHA1:        40,
+	common.SHA224:      56,
+	common.SHA256:      64,
+	common.SHA384:      96,
+	common.SHA512:      128,
+	common.MD2:         32,
+	common.MD4:         32,
+	common.MD5:         32,
+	common.MD6:         0,
+	common.SHA3_256:    64,
+	common.SHA3_384:    96,
+	common.SHA3_512:    128,
+	common.BLAKE2b_256: 64,
+	common.BLAKE2b_384: 96,
+	common.BLAKE2b_512: 128,
+	common.BLAKE3:      0,
+	common.ADLER32:     8,
+}
+
+var agentTypes = []string{"Person", "Organization", "Tool"}
+
+var annotationTypes = []string{"REVIEW", "OTHER"}
+
+var packagePurposes = []string{
+	"APPLICATION", "FRAMEWORK", "LIBRARY", "CONTAINER", "OPERATING-SYSTEM", "DEVICE",
+	"FIRMWARE", "SOURCE", "ARCHIVE", "FILE", "INSTALL", "OTHER",
+}
+
+var fileTypes = []string{
+	"SOURCE", "BINARY", "ARCHIVE", "APPLICATION", "AUDIO", "IMAGE", "TEXT", "VIDEO",
+	"DOCUMENTATION", "SPDX", "OTHER",
+}
+
+// relationshipTypes are the relationship types of SPDX 2.1 and 2.2
+var relationshipTypes = []string{
+	common.TypeRelationshipDescribe,
+	common.TypeRelationshipDescribeBy,
+	common.TypeRelationshipContains,
+	common.TypeRelationshipContainedBy,
+	common.TypeRelationshipDependsOn,
+	common.TypeRelationshipDependencyOf,
+	"DEPENDENCY_MANIFEST_OF",
+	common.TypeRelationshipBuildDependencyOf,
+	common.TypeRelationshipDevDependencyOf,
+	common.TypeRelationshipOptionalDependencyOf,
+	common.TypeRelationshipProvidedDependencyOf,
+	common.TypeRelationshipTestDependencyOf,
+	common.TypeRelationshipRuntimeDependencyOf,
+	common.TypeRelationshipExampleOf,
+	common.TypeRelationshipGenerates,
+	common.TypeRelationshipGeneratedFrom,
+	common.TypeRelationshipAncestorOf,
+	common.TypeRelationshipDescendantOf,
+	common.TypeRelationshipVariantOf,
+	common.TypeRelationshipDistributionArtifact,
+	common.TypeRelationshipPatchFor,
+	common.TypeRelationshipPatchApplied,
+	common.TypeRelationshipCopyOf,
+	common.TypeRelationshipFileAdded,
+	common.TypeRelationshipFileDeleted,
+	common.TypeRelationshipFileModified,
+	common.TypeRelationshipExpandedFromArchive,
+	common.TypeRelationshipDynamicLink,
+	common.TypeRelationshipStaticLink,
+	common.TypeRelationshipDataFileOf,
+	common.TypeRelationshipTestCaseOf,
+	common.TypeRelationshipBuildToolOf,
+	common.TypeRelationshipDevToolOf,
+	common.TypeRelationshipTestOf,
+	common.TypeRelationshipTestToolOf,
+	common.TypeRelationshipDocumentationOf,
+	common.TypeRelationshipOptionalComponentOf,
+	common.TypeRelationshipMetafileOf,
+	common.TypeRelationshipPackageOf,
+	common.TypeRelationshipAmends,
+	common.TypeRelationshipPrerequisiteFor,
+	common.TypeRelationshipHasPrerequisite,
+	common.TypeRelationshipOther,
+}
+
+type validator struct {
+	doc      *spdx.Document
+	idx      *Index
+	rules    *rules
+	list     *licenselist.List
+	findings Findings
+
+	// elements are the rendered IDs of the document, packages, files and snippets
+	elements map[string]bool
+	files    map[common.ElementID]bool
+
+	// validated are the files already validated: a file may be listed both
+	// in a package and in the document
+	validated map[*spdx.File]bool
+
+	// licenseRefs are the LicenseRef- IDs defined in OtherLicenses
+	licenseRefs map[string]bool
+
+	// documentRefs are the IDs of the external document references, without "DocumentRef-"
+	documentRefs map[string]bool
+}
+
+// Validate checks the document against the version of the specification it
+// declares in SPDXVersion, and returns every problem found: missing mandatory
+// fields, malformed identifiers, checksums, dates, URIs and license
+// expressions, references to undefined elements, licenses and external
+// documents, and packages whose FilesAnalyzed value contradicts their
+// verification code or files. The document may be a v2_1, v2_2 or v2_3
+// Document, or a pointer to one. License IDs are checked against
+// licenselist.Default(). An empty result means the document is valid.
+func Validate(document spdxcommon.AnyDocument) Findings {
+	declared, model, ok := documentVersion(document)
+	if !ok {
+		return Findings{{Severity: SeverityError, Message: fmt.Sprintf("unsupported document type %T", document)}}
+	}
+
+	// a document that declares an unknown version is checked against the version of its model
+	r := versionRules[declared]
+	if r == nil {
+		r = versionRules[model]
+	}
+
+	doc, err := latest(document)
+	if err != nil {
+		return Findings{{Severity: SeverityError, ElementID: documentID, Message: err.Error()}}
+	}
+
+	v := &validator{
+		doc:          doc,
+		idx:          NewIndex(doc),
+		rules:        r,
+		list:         licenselist.Default(),
+		elements:     map[string]bool{},
+		files:        map[common.ElementID]bool{},
+		validated:    map[*spdx.File]bool{},
+		licenseRefs:  map[string]bool{},
+		documentRefs: map[string]bool{},
+	}
+	v.index()
+
+	v.validateDocument(declared)
+	for _, pkg := range doc.Packages {
+		if pkg != nil {
+			v.validatePackage(pkg)
+		}
+	}
+	for _, file := range doc.Files {
+		if file != nil {
+			v.validateFile(file)
+		}
+	}
+	for i := range doc.Snippets {
+		v.validateSnippet(&doc.Snippets[i])
+	}
+	for _, license := range doc.OtherLicenses {
+		if license != nil {
+			v.validateOtherLicense(license)
+		}
+	}
+	for _, relationship := range doc.Relationships {
+		if relationship != nil {
+			v.validateRelationship(relationship)
+		}
+	}
+	for _, annotation := range doc.Annotations {
+		if annotation != nil {
+			v.validateAnnotation(documentID, annotation)
+		}
+	}
+	for _, review := range doc.Reviews {
+		if review != nil {
+			v.validateReview(review)
+		}
+	}
+	return v.findings
+}
+
+const documentID = spdxRefPrefix + "DOCUMENT"
+
+// documentVersion returns the version a document declares and the version of its model
+func documentVersion(doc spdxcommon.AnyDocument) (string, string, bool) {
+	switch doc := doc.(type) {
+	case v2_1.Document:
+		return doc.SPDXVersion, v2_1.Version, true
+	case *v2_1.Document:
+		if doc != nil {
+			return doc.SPDXVersion, v2_1.Version, true
+		}
+	case v2_2.Document:
+		return doc.SPDXVersion, v2_2.Version, true
+	case *v2_2.Document:
+		if doc != nil {
+			return doc.SPDXVersion, v2_2.Version, true
+		}
+	case v2_3.Document:
+		return doc.SPDXVersion, v2_3.Version, true
+	case *v2_3.Document:
+		if doc != nil {
+			return doc.SPDXVersion, v2_3.Version, true
+		}
+	}
+	return "", "", false
+}
+
+// latest returns the document as a v2_3 Document; the rules of older versions
+// are checked against the converted document
+func latest(doc spdxcommon.AnyDocument) (*spdx.Document, error) {
+	switch doc := doc.(type) {
+	case *v2_3.Document:
+		return doc, nil
+	case v2_3.Document:
+		return &doc, nil
+	}
+	var out spdx.Document
+	if err := convert.Document(doc, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// index collects the identifiers defined in the document, reporting duplicates
+func (v *validator) index() {
+	v.elements[documentID] = true
+
+	define := func(id common.ElementID, field string) {
+		rendered := renderID(id)
+		if id != "" && v.elements[rendered] {
+			v.errorf(rendered, field, "identifier %s is defined more than once", rendered)
+		}
+		v.elements[rendered] = true
+	}
+
+	for _, pkg := range v.doc.Packages {
+		if pkg == nil {
+			continue
+		}
+		define(pkg.PackageSPDXIdentifier, "PackageSPDXIdentifier")
+		for _, file := range pkg.Files {
+			if file != nil {
+				define(file.FileSPDXIdentifier, "FileSPDXIdentifier")
+				v.files[file.FileSPDXIdentifier] = true
+			}
+		}
+	}
+	for _, file := range v.doc.Files {
+		if file == nil {
+			continue
+		}
+		// the same file may be listed both in a package and in the document
+		if !v.files[file.FileSPDXIdentifier] {
+			define(file.FileSPDXIdentifier, "FileSPDXIdentifier")
+			v.files[file.FileSPDXIdentifier] = true
+		}
+	}
+	for _, snippet := range v.doc.Snippets {
+		define(snippet.SnippetSPDXIdentifier, "SnippetSPDXIdentifier")
+	}
+
+	for _, license := range v.doc.OtherLicenses {
+		if license == nil {
+			continue
+		}
+		if v.licenseRefs[license.LicenseIdentifier] {
+			v.errorf(license.LicenseIdentifier, "LicenseIdentifier", "license %s is defined more than once", license.LicenseIdentifier)
+		}
+		v.licenseRefs[license.LicenseIdentifier] = true
+	}
+
+	for _, ref := range v.doc.ExternalDocumentReferences {
+		id := strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)
+		if v.documentRefs[id] {
+			v.errorf(documentID, "ExternalDocumentReferences", "external document %s%s is referenced more than once", documentRefPrefix, id)
+		}
+		v.documentRefs[id] = true
+	}
+}
+
+func (v *validator) validateDocument(declared string) {
+	doc := v.doc
+
+	if declared == "" {
+		v.errorf(documentID, "SPDXVersion", "SPDX version is missing")
+	} else if versionRules[declared] == nil {
+		v.errorf(documentID, "SPDXVersion", "SPDX version %q is not one of %s; the document was checked against %s",
+			declared, strings.Join(versions, ", "), v.rules.version)
+	}
+
+	if doc.DataLicense != spdx.DataLicense {
+		v.errorf(documentID, "DataLicense", "data license %q is not %q", doc.DataLicense, spdx.DataLicense)
+	}
+
+	if doc.SPDXIdentifier != "DOCUMENT" {
+		v.errorf(documentID, "SPDXIdentifier", "document identifier %q is not %q", renderID(doc.SPDXIdentifier), documentID)
+	}
+
+	if doc.DocumentName == "" {
+		v.errorf(documentID, "DocumentName", "document name is missing")
+	}
+
+	if doc.DocumentNamespace == "" {
+		v.errorf(documentID, "DocumentNamespace", "document namespace is missing")
+	} else if err := checkURI(doc.DocumentNamespace); err != nil {
+		v.errorf(documentID, "DocumentNamespace", "document namespace %s", err)
+	}
+
+	for _, ref := range doc.ExternalDocumentReferences {
+		id := strings.TrimPrefix(ref.DocumentRefID, documentRefPrefix)
+		if !idString.MatchString(id) {
+			v.errorf(documentID, "ExternalDocumentReferences", "external document ID %q is not of the form DocumentRef-[idstring]", ref.DocumentRefID)
+		}
+		if ref.URI == "" {
+			v.errorf(documentID, "ExternalDocumentReferences", "external document %s has no SPDX document URI", documentRefPrefix+id)
+		} else if err := checkURI(ref.URI); err != nil {
+			v.errorf(documentID, "ExternalDocumentReferences", "external document %s URI %s", documentRefPrefix+id, err)
+		}
+		if ref.Checksum.Algorithm != common.SHA1 {
+			v.errorf(documentID, "ExternalDocumentReferences", "external document %s checksum must be SHA1, not %q", documentRefPrefix+id, ref.Checksum.Algorithm)
+		}
+		v.checkChecksum(documentID, "ExternalDocumentReferences", ref.Checksum)
+	}
+
+	if doc.CreationInfo == nil {
+		v.errorf(documentID, "Creators", "creation info is missing")
+		v.errorf(documentID, "Created", "creation info is missing")
+		return
+	}
+
+	if version := doc.CreationInfo.LicenseListVersion; version != "" && !licenseListVersion.MatchString(version) {
+		v.errorf(documentID, "LicenseListVersion", "license list version %q is not of the form M.N", version)
+	}
+
+	if len(doc.CreationInfo.Creators) == 0 {
+		v.errorf(documentID, "Creators", "no creators")
+	}
+	for _, creator := range doc.CreationInfo.Creators {
+		v.checkAgent(documentID, "Creators", creator.CreatorType, creator.Creator, agentTypes)
+	}
+
+	if doc.CreationInfo.Created == "" {
+		v.errorf(documentID, "Created", "creation date is missing")
+	} else {
+		v.checkDate(documentID, "Created", doc.CreationInfo.Created)
+	}
+}
+
+func (v *validator) validatePackage(pkg *spdx.Package) {
+	id := renderID(pkg.PackageSPDXIdentifier)
+
+	if pkg.PackageName == "" {
+		v.errorf(id, "PackageName", "package name is missing")
+	}
+	v.checkID(id, "PackageSPDXIdentifier", pkg.PackageSPDXIdentifier)
+
+	if pkg.PackageSupplier != nil && pkg.PackageSupplier.Supplier != noAssertion {
+		v.checkAgent(id, "PackageSupplier", pkg.PackageSupplier.SupplierType, pkg.PackageSupplier.Supplier, agentTypes[:2])
+	}
+	if pkg.PackageOriginator != nil && pkg.PackageOriginator.Originator != noAssertion {
+		v.checkAgent(id, "PackageOriginator", pkg.PackageOriginator.OriginatorType, pkg.PackageOriginator.Originator, agentTypes[:2])
+	}
+
+	if pkg.PackageDownloadLocation == "" {
+		v.errorf(id, "PackageDownloadLocation", "download location is missing; use NOASSERTION or NONE if it is not known")
+	}
+
+	if v.rules.licensingMandatory {
+		v.checkMandatory(id, "PackageLicenseConcluded", pkg.PackageLicenseConcluded)
+		v.checkMandatory(id, "PackageLicenseDeclared", pkg.PackageLicenseDeclared)
+		v.checkMandatory(id, "PackageCopyrightText", pkg.PackageCopyrightText)
+	}
+
+	v.validateFilesAnalyzed(id, pkg)
+
+	for _, checksum := range pkg.PackageChecksums {
+		v.checkChecksum(id, "PackageChecksums", checksum)
+	}
+
+	if home := pkg.PackageHomePage; home != "" && home != none && home != noAssertion {
+		if err := checkURL(home); err != nil {
+			v.errorf(id, "PackageHomePage", "home page %s", err)
+		}
+	}
+
+	v.checkExpression(id, "PackageLicenseConcluded", pkg.PackageLicenseConcluded)
+	for _, license := range pkg.PackageLicenseInfoFromFiles {
+		v.checkSimpleExpression(id, "PackageLicenseInfoFromFiles", license)
+	}
+	v.checkExpression(id, "PackageLicenseDeclared", pkg.PackageLicenseDeclared)
+
+	for _, ref := range pkg.PackageExternalReferences {
+		if ref != nil {
+			v.checkExternalRef(id, ref)
+		}
+	}
+
+	v.checkDefined(id, "PackageAttributionTexts", len(pkg.PackageAttributionTexts) > 0)
+	v.checkDefined(id, "PrimaryPackagePurpose", pkg.PrimaryPackagePurpose != "")
+	v.checkDefined(id, "ReleaseDate", pkg.ReleaseDate != "")
+	v.checkDefined(id, "BuiltDate", pkg.BuiltDate != "")
+	v.checkDefined(id, "ValidUntilDate", pkg.ValidUntilDate != "")
+	if purpose := pkg.PrimaryPackagePurpose; purpose != "" && !contains(packagePurposes, purpose) {
+		v.errorf(id, "PrimaryPackagePurpose", "unknown primary package purpose %q", purpose)
+	}
+	v.checkDate(id, "ReleaseDate", pkg.ReleaseDate)
+	v.checkDate(id, "BuiltDate", pkg.BuiltDate)
+	v.checkDate(id, "ValidUntilDate", pkg.ValidUntilDate)
+
+	for _, file := range pkg.Files {
+		if file != nil {
+			v.validateFile(file)
+		}
+	}
+	for i := range pkg.Annotations {
+		v.validateAnnotation(id, &pkg.Annotations[i])
+	}
+}
+
+// validateFilesAnalyzed checks that the verification code, license information
+// from files and the files themselves are present if and only if FilesAnalyzed is true
+func (v *validator) validateFilesAnalyzed(id string, pkg *spdx.Package) {
+	hasFiles := len(v.idx.PackageFiles(pkg.PackageSPDXIdentifier)) > 0
+	// older models hold the code as a value, which is converted to an empty code
+	hasCode := pkg.PackageVerificationCode != nil &&
+		(pkg.PackageVerificationCode.Value != "" || len(pkg.PackageVerificationCode.ExcludedFiles) > 0)
+
+	if !pkg.FilesAnalyzed {
+		if hasCode {
+			v.errorf(id, "PackageVerificationCode", "verification code must be omitted when FilesAnalyzed is false")
+		}
+		if len(pkg.PackageLicenseInfoFromFiles) > 0 {
+			v.errorf(id, "PackageLicenseInfoFromFiles", "license information from files must be omitted when FilesAnalyzed is false")
+		}
+		if hasFiles {
+			v.errorf(id, "FilesAnalyzed", "package contains files but FilesAnalyzed is false")
+		}
+		return
+	}
+
+	// the code and license information can only be collected from the files of the package
+	if v.rules.licensingMandatory && len(pkg.PackageLicenseInfoFromFiles) == 0 {
+		if hasFiles {
+			v.errorf(id, "PackageLicenseInfoFromFiles", "license information from files is mandatory in %s when FilesAnalyzed is true; use NOASSERTION if it is not known", v.rules.version)
+		} else {
+			v.warnf(id, "PackageLicenseInfoFromFiles", "license information from files is missing but FilesAnalyzed is true; set FilesAnalyzed to false if the files were not analyzed")
+		}
+	}
+
+	if !hasCode {
+		if hasFiles {
+			v.errorf(id, "PackageVerificationCode", "verification code is missing but FilesAnalyzed is true")
+		} else {
+			v.warnf(id, "PackageVerificationCode", "verification code is missing but FilesAnalyzed is true; set FilesAnalyzed to false if the files were not analyzed")
+		}
+		return
+	}
+
+	code := pkg.PackageVerificationCode.Value
+	if len(code) != checksumLengths[common.SHA1] || !hexString.MatchString(code) {
+		v.errorf(id, "PackageVerificationCode", "verification code %q is not a SHA1 value of 40 hex digits", code)
+	}
+}
+
+func (v *validator) validateFile(file *spdx.File) {
+	if v.validated[file] {
+		return
+	}
+	v.validated[file] = true
+	id := renderID(file.FileSPDXIdentifier)
+
+	if file.FileName == "" {
+		v.errorf(id, "FileName", "file name is missing")
+	}
+	v.checkID(id, "FileSPDXIdentifier", file.FileSPDXIdentifier)
+
+	for _, fileType := range file.FileTypes {
+		if !contains(fileTypes, fileType) {
+			v.errorf(id, "FileTypes", "unknown file type %q", fileType)
+		}
+	}
+
+	hasSHA1 := false
+	for _, checksum := range file.Checksums {
+		hasSHA1 = hasSHA1 || checksum.Algorithm == common.SHA1
+		v.checkChecksum(id, "Checksums", checksum)
+	}
+	if !hasSHA1 {
+		v.errorf(id, "Checksums", "SHA1 checksum is missing")
+	}
+
+	if v.rules.licensingMandatory {
+		v.checkMandatory(id, "LicenseConcluded", file.LicenseConcluded)
+		if len(file.LicenseInfoInFiles) == 0 {
+			v.errorf(id, "LicenseInfoInFiles", "license information in file is mandatory in %s; use NOASSERTION if it is not known", v.rules.version)
+		}
+		v.checkMandatory(id, "FileCopyrightText", file.FileCopyrightText)
+	}
+	v.checkExpression(id, "LicenseConcluded", file.LicenseConcluded)
+	for _, license := range file.LicenseInfoInFiles {
+		v.checkSimpleExpression(id, "LicenseInfoInFiles", license)
+	}
+	v.checkDefined(id, "FileAttributionTexts", len(file.FileAttributionTexts) > 0)
+
+	for i := range file.Annotations {
+		v.validateAnnotation(id, &file.Annotations[i])
+	}
+}
+
+func (v *validator) validateSnippet(snippet *spdx.Snippet) {
+	id := renderID(snippet.SnippetSPDXIdentifier)
+
+	v.checkID(id, "SnippetSPDXIdentifier", snippet.SnippetSPDXIdentifier)
+
+	from := snippet.SnippetFromFileSPDXIdentifier
+	if from == "" {
+		v.errorf(id, "SnippetFromFileSPDXIdentifier", "snippet file is missing")
+	} else if !v.files[from] {
+		v.errorf(id, "SnippetFromFileSPDXIdentifier", "snippet file %s is not in the document", renderID(from))
+	}
+
+	if len(snippet.Ranges) == 0 {
+		v.errorf(id, "Ranges", "snippet has no byte range")
+	}
+	for _, r := range snippet.Ranges {
+		start, end := r.StartPointer, r.EndPointer
+		switch {
+		case start.Offset > 0 || end.Offset > 0:
+			if start.Offset < 1 || end.Offset < start.Offset {
+				v.errorf(id, "Ranges", "byte range %d:%d is invalid", start.Offset, end.Offset)
+			}
+		case start.LineNumber > 0 || end.LineNumber > 0:
+			if start.LineNumber < 1 || end.LineNumber < start.LineNumber {
+				v.errorf(id, "Ranges", "line range %d:%d is invalid", start.LineNumber, end.LineNumber)
+			}
+		default:
+			v.errorf(id, "Ranges", "range has neither offsets nor line numbers")
+		}
+		for _, file := range []common.ElementID{start.FileSPDXIdentifier, end.FileSPDXIdentifier} {
+			if file != "" && file != from {
+				v.errorf(id, "Ranges", "range refers to %s instead of the snippet file %s", renderID(file), renderID(from))
+				break
+			}
+		}
+	}
+
+	if v.rules.licensingMandatory {
+		v.checkMandatory(id, "SnippetLicenseConcluded", snippet.SnippetLicenseConcluded)
+		v.checkMandatory(id, "SnippetCopyrightText", snippet.SnippetCopyrightText)
+	}
+	v.checkExpression(id, "SnippetLicenseConcluded", snippet.SnippetLicenseConcluded)
+	for _, license := range snippet.LicenseInfoInSnippet {
+		v.checkSimpleExpression(id, "LicenseInfoInSnippet", license)
+	}
+	v.checkDefined(id, "SnippetAttributionTexts", len(snippet.SnippetAttributionTexts) > 0)
+}
+
+func (v *validator) validateOtherLicense(license *spdx.OtherLicense) {
+	id := license.LicenseIdentifier
+
+	if !strings.HasPrefix(id, licenseRefPrefix) || !idString.MatchString(strings.TrimPrefix(id, licenseRefPrefix)) {
+		v.errorf(id, "LicenseIdentifier", "license identifier %q is not of the form LicenseRef-[idstring]", id)
+	}
+	if license.ExtractedText == "" {
+		v.errorf(id, "ExtractedText", "extracted text is missing")
+	}
+}
+
+func (v *validator) validateRelationship(relationship *spdx.Relationship) {
+	id := common.RenderDocElementID(relationship.RefA)
+
+	if relationship.Relationship == "" {
+		v.errorf(id, "Relationship", "relationship type is missing")
+	} else if !contains(v.rules.relationshipTypes, relationship.Relationship) {
+		v.errorf(id, "Relationship", "unknown relationship type %q", relationship.Relationship)
+	}
+
+	v.checkReference(id, "RefA", relationship.RefA, true)
+	v.checkReference(id, "RefB", relationship.RefB, true)
+}
+
+func (v *validator) validateAnnotation(id string, annotation *spdx.Annotation) {
+	v.checkAgent(id, "Annotator", annotation.Annotator.AnnotatorType, annotation.Annotator.Annotator, agentTypes)
+
+	if annotation.AnnotationDate == "" {
+		v.errorf(id, "AnnotationDate", "annotation date is missing")
+	} else {
+		v.checkDate(id, "AnnotationDate", annotation.AnnotationDate)
+	}
+
+	if !contains(annotationTypes, annotation.AnnotationType) {
+		v.errorf(id, "AnnotationType", "annotation type %q is not REVIEW or OTHER", annotation.AnnotationType)
+	}
+
+	// the identifier is implied by the position of the annotation in JSON and YAML
+	if annotation.AnnotationSPDXIdentifier != (common.DocElementID{}) {
+		v.checkReference(id, "AnnotationSPDXIdentifier", annotation.AnnotationSPDXIdentifier, false)
+	}
+
+	if annotation.AnnotationComment == "" {
+		v.errorf(id, "AnnotationComment", "annotation comment is missing")
+	}
+}
+
+func (v *validator) validateReview(review *spdx.Review) {
+	v.checkAgent(documentID, "Reviewer", review.ReviewerType, review.Reviewer, agentTypes)
+	if review.ReviewDate == "" {
+		v.errorf(documentID, "ReviewDate", "review date is missing")
+	} else {
+		v.checkDate(documentID, "ReviewDate", review.ReviewDate)
+	}
+}
+
+// checkMandatory checks a licensing field that is mandatory in older versions
+func (v *validator) checkMandatory(id string, field string, value string) {
+	if value == "" {
+		v.errorf(id, field, "%s is mandatory in %s; use NOASSERTION if it is not known", field, v.rules.version)
+	}
+}
+
+// checkDefined checks that a field that is present exists in the version of the document
+func (v *validator) checkDefined(id string, field string, present bool) {
+	if present && !v.rules.defines(field) {
+		v.errorf(id, field, "%s is not defined in %s", field, v.rules.version)
+	}
+}
+
+// checkID checks the syntax of an element's own identifier
+func (v *validator) checkID(id string, field string, eID common.ElementID) {
+	if eID == "" {
+		v.errorf(id, field, "identifier is missing")
+	} else if !idString.MatchString(string(eID)) {
+		v.errorf(id, field, "identifier %q is not of the form SPDXRef-[idstring]", renderID(eID))
+	}
+}
+
+// checkReference checks that an identifier refers to an element of this document
+// or of a declared external document; special values are allowed for relationships
+func (v *validator) checkReference(id string, field string, ref common.DocElementID, allowSpecial bool) {
+	if ref.SpecialID != "" {
+		if !allowSpecial || ref.SpecialID != none && ref.SpecialID != noAssertion {
+			v.errorf(id, field, "%q is not allowed here", ref.SpecialID)
+		}
+		return
+	}
+
+	if ref.ElementRefID == "" {
+		v.errorf(id, field, "identifier is missing")
+		return
+	}
+	if !idString.MatchString(string(ref.ElementRefID)) {
+		v.errorf(id, field, "identifier %q is not of the form SPDXRef-[idstring]", common.RenderDocElementID(ref))
+		return
+	}
+
+	if ref.DocumentRefID != "" {
+		if !v.documentRefs[ref.DocumentRefID] {
+			v.errorf(id, field, "%s refers to undeclared external document %s", common.RenderDocElementID(ref), documentRefPrefix+ref.DocumentRefID)
+		}
+		return
+	}
+	if !v.elements[renderID(ref.ElementRefID)] {
+		v.errorf(id, field, "%s is not in the document", renderID(ref.ElementRefID))
+	}
+}
+
+func (v *validator) checkAgent(id string, field string, agentType string, name string, allowed []string) {
+	if !contains(allowed, agentType) {
+		v.errorf(id, field, "%q is not one of %s", agentType, strings.Join(allowed, ", "))
+	}
+	if name == "" {
+		v.errorf(id, field, "%s name is missing", strings.ToLower(agentType))
+	}
+}
+
+func (v *validator) checkChecksum(id string, field string, checksum common.Checksum) {
+	length, ok := checksumLengths[checksum.Algorithm]
+	if !ok {
+		v.errorf(id, field, "unknown checksum algorithm %q", checksum.Algorithm)
+		return
+	}
+	if !v.rules.allowsChecksum(checksum.Algorithm) {
+		v.errorf(id, field, "%s checksums are not allowed in %s", checksum.Algorithm, v.rules.version)
+	}
+	if !hexString.MatchString(checksum.Value) || length > 0 && len(checksum.Value) != length {
+		v.errorf(id, field, "%s checksum %q is not %s", checksum.Algorithm, checksum.Value, describeLength(length))
+	}
+}
+
+func describeLength(length int) string {
+	if length == 0 {
+		return "a hex value"
+	}
+	return fmt.Sprintf("%d hex digits", length)
+}
+
+func (v *validator) checkDate(id string, field string, date string) {
+	if date == "" {
+		return
+	}
+	if _, err := time.Parse(dateFormat, date); err != nil {
+		v.errorf(id, field, "date %q is not of the form YYYY-MM-DDThh:mm:ssZ", date)
+	}
+}
+
+func (v *validator) checkExternalRef(id string, ref *spdx.PackageExternalReference) {
+	const field = "PackageExternalReferences"
+
+	if !contains(v.rules.externalRefCategories, ref.Category) {
+		v.errorf(id, field, "unknown external reference category %q", ref.Category)
+	}
+	if ref.RefType == "" {
+		v.errorf(id, field, "external reference type is missing")
+	}
+	if ref.Locator == "" {
+		v.errorf(id, field, "external reference locator is missing")
+	} else if strings.ContainsAny(ref.Locator, " \t\n") {
+		v.errorf(id, field, "external reference locator %q contains whitespace", ref.Locator)
+	}
+
+	switch ref.RefType {
+	case common.TypePackageManagerPURL:
+		if !strings.HasPrefix(ref.Locator, "pkg:") {
+			v.errorf(id, field, "purl %q does not start with \"pkg:\"", ref.Locator)
+		}
+	case common.TypeSecurityCPE23Type:
+		if !strings.HasPrefix(ref.Locator, "cpe:2.3:") {
+			v.errorf(id, field, "CPE 2.3 name %q does not start with \"cpe:2.3:\"", ref.Locator)
+		}
+	case common.TypeSecurityCPE22Type:
+		if !strings.HasPrefix(ref.Locator, "cpe:/") {
+			v.errorf(id, field, "CPE 2.2 name %q does not start with \"cpe:/\"", ref.Locator)
+		}
+	}
+}
+
+// checkExpression checks the syntax of a license expression and the licenses it uses
+func (v *validator) checkExpression(id string, field string, expression string) {
+	if expression == "" {
+		return
+	}
+	e, err := licensing.Parse(expression)
+	if err != nil {
+		v.errorf(id, field, "%s", err)
+		return
+	}
+	v.checkLicenses(id, field, e)
+}
+
+// checkSimpleExpression checks a value that must be a single license, NONE or NOASSERTION
+func (v *validator) checkSimpleExpression(id string, field string, expression string) {
+	e, err := licensing.Parse(expression)
+	if err != nil {
+		v.errorf(id, field, "%s", err)
+		return
+	}
+	switch e.(type) {
+	case *licensing.License, *licensing.LicenseRef, licensing.Special:
+		v.checkLicenses(id, field, e)
+	default:
+		v.errorf(id, field, "%q must be a single license, not an expression", expression)
+	}
+}
+
+func (v *validator) checkLicenses(id string, field string, e licensing.Expression) {
+	licensing.Walk(e, func(e licensing.Expression) bool {
+		switch e := e.(type) {
+		case *licensing.License:
+			license, ok := v.list.License(e.ID)
+			switch {
+			case !ok:
+				v.warnf(id, field, "license %s is not on the SPDX License List %s", e.ID, v.list.Version)
+			case license.IsDeprecated:
+				v.warnf(id, field, "license %s is deprecated", license.ID)
+			}
+		case *licensing.LicenseRef:
+			if e.DocumentRef != "" {
+				if !v.documentRefs[e.DocumentRef] {
+					v.errorf(id, field, "%s refers to undeclared external document %s", e, documentRefPrefix+e.DocumentRef)
+				}
+			} else if !v.licenseRefs[e.LicenseRef] {
+				v.errorf(id, field, "license %s is not defined in the document", e.LicenseRef)
+			}
+		case *licensing.With:
+			if strings.Contains(e.Exception, ":") || strings.HasPrefix(e.Exception, "AdditionRef-") {
+				break
+			}
+			exception, ok := v.list.Exception(e.Exception)
+			switch {
+			case !ok:
+				v.warnf(id, field, "exception %s is not on the SPDX License List %s", e.Exception, v.list.Version)
+			case exception.IsDeprecated:
+				v.warnf(id, field, "exception %s is deprecated", exception.ID)
+			}
+		}
+		return true
+	})
+}
+
+func (v *validator) errorf(id string, field string, format string, args ...interface{}) {
+	v.add(SeverityError, id, field, format, args...)
+}
+
+func (v *validator) warnf(id string, field string, format string, args ...interface{}) {
+	v.add(SeverityWarning, id, field, format, args...)
+}
+
+func (v *validator) add(severity Severity, id string, field string, format string, args ...interface{}) {
+	section := ""
+	if v.rules.defines(field) {
+		section = v.rules.section(field)
+	}
+	v.findings = append(v.findings, Finding{
+		Severity:  severity,
+		ElementID: id,
+		Field:     field,
+		Section:   section,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+// checkURI checks that the value is an absolute URI without a fragment
+func checkURI(value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("%q is not a valid URI", value)
+	}
+	if !u.IsAbs() {
+		return fmt.Errorf("%q is not an absolute URI", value)
+	}
+	if strings.Contains(value, "#") {
+		return fmt.Errorf("%q must not contain \"#\"", value)
+	}
+	return nil
+}
+
+func checkURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		return fmt.Errorf("%q is not a valid URL", value)
+	}
+	return nil
+}
+
+func renderID(id common.ElementID) string {
+	return spdxRefPrefix + string(id)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"strings"
+
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+// rules are what differs between the versions of the specification
+type rules struct {
+	version string
+
+	// chapters maps the chapters of the SPDX 2.3 sections in the sections
+	// table to the chapters of this version; nil if they are the same
+	chapters map[string]string
+
+	// checksumAlgorithms are the algorithms allowed for packages and files
+	checksumAlgorithms []common.ChecksumAlgorithm
+
+	externalRefCategories []string
+
+	relationshipTypes []string
+
+	// licensingMandatory is true if the concluded and declared licenses, the
+	// license information found in files and the copyright texts are mandatory
+	licensingMandatory bool
+}
+
+// introduced maps the fields that were added after SPDX 2.1 to the version that added them
+var introduced = map[string]string{
+	"PackageAttributionTexts": v2_2.Version,
+	"FileAttributionTexts":    v2_2.Version,
+	"SnippetAttributionTexts": v2_2.Version,
+	"PrimaryPackagePurpose":   v2_3.Version,
+	"ReleaseDate":             v2_3.Version,
+	"BuiltDate":               v2_3.Version,
+	"ValidUntilDate":          v2_3.Version,
+}
+
+// versions lists the supported versions, oldest first
+var versions = []string{v2_1.Version, v2_2.Version, v2_3.Version}
+
+// v2_1Chapters maps the chapters of SPDX 2.3 to those of SPDX 2.1 and 2.2,
+// which start with the document creation information in chapter 2
+var v2_1Chapters = map[string]string{
+	"6": "2", "7": "3", "8": "4", "9": "5", "10": "6", "11": "7", "12": "8", "13": "9",
+}
+
+var versionRules = map[string]*rules{
+	v2_1.Version: {
+		version:  v2_1.Version,
+		chapters: v2_1Chapters,
+		checksumAlgorithms: []common.ChecksumAlgorithm{
+			common.SHA1, common.SHA256, common.MD5,
+		},
+		externalRefCategories: []string{
+			common.CategorySecurity, common.CategoryPackageManager, common.CategoryOther,
+		},
+		relationshipTypes:  relationshipTypes,
+		licensingMandatory: true,
+	},
+	v2_2.Version: {
+		version:  v2_2.Version,
+		chapters: v2_1Chapters,
+		checksumAlgorithms: []common.ChecksumAlgorithm{
+			common.SHA1, common.SHA224, common.SHA256, common.SHA384, common.SHA512,
+			common.MD2, common.MD4, common.MD5, common.MD6,
+		},
+		externalRefCategories: []string{
+			common.CategorySecurity, common.CategoryPackageManager, common.CategoryOther,
+		},
+		relationshipTypes:  relationshipTypes,
+		licensingMandatory: true,
+	},
+	v2_3.Version: {
+		version: v2_3.Version,
+		checksumAlgorithms: []common.ChecksumAlgorithm{
+			common.SHA1, common.SHA224, common.SHA256, common.SHA384, common.SHA512,
+			common.MD2, common.MD4, common.MD5, common.MD6,
+			common.SHA3_256, common.SHA3_384, common.SHA3_512,
+			common.BLAKE2b_256, common.BLAKE2b_384, common.BLAKE2b_512, common.BLAKE3,
+			common.ADLER32,
+		},
+		externalRefCategories: []string{
+			common.CategorySecurity, common.CategoryPackageManager, common.CategoryPersistentId, common.CategoryOther,
+		},
+		relationshipTypes: append(relationshipTypes[:len(relationshipTypes):len(relationshipTypes)],
+			common.TypeRelationshipRequirementDescriptionFor,
+			common.TypeRelationshipSpecificationFor,
+		),
+	},
+}
+
+// section returns the section of this version that defines the field
+func (r *rules) section(field string) string {
+	section, ok := sections[field]
+	if !ok || r.chapters == nil {
+		return section
+	}
+	parts := strings.SplitN(section, ".", 2)
+	if chapter, ok := r.chapters[parts[0]]; ok && len(parts) == 2 {
+		return chapter + "." + parts[1]
+	}
+	return section
+}
+
+// defines returns false if the field was added in a later version
+func (r *rules) defines(field string) bool {
+	since, ok := introduced[field]
+	return !ok || versionIndex(since) <= versionIndex(r.version)
+}
+
+func (r *rules) allowsChecksum(algorithm common.ChecksumAlgorithm) bool {
+	for _, a := range r.checksumAlgorithms {
+		if a == algorithm {
+			return true
+		}
+	}
+	return false
+}
+
+func versionIndex(version string) int {
+	for i, v := range versions {
+		if v == version {
+			return i
+		}
+	}
+	return -1
+}
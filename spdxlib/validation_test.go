@@ -0,0 +1,576 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spdxlib
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/convert"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	v2_2example "github.com/spdx/tools-golang/spdx/v2/v2_2/example"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+	"github.com/spdx/tools-golang/spdx/v3/v3_0"
+)
+
+func Test_ValidateExample(t *testing.T) {
+	doc := example.Copy()
+	findings := Validate(&doc)
+
+	require.False(t, findings.HasErrors(), findings.Err())
+	require.NoError(t, findings.Err())
+
+	// packages without files or verification code that do not set FilesAnalyzed to false
+	require.Equal(t, Findings{
+		{
+			Severity:  SeverityWarning,
+			ElementID: "SPDXRef-fromDoap-0",
+			Field:     "PackageVerificationCode",
+			Section:   "7.9",
+			Message:   "verification code is missing but FilesAnalyzed is true; set FilesAnalyzed to false if the files were not analyzed",
+		},
+		{
+			Severity:  SeverityWarning,
+			ElementID: "SPDXRef-CentOS-7",
+			Field:     "PackageVerificationCode",
+			Section:   "7.9",
+			Message:   "verification code is missing but FilesAnalyzed is true; set FilesAnalyzed to false if the files were not analyzed",
+		},
+	}, findings)
+}
+
+func Test_ValidateFindings(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(doc *spdx.Document)
+		severity Severity
+		id       string
+		field    string
+		section  string
+	}{
+		{
+			name:    "wrong version",
+			modify:  func(doc *spdx.Document) { doc.SPDXVersion = "SPDX-2.4" },
+			id:      "SPDXRef-DOCUMENT",
+			field:   "SPDXVersion",
+			section: "6.1",
+		},
+		{
+			name:    "wrong data license",
+			modify:  func(doc *spdx.Document) { doc.DataLicense = "CC-BY-4.0" },
+			id:      "SPDXRef-DOCUMENT",
+			field:   "DataLicense",
+			section: "6.2",
+		},
+		{
+			name:    "missing name",
+			modify:  func(doc *spdx.Document) { doc.DocumentName = "" },
+			id:      "SPDXRef-DOCUMENT",
+			field:   "DocumentName",
+			section: "6.4",
+		},
+		{
+			name:    "relative namespace",
+			modify:  func(doc *spdx.Document) { doc.DocumentNamespace = "spdx-example" },
+			id:      "SPDXRef-DOCUMENT",
+			field:   "DocumentNamespace",
+			section: "6.5",
+		},
+		{
+			name:    "namespace with fragment",
+			modify:  func(doc *spdx.Document) { doc.DocumentNamespace += "#part" },
+			id:      "SPDXRef-DOCUMENT",
+			field:   "DocumentNamespace",
+			section: "6.5",
+		},
+		{
+			name: "external document checksum",
+			modify: func(doc *spdx.Document) {
+				doc.ExternalDocumentReferences[0].Checksum.Value = "d6a770ba"
+			},
+			id:      "SPDXRef-DOCUMENT",
+			field:   "ExternalDocumentReferences",
+			section: "6.6",
+		},
+		{
+			name:    "license list version",
+			modify:  func(doc *spdx.Document) { doc.CreationInfo.LicenseListVersion = "v3" },
+			id:      "SPDXRef-DOCUMENT",
+			field:   "LicenseListVersion",
+			section: "6.7",
+		},
+		{
+			name:    "no creators",
+			modify:  func(doc *spdx.Document) { doc.CreationInfo.Creators = nil },
+			id:      "SPDXRef-DOCUMENT",
+			field:   "Creators",
+			section: "6.8",
+		},
+		{
+			name: "creator type",
+			modify: func(doc *spdx.Document) {
+				doc.CreationInfo.Creators[0].CreatorType = "Robot"
+			},
+			id:      "SPDXRef-DOCUMENT",
+			field:   "Creators",
+			section: "6.8",
+		},
+		{
+			name:    "created date",
+			modify:  func(doc *spdx.Document) { doc.CreationInfo.Created = "2010-01-29 18:30:22" },
+			id:      "SPDXRef-DOCUMENT",
+			field:   "Created",
+			section: "6.9",
+		},
+		{
+			name:    "missing package name",
+			modify:  func(doc *spdx.Document) { doc.Packages[0].PackageName = "" },
+			id:      "SPDXRef-Package",
+			field:   "PackageName",
+			section: "7.1",
+		},
+		{
+			name:    "package identifier syntax",
+			modify:  func(doc *spdx.Document) { doc.Packages[3].PackageSPDXIdentifier = "Saxon_8" },
+			id:      "SPDXRef-Saxon_8",
+			field:   "PackageSPDXIdentifier",
+			section: "7.2",
+		},
+		{
+			name:    "duplicate identifier",
+			modify:  func(doc *spdx.Document) { doc.Packages[3].PackageSPDXIdentifier = "Package" },
+			id:      "SPDXRef-Package",
+			field:   "PackageSPDXIdentifier",
+			section: "7.2",
+		},
+		{
+			name:    "missing download location",
+			modify:  func(doc *spdx.Document) { doc.Packages[0].PackageDownloadLocation = "" },
+			id:      "SPDXRef-Package",
+			field:   "PackageDownloadLocation",
+			section: "7.7",
+		},
+		{
+			name:    "files in package that was not analyzed",
+			modify:  func(doc *spdx.Document) { doc.Packages[0].FilesAnalyzed = false },
+			id:      "SPDXRef-Package",
+			field:   "PackageVerificationCode",
+			section: "7.9",
+		},
+		{
+			name: "files analyzed without verification code",
+			modify: func(doc *spdx.Document) {
+				doc.Packages[0].PackageVerificationCode = nil
+			},
+			id:      "SPDXRef-Package",
+			field:   "PackageVerificationCode",
+			section: "7.9",
+		},
+		{
+			name:    "package checksum",
+			modify:  func(doc *spdx.Document) { doc.Packages[0].PackageChecksums[2].Value = "xyz" },
+			id:      "SPDXRef-Package",
+			field:   "PackageChecksums",
+			section: "7.10",
+		},
+		{
+			name:    "unknown checksum algorithm",
+			modify:  func(doc *spdx.Document) { doc.Packages[0].PackageChecksums[0].Algorithm = "CRC32" },
+			id:      "SPDXRef-Package",
+			field:   "PackageChecksums",
+			section: "7.10",
+		},
+		{
+			name:    "concluded license syntax",
+			modify:  func(doc *spdx.Document) { doc.Packages[0].PackageLicenseConcluded = "(LGPL-2.0-only OR" },
+			id:      "SPDXRef-Package",
+			field:   "PackageLicenseConcluded",
+			section: "7.13",
+		},
+		{
+			name:    "undefined license ref",
+			modify:  func(doc *spdx.Document) { doc.Packages[0].PackageLicenseDeclared = "LicenseRef-99" },
+			id:      "SPDXRef-Package",
+			field:   "PackageLicenseDeclared",
+			section: "7.15",
+		},
+		{
+			name: "license info from files must be simple",
+			modify: func(doc *spdx.Document) {
+				doc.Packages[0].PackageLicenseInfoFromFiles[0] = "MIT OR ISC"
+			},
+			id:      "SPDXRef-Package",
+			field:   "PackageLicenseInfoFromFiles",
+			section: "7.14",
+		},
+		{
+			name:     "unknown license",
+			modify:   func(doc *spdx.Document) { doc.Packages[3].PackageLicenseConcluded = "MPL-1.0 AND Acme-1.0" },
+			severity: SeverityWarning,
+			id:       "SPDXRef-Saxon",
+			field:    "PackageLicenseConcluded",
+			section:  "7.13",
+		},
+		{
+			name:     "deprecated license",
+			modify:   func(doc *spdx.Document) { doc.Packages[3].PackageLicenseDeclared = "GPL-2.0+" },
+			severity: SeverityWarning,
+			id:       "SPDXRef-Saxon",
+			field:    "PackageLicenseDeclared",
+			section:  "7.15",
+		},
+		{
+			name: "purl",
+			modify: func(doc *spdx.Document) {
+				doc.Packages[2].PackageExternalReferences[0].Locator = "maven/org.apache.jena/apache-jena@3.12.0"
+			},
+			id:      "SPDXRef-fromDoap-0",
+			field:   "PackageExternalReferences",
+			section: "7.21",
+		},
+		{
+			name:    "package purpose",
+			modify:  func(doc *spdx.Document) { doc.Packages[4].PrimaryPackagePurpose = "IMAGE" },
+			id:      "SPDXRef-CentOS-7",
+			field:   "PrimaryPackagePurpose",
+			section: "7.24",
+		},
+		{
+			name:    "built date",
+			modify:  func(doc *spdx.Document) { doc.Packages[4].BuiltDate = "2021-09-15" },
+			id:      "SPDXRef-CentOS-7",
+			field:   "BuiltDate",
+			section: "7.26",
+		},
+		{
+			name:    "file without SHA1",
+			modify:  func(doc *spdx.Document) { doc.Files[3].Checksums = doc.Files[3].Checksums[1:] },
+			id:      "SPDXRef-File",
+			field:   "Checksums",
+			section: "8.4",
+		},
+		{
+			name:    "file type",
+			modify:  func(doc *spdx.Document) { doc.Files[0].FileTypes = []string{"JAVA"} },
+			id:      "SPDXRef-DoapSource",
+			field:   "FileTypes",
+			section: "8.3",
+		},
+		{
+			name:    "lower case file type",
+			modify:  func(doc *spdx.Document) { doc.Files[0].FileTypes = []string{"source"} },
+			id:      "SPDXRef-DoapSource",
+			field:   "FileTypes",
+			section: "8.3",
+		},
+		{
+			name:    "snippet file",
+			modify:  func(doc *spdx.Document) { doc.Snippets[0].SnippetFromFileSPDXIdentifier = "Missing" },
+			id:      "SPDXRef-Snippet",
+			field:   "SnippetFromFileSPDXIdentifier",
+			section: "9.2",
+		},
+		{
+			name: "snippet range",
+			modify: func(doc *spdx.Document) {
+				doc.Snippets[0].Ranges[0].EndPointer.Offset = 100
+			},
+			id:      "SPDXRef-Snippet",
+			field:   "Ranges",
+			section: "9.3",
+		},
+		{
+			name:    "license ref syntax",
+			modify:  func(doc *spdx.Document) { doc.OtherLicenses[4].LicenseIdentifier = "CyberNeko" },
+			id:      "CyberNeko",
+			field:   "LicenseIdentifier",
+			section: "10.1",
+		},
+		{
+			name:    "unknown relationship type",
+			modify:  func(doc *spdx.Document) { doc.Relationships[0].Relationship = "HAS" },
+			id:      "SPDXRef-DOCUMENT",
+			field:   "Relationship",
+			section: "11.1",
+		},
+		{
+			name: "relationship to missing element",
+			modify: func(doc *spdx.Document) {
+				doc.Relationships[5].RefB = common.MakeDocElementID("", "Missing")
+			},
+			id:      "SPDXRef-Package",
+			field:   "RefB",
+			section: "11.1",
+		},
+		{
+			name: "relationship to undeclared document",
+			modify: func(doc *spdx.Document) {
+				doc.Relationships[1].RefB = common.MakeDocElementID("other", "ToolsElement")
+			},
+			id:      "SPDXRef-DOCUMENT",
+			field:   "RefB",
+			section: "11.1",
+		},
+		{
+			name:    "annotation type",
+			modify:  func(doc *spdx.Document) { doc.Packages[0].Annotations[0].AnnotationType = "NOTE" },
+			id:      "SPDXRef-Package",
+			field:   "AnnotationType",
+			section: "12.3",
+		},
+		{
+			name:    "annotation date",
+			modify:  func(doc *spdx.Document) { doc.Annotations[0].AnnotationDate = "yesterday" },
+			id:      "SPDXRef-DOCUMENT",
+			field:   "AnnotationDate",
+			section: "12.2",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doc := example.Copy()
+			before := Validate(&doc)
+
+			test.modify(&doc)
+			findings := Validate(&doc)
+			require.Greater(t, len(findings), len(before))
+
+			var found *Finding
+			for i := range findings {
+				if findings[i].ElementID == test.id && findings[i].Field == test.field && findings[i].Severity == test.severity {
+					found = &findings[i]
+				}
+			}
+			require.NotNil(t, found, "%v", findings)
+			require.Equal(t, test.section, found.Section)
+			require.NotEmpty(t, found.Message)
+			require.Equal(t, test.severity == SeverityError, findings.HasErrors())
+		})
+	}
+}
+
+func Test_ValidateReportsEveryFinding(t *testing.T) {
+	doc := &spdx.Document{
+		Packages: []*spdx.Package{
+			{PackageSPDXIdentifier: "p1"},
+		},
+		Files: []*spdx.File{
+			{FileName: "f1", FileSPDXIdentifier: "f1", LicenseConcluded: "MIT AND"},
+		},
+	}
+
+	findings := Validate(doc)
+	require.True(t, findings.HasErrors())
+	require.Empty(t, findings.Warnings())
+
+	fields := map[string]bool{}
+	for _, finding := range findings {
+		fields[finding.Field] = true
+	}
+	for _, field := range []string{
+		"SPDXVersion", "DataLicense", "SPDXIdentifier", "DocumentName", "DocumentNamespace",
+		"Creators", "Created", "PackageName", "PackageDownloadLocation", "Checksums", "LicenseConcluded",
+	} {
+		require.True(t, fields[field], "no finding for %s: %v", field, findings)
+	}
+
+	require.Error(t, findings.Err())
+	require.Contains(t, findings.Err().Error(), "error: SPDXRef-p1 PackageName (section 7.1): package name is missing")
+}
+
+func Test_ValidateVersions(t *testing.T) {
+	v22 := v2_2example.Copy()
+	findings := Validate(v22)
+	require.False(t, findings.HasErrors(), findings.Err())
+	require.Len(t, findings, 2)
+	// SPDX 2.2 numbers the package section 3, as SPDX 2.1 does
+	require.Equal(t, "3.14", findings[0].Section)
+
+	var v21 v2_1.Document
+	require.NoError(t, convert.Document(v22, &v21))
+	findings = Validate(&v21)
+	require.False(t, findings.HasErrors(), findings.Err())
+	require.Len(t, findings, 2)
+	// SPDX 2.1 numbers the package section 3
+	require.Equal(t, "3.14", findings[0].Section)
+	require.Equal(t, "3.9", findings[1].Section)
+
+	require.Equal(t, Findings{{Severity: SeverityError, Message: "unsupported document type string"}}, Validate("SPDX-2.3"))
+	require.Equal(t, "unsupported document type *v2_2.Document", Validate((*v2_2.Document)(nil))[0].Message)
+}
+
+func Test_ValidateDeclaredVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		modify   func(doc *spdx.Document)
+		id       string
+		field    string
+		section  string
+		expected bool
+	}{
+		{
+			name:     "copyright text is optional in 2.3",
+			version:  "SPDX-2.3",
+			modify:   func(doc *spdx.Document) { doc.Files[0].FileCopyrightText = "" },
+			expected: false,
+		},
+		{
+			name:     "copyright text is mandatory in 2.2",
+			version:  "SPDX-2.2",
+			modify:   func(doc *spdx.Document) { doc.Files[0].FileCopyrightText = "" },
+			id:       "SPDXRef-DoapSource",
+			field:    "FileCopyrightText",
+			section:  "4.8",
+			expected: true,
+		},
+		{
+			name:     "concluded license is mandatory in 2.1",
+			version:  "SPDX-2.1",
+			modify:   func(doc *spdx.Document) { doc.Packages[3].PackageLicenseConcluded = "" },
+			id:       "SPDXRef-Saxon",
+			field:    "PackageLicenseConcluded",
+			section:  "3.13",
+			expected: true,
+		},
+		{
+			name:     "primary package purpose is not defined in 2.2",
+			version:  "SPDX-2.2",
+			modify:   func(doc *spdx.Document) {},
+			id:       "SPDXRef-CentOS-7",
+			field:    "PrimaryPackagePurpose",
+			section:  "",
+			expected: true,
+		},
+		{
+			name:    "SHA3 is not allowed in 2.2",
+			version: "SPDX-2.2",
+			modify: func(doc *spdx.Document) {
+				doc.Packages[3].PackageChecksums[0] = common.Checksum{
+					Algorithm: common.SHA3_256,
+					Value:     "11b6d3ee554eedf79299905a98f9b9a04e498210b59f15094c916c91d150efcd",
+				}
+			},
+			id:       "SPDXRef-Saxon",
+			field:    "PackageChecksums",
+			section:  "3.10",
+			expected: true,
+		},
+		{
+			name:    "SHA3 is allowed in 2.3",
+			version: "SPDX-2.3",
+			modify: func(doc *spdx.Document) {
+				doc.Packages[3].PackageChecksums[0] = common.Checksum{
+					Algorithm: common.SHA3_256,
+					Value:     "11b6d3ee554eedf79299905a98f9b9a04e498210b59f15094c916c91d150efcd",
+				}
+			},
+			expected: false,
+		},
+		{
+			name:     "SPECIFICATION_FOR is not defined in 2.2",
+			version:  "SPDX-2.2",
+			modify:   func(doc *spdx.Document) { doc.Relationships[5].Relationship = "SPECIFICATION_FOR" },
+			id:       "SPDXRef-Package",
+			field:    "Relationship",
+			section:  "7.1",
+			expected: true,
+		},
+		{
+			name:    "persistent ids are not defined in 2.1",
+			version: "SPDX-2.1",
+			modify: func(doc *spdx.Document) {
+				doc.Packages[2].PackageExternalReferences[0].Category = common.CategoryPersistentId
+			},
+			id:       "SPDXRef-fromDoap-0",
+			field:    "PackageExternalReferences",
+			section:  "3.21",
+			expected: true,
+		},
+		{
+			name:     "unknown version",
+			version:  "SPDX-3.0",
+			modify:   func(doc *spdx.Document) {},
+			id:       "SPDXRef-DOCUMENT",
+			field:    "SPDXVersion",
+			section:  "6.1",
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doc := example.Copy()
+			// the example has no license information from files for the Jena package
+			doc.Packages[2].PackageLicenseInfoFromFiles = []string{"NOASSERTION"}
+			doc.SPDXVersion = test.version
+			test.modify(&doc)
+
+			findings := Validate(&doc).Errors()
+			if !test.expected {
+				require.Empty(t, findings)
+				return
+			}
+			var found *Finding
+			for i := range findings {
+				if findings[i].ElementID == test.id && findings[i].Field == test.field {
+					found = &findings[i]
+				}
+			}
+			require.NotNil(t, found, "%v", findings)
+			require.Equal(t, test.section, found.Section)
+		})
+	}
+}
+
+func Test_ValidateSharedFile(t *testing.T) {
+	doc := example.Copy()
+	file := doc.Files[0]
+	file.FileName = ""
+	doc.Packages[0].Files = append(doc.Packages[0].Files, file)
+
+	// a file listed both in a package and in the document is validated once
+	var names Findings
+	for _, f := range Validate(&doc) {
+		if f.Field == "FileName" {
+			names = append(names, f)
+		}
+	}
+	require.Len(t, names, 1)
+}
+
+func Test_ValidateConvertedV3Package(t *testing.T) {
+	src := example.Copy()
+	var v3doc v3_0.Document
+	require.NoError(t, convert.Document(src, &v3doc))
+
+	// an SPDX 3 package without files or verification code
+	pkg := &v3_0.Package{}
+	pkg.SpdxID = src.DocumentNamespace + "#SPDXRef-native"
+	pkg.CreationInfo = v3doc.CreationInfo
+	pkg.Name = "native"
+	pkg.DownloadLocation = "https://example.com/native.tar.gz"
+	v3doc.Elements = append(v3doc.Elements, pkg)
+
+	var doc spdx.Document
+	require.NoError(t, convert.Document(v3doc, &doc))
+	var native *spdx.Package
+	for _, p := range doc.Packages {
+		if p.PackageSPDXIdentifier == "native" {
+			native = p
+		}
+	}
+	require.NotNil(t, native)
+	require.False(t, native.FilesAnalyzed)
+
+	findings := Validate(&doc)
+	require.False(t, findings.HasErrors(), findings.Err())
+	for _, f := range findings {
+		require.NotEqual(t, "SPDXRef-native", f.ElementID, f.String())
+	}
+}
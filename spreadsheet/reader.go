@@ -0,0 +1,551 @@
+// Package spreadsheet reads and writes SPDX 2.2 and 2.3 documents in the SPDX
+// spreadsheet format: an XLSX workbook with Document Info, Package Info,
+// External Refs, Extracted License Info, Per File Info, Relationships,
+// Annotations, Snippets and Reviewers sheets. Like the JSON format, files are
+// read as files of the document, with a CONTAINS relationship from the
+// package in their Package Identifier column.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+package spreadsheet
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spdx/tools-golang/convert"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/common"
+	v2common "github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+// Read takes an io.Reader and returns a fully-parsed current model SPDX Document
+// or an error if any error is encountered.
+func Read(content io.Reader) (*spdx.Document, error) {
+	doc := spdx.Document{}
+	err := ReadInto(content, &doc)
+	return &doc, err
+}
+
+// ReadInto takes an io.Reader, reads in the SPDX document at the version provided
+// and converts to the doc version
+func ReadInto(content io.Reader, doc common.AnyDocument) error {
+	if !convert.IsPtr(doc) {
+		return fmt.Errorf("doc to read into must be a pointer")
+	}
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	wb, err := readWorkbook(data)
+	if err != nil {
+		return err
+	}
+	latest, err := readDocument(wb)
+	if err != nil {
+		return err
+	}
+
+	if latest.SPDXVersion == v2_2.Version {
+		// the sheets are read into the latest model, which is converted to
+		// the version of the spreadsheet before the version to read into
+		var doc2_2 v2_2.Document
+		if err = convert.Document(latest, &doc2_2); err != nil {
+			return err
+		}
+		return convert.Document(doc2_2, doc)
+	}
+	return convert.Document(latest, doc)
+}
+
+// record is a row of a sheet, with its cells by the header of their column
+type record struct {
+	sheet string
+	row   int
+	cells map[string]string
+}
+
+func (r record) get(header string) string {
+	return r.cells[header]
+}
+
+// value returns the text of a cell without surrounding space, for values
+// such as identifiers
+func (r record) value(header string) string {
+	return strings.TrimSpace(r.cells[header])
+}
+
+func (r record) wrap(err error) error {
+	return fmt.Errorf("sheet %s row %d: %v", r.sheet, r.row, err)
+}
+
+// reader reads a document from the sheets of a workbook
+type reader struct {
+	wb  *workbook
+	doc *v2_3.Document
+	// relationships are the relationships given by the Document Contents
+	// and Package Identifier columns
+	relationships []*v2_3.Relationship
+}
+
+// records returns the rows of a sheet after its header, skipping empty rows
+func (r *reader) records(name string) []record {
+	s := r.wb.sheet(name)
+	if s == nil || len(s.rows) == 0 {
+		return nil
+	}
+	var records []record
+	for i, row := range s.rows[1:] {
+		rec := record{sheet: name, row: i + 2, cells: map[string]string{}}
+		empty := true
+		for j, text := range row {
+			if j >= len(s.rows[0]) || strings.TrimSpace(text) == "" {
+				continue
+			}
+			rec.cells[strings.TrimSpace(s.rows[0][j])] = text
+			empty = false
+		}
+		if !empty {
+			records = append(records, rec)
+		}
+	}
+	return records
+}
+
+// date returns the text of a date cell, which is a number of days when the
+// cell is formatted as a date
+func (r *reader) date(rec record, header string) string {
+	text := rec.value(header)
+	if t, ok := r.wb.date(text); ok {
+		return t.UTC().Format(time.RFC3339)
+	}
+	return text
+}
+
+// readDocument reads the sheets of a workbook into the latest model, with
+// the SPDX version of the spreadsheet
+func readDocument(wb *workbook) (*v2_3.Document, error) {
+	r := &reader{wb: wb, doc: &v2_3.Document{}}
+	for _, read := range []func() error{
+		r.readDocumentInfo,
+		r.readPackages,
+		r.readExternalRefs,
+		r.readExtractedLicenses,
+		r.readFiles,
+		r.readSnippets,
+		r.readRelationships,
+		r.readAnnotations,
+		r.readReviews,
+	} {
+		if err := read(); err != nil {
+			return nil, err
+		}
+	}
+	return r.doc, nil
+}
+
+func (r *reader) readDocumentInfo() error {
+	info := r.records(documentInfoSheet)
+	if len(info) == 0 {
+		return fmt.Errorf("spreadsheet does not contain a %s sheet", documentInfoSheet)
+	}
+
+	first := info[0]
+	version := first.value("SPDX Version")
+	if version != v2_2.Version && version != v2_3.Version {
+		return fmt.Errorf("unsupported SDPX version: %s", version)
+	}
+
+	id, err := parseElementID(first.value("SPDX Identifier"))
+	if err != nil {
+		return first.wrap(err)
+	}
+	doc := r.doc
+	doc.SPDXVersion = version
+	doc.DataLicense = first.value("Data License")
+	doc.SPDXIdentifier = id
+	doc.DocumentName = first.get("Document Name")
+	doc.DocumentNamespace = first.value("Document Namespace")
+	doc.DocumentComment = first.get("Document Comment")
+	doc.CreationInfo = &v2_3.CreationInfo{
+		LicenseListVersion: first.value("License List Version"),
+		Created:            r.date(first, "Created"),
+		CreatorComment:     first.get("Creator Comment"),
+	}
+
+	// the lists of the sheet continue on the rows after the first
+	for _, rec := range info {
+		if v := rec.value("Document Contents"); v != "" {
+			described, err := parseDocElementID(v)
+			if err != nil {
+				return rec.wrap(err)
+			}
+			r.relationships = append(r.relationships, &v2_3.Relationship{
+				RefA:         v2common.MakeDocElementID("", string(id)),
+				RefB:         described,
+				Relationship: v2common.TypeRelationshipDescribe,
+			})
+		}
+		if v := rec.value("External Document References"); v != "" {
+			// a reference is "DocumentRef-id URI SHA1: value"
+			fields := strings.Fields(v)
+			if len(fields) != 4 || !strings.HasSuffix(fields[2], ":") {
+				return rec.wrap(fmt.Errorf("failed to parse external document reference '%s'", v))
+			}
+			doc.ExternalDocumentReferences = append(doc.ExternalDocumentReferences, v2_3.ExternalDocumentRef{
+				DocumentRefID: fields[0],
+				URI:           fields[1],
+				Checksum: v2common.Checksum{
+					Algorithm: v2common.ChecksumAlgorithm(strings.TrimSuffix(fields[2], ":")),
+					Value:     fields[3],
+				},
+			})
+		}
+		if v := rec.value("Creator"); v != "" {
+			creatorType, creator, err := splitTyped(v)
+			if err != nil {
+				return rec.wrap(err)
+			}
+			doc.CreationInfo.Creators = append(doc.CreationInfo.Creators, v2common.Creator{
+				Creator:     creator,
+				CreatorType: creatorType,
+			})
+		}
+	}
+	return nil
+}
+
+func (r *reader) readPackages() error {
+	for _, rec := range r.records(packageInfoSheet) {
+		id, err := parseElementID(rec.value("SPDX Identifier"))
+		if err != nil {
+			return rec.wrap(err)
+		}
+		p := &v2_3.Package{
+			PackageName:                 rec.get("Package Name"),
+			PackageSPDXIdentifier:       id,
+			PackageVersion:              rec.value("Package Version"),
+			PackageFileName:             rec.value("Package FileName"),
+			PackageDownloadLocation:     rec.value("Package Download Location"),
+			FilesAnalyzed:               true,
+			PackageHomePage:             rec.value("Home Page"),
+			PackageSourceInfo:           rec.get("Source Info"),
+			PackageLicenseConcluded:     rec.value("License Concluded"),
+			PackageLicenseInfoFromFiles: splitList(rec.get("License Info From Files")),
+			PackageLicenseDeclared:      rec.value("License Declared"),
+			PackageLicenseComments:      rec.get("License Comments"),
+			PackageCopyrightText:        rec.get("Package Copyright Text"),
+			PackageSummary:              rec.get("Summary"),
+			PackageDescription:          rec.get("Description"),
+			PackageComment:              rec.get("Comments"),
+			PackageAttributionTexts:     splitQuoted(rec.get("Attribution Text")),
+			PrimaryPackagePurpose:       rec.value("Primary Package Purpose"),
+			ReleaseDate:                 r.date(rec, "Release Date"),
+			BuiltDate:                   r.date(rec, "Built Date"),
+			ValidUntilDate:              r.date(rec, "Valid Until Date"),
+		}
+
+		if v := rec.value("Package Supplier"); v == "NOASSERTION" {
+			p.PackageSupplier = &v2common.Supplier{Supplier: v}
+		} else if v != "" {
+			supplierType, supplier, err := splitTyped(v)
+			if err != nil {
+				return rec.wrap(err)
+			}
+			p.PackageSupplier = &v2common.Supplier{Supplier: supplier, SupplierType: supplierType}
+		}
+		if v := rec.value("Package Originator"); v == "NOASSERTION" {
+			p.PackageOriginator = &v2common.Originator{Originator: v}
+		} else if v != "" {
+			originatorType, originator, err := splitTyped(v)
+			if err != nil {
+				return rec.wrap(err)
+			}
+			p.PackageOriginator = &v2common.Originator{Originator: originator, OriginatorType: originatorType}
+		}
+		if p.PackageChecksums, err = parseChecksums(rec.get("Package Checksum")); err != nil {
+			return rec.wrap(err)
+		}
+		if v := rec.value("Package Verification Code"); v != "" {
+			p.PackageVerificationCode = &v2common.PackageVerificationCode{
+				Value:         v,
+				ExcludedFiles: splitList(rec.get("Verification Code Excluded Files")),
+			}
+		}
+		// FilesAnalyzed defaults to true if omitted
+		if v := rec.value("Files Analyzed"); v != "" {
+			if p.FilesAnalyzed, err = strconv.ParseBool(v); err != nil {
+				return rec.wrap(fmt.Errorf("failed to parse Files Analyzed '%s'", v))
+			}
+			p.IsFilesAnalyzedTagPresent = true
+		}
+
+		r.doc.Packages = append(r.doc.Packages, p)
+	}
+	return nil
+}
+
+func (r *reader) readExternalRefs() error {
+	for _, rec := range r.records(externalRefsSheet) {
+		id, err := parseElementID(rec.value("Package ID"))
+		if err != nil {
+			return rec.wrap(err)
+		}
+		p := r.pkg(id)
+		if p == nil {
+			return rec.wrap(fmt.Errorf("package %s not found", v2common.RenderElementID(id)))
+		}
+		p.PackageExternalReferences = append(p.PackageExternalReferences, &v2_3.PackageExternalReference{
+			Category:           strings.ReplaceAll(rec.value("Category"), "_", "-"),
+			RefType:            rec.value("Type"),
+			Locator:            rec.value("Locator"),
+			ExternalRefComment: rec.get("Comment"),
+		})
+	}
+	return nil
+}
+
+func (r *reader) readExtractedLicenses() error {
+	for _, rec := range r.records(extractedLicenseSheet) {
+		r.doc.OtherLicenses = append(r.doc.OtherLicenses, &v2_3.OtherLicense{
+			LicenseIdentifier:      rec.value("Identifier"),
+			ExtractedText:          rec.get("Extracted Text"),
+			LicenseName:            rec.get("License Name"),
+			LicenseCrossReferences: splitList(rec.get("Cross Reference URLs")),
+			LicenseComment:         rec.get("Comment"),
+		})
+	}
+	return nil
+}
+
+func (r *reader) readFiles() error {
+	for _, rec := range r.records(perFileInfoSheet) {
+		id, err := parseElementID(rec.value("SPDX Identifier"))
+		if err != nil {
+			return rec.wrap(err)
+		}
+		f := &v2_3.File{
+			FileName:             rec.value("File Name"),
+			FileSPDXIdentifier:   id,
+			FileTypes:            splitList(rec.get("File Type(s)")),
+			LicenseConcluded:     rec.value("License Concluded"),
+			LicenseInfoInFiles:   splitList(rec.get("License Info in File")),
+			LicenseComments:      rec.get("License Comments"),
+			FileCopyrightText:    rec.get("File Copyright Text"),
+			FileComment:          rec.get("File Comment"),
+			FileNotice:           rec.get("Notice Text"),
+			FileContributors:     splitQuoted(rec.get("Contributors")),
+			FileAttributionTexts: splitQuoted(rec.get("Attribution Text")),
+			FileDependencies:     splitList(rec.get("File Dependencies")),
+		}
+		if f.Checksums, err = parseChecksums(rec.get("File Checksum(s)")); err != nil {
+			return rec.wrap(err)
+		}
+
+		// the projects are lists in three columns, matched by position
+		names := splitList(rec.get("Artifact of Project"))
+		homePages := splitList(rec.get("Artifact of Homepage"))
+		uris := splitList(rec.get("Artifact of URL"))
+		for i := 0; i < len(names) || i < len(homePages) || i < len(uris); i++ {
+			f.ArtifactOfProjects = append(f.ArtifactOfProjects, &v2_3.ArtifactOfProject{
+				Name:     at(names, i),
+				HomePage: at(homePages, i),
+				URI:      at(uris, i),
+			})
+		}
+
+		// files are contained by the package given for them, like files
+		// listed in the hasFiles property of a JSON package
+		if v := rec.value("Package Identifier"); v != "" {
+			pkg, err := parseElementID(v)
+			if err != nil {
+				return rec.wrap(err)
+			}
+			r.relationships = append(r.relationships, &v2_3.Relationship{
+				RefA:         v2common.MakeDocElementID("", string(pkg)),
+				RefB:         v2common.MakeDocElementID("", string(id)),
+				Relationship: v2common.TypeRelationshipContains,
+			})
+		}
+
+		r.doc.Files = append(r.doc.Files, f)
+	}
+	return nil
+}
+
+func (r *reader) readSnippets() error {
+	for _, rec := range r.records(snippetsSheet) {
+		id, err := parseElementID(rec.value("ID"))
+		if err != nil {
+			return rec.wrap(err)
+		}
+		file, err := parseElementID(rec.value("From File ID"))
+		if err != nil {
+			return rec.wrap(err)
+		}
+		s := v2_3.Snippet{
+			SnippetSPDXIdentifier:         id,
+			SnippetFromFileSPDXIdentifier: file,
+			SnippetLicenseConcluded:       rec.value("License Concluded"),
+			LicenseInfoInSnippet:          splitList(rec.get("License Info in Snippet")),
+			SnippetLicenseComments:        rec.get("License Comments"),
+			SnippetCopyrightText:          rec.get("Snippet Copyright Text"),
+			SnippetComment:                rec.get("Comment"),
+			SnippetName:                   rec.get("Name"),
+			SnippetAttributionTexts:       splitQuoted(rec.get("Attribution Text")),
+		}
+		for _, v := range splitList(rec.get("Byte Range")) {
+			start, end, err := parseRange(v)
+			if err != nil {
+				return rec.wrap(err)
+			}
+			s.Ranges = append(s.Ranges, v2common.SnippetRange{
+				StartPointer: v2common.SnippetRangePointer{Offset: start, FileSPDXIdentifier: file},
+				EndPointer:   v2common.SnippetRangePointer{Offset: end, FileSPDXIdentifier: file},
+			})
+		}
+		for _, v := range splitList(rec.get("Line Range")) {
+			start, end, err := parseRange(v)
+			if err != nil {
+				return rec.wrap(err)
+			}
+			s.Ranges = append(s.Ranges, v2common.SnippetRange{
+				StartPointer: v2common.SnippetRangePointer{LineNumber: start, FileSPDXIdentifier: file},
+				EndPointer:   v2common.SnippetRangePointer{LineNumber: end, FileSPDXIdentifier: file},
+			})
+		}
+		r.doc.Snippets = append(r.doc.Snippets, s)
+	}
+	return nil
+}
+
+func (r *reader) readRelationships() error {
+	for _, rec := range r.records(relationshipsSheet) {
+		refA, err := parseDocElementID(rec.value("SPDX Identifier A"))
+		if err != nil {
+			return rec.wrap(err)
+		}
+		refB, err := parseDocElementID(rec.value("SPDX Identifier B"))
+		if err != nil {
+			return rec.wrap(err)
+		}
+		r.doc.Relationships = append(r.doc.Relationships, &v2_3.Relationship{
+			RefA:                refA,
+			RefB:                refB,
+			Relationship:        rec.value("Relationship"),
+			RelationshipComment: rec.get("Relationship Comment"),
+		})
+	}
+
+	// the relationships of the Document Contents and Package Identifier
+	// columns are added when the Relationships sheet does not have them
+	exists := map[string]bool{}
+	for _, rel := range r.doc.Relationships {
+		exists[relationshipKey(rel)] = true
+	}
+	for _, rel := range r.relationships {
+		if !exists[relationshipKey(rel)] {
+			r.doc.Relationships = append(r.doc.Relationships, rel)
+			exists[relationshipKey(rel)] = true
+		}
+	}
+	return nil
+}
+
+// relationshipKey returns the elements and type of a relationship, with
+// CONTAINED_BY and DESCRIBED_BY given as their opposites so that they match
+func relationshipKey(r *v2_3.Relationship) string {
+	refA, refB, rel := r.RefA, r.RefB, r.Relationship
+	switch rel {
+	case v2common.TypeRelationshipContainedBy:
+		refA, refB, rel = refB, refA, v2common.TypeRelationshipContains
+	case v2common.TypeRelationshipDescribeBy:
+		refA, refB, rel = refB, refA, v2common.TypeRelationshipDescribe
+	}
+	return fmt.Sprintf("%v-%v->%v", v2common.RenderDocElementID(refA), rel, v2common.RenderDocElementID(refB))
+}
+
+func (r *reader) readAnnotations() error {
+	for _, rec := range r.records(annotationsSheet) {
+		id, err := parseDocElementID(rec.value("SPDX Identifier being Annotated"))
+		if err != nil {
+			return rec.wrap(err)
+		}
+		annotatorType, annotator, err := splitTyped(rec.value("Annotator"))
+		if err != nil {
+			return rec.wrap(err)
+		}
+		a := v2_3.Annotation{
+			Annotator:         v2common.Annotator{Annotator: annotator, AnnotatorType: annotatorType},
+			AnnotationDate:    r.date(rec, "Annotation Date"),
+			AnnotationType:    rec.value("Annotation Type"),
+			AnnotationComment: rec.get("Annotation Comment"),
+		}
+
+		// annotations of the document, its packages and its files are given
+		// to them, as they are in JSON documents
+		if id.DocumentRefID == "" && id.SpecialID == "" {
+			if id.ElementRefID == r.doc.SPDXIdentifier {
+				r.doc.Annotations = append(r.doc.Annotations, &a)
+				continue
+			}
+			if p := r.pkg(id.ElementRefID); p != nil {
+				p.Annotations = append(p.Annotations, a)
+				continue
+			}
+			if f := r.file(id.ElementRefID); f != nil {
+				f.Annotations = append(f.Annotations, a)
+				continue
+			}
+		}
+		a.AnnotationSPDXIdentifier = id
+		r.doc.Annotations = append(r.doc.Annotations, &a)
+	}
+	return nil
+}
+
+func (r *reader) readReviews() error {
+	for _, rec := range r.records(reviewersSheet) {
+		reviewerType, reviewer, err := splitTyped(rec.value("Reviewer"))
+		if err != nil {
+			return rec.wrap(err)
+		}
+		r.doc.Reviews = append(r.doc.Reviews, &v2_3.Review{
+			Reviewer:      reviewer,
+			ReviewerType:  reviewerType,
+			ReviewDate:    r.date(rec, "Review Date"),
+			ReviewComment: rec.get("Reviewer Comment"),
+		})
+	}
+	return nil
+}
+
+func (r *reader) pkg(id v2common.ElementID) *v2_3.Package {
+	for _, p := range r.doc.Packages {
+		if p.PackageSPDXIdentifier == id {
+			return p
+		}
+	}
+	return nil
+}
+
+func (r *reader) file(id v2common.ElementID) *v2_3.File {
+	for _, f := range r.doc.Files {
+		if f.FileSPDXIdentifier == id {
+			return f
+		}
+	}
+	return nil
+}
+
+// at returns the value at an index of a list, or the empty string
+func at(values []string, i int) string {
+	if i < len(values) {
+		return values[i]
+	}
+	return ""
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spreadsheet
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/json"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+func Test_ReadSample(t *testing.T) {
+	f, err := os.Open("../examples/sample-docs/xls/SPDXSpreadsheetExample-v2.2.xlsx")
+	require.NoError(t, err)
+	defer f.Close()
+
+	got, err := Read(f)
+	require.NoError(t, err)
+
+	f, err = os.Open("../examples/sample-docs/json/SPDXJSONExample-v2.2.spdx.json")
+	require.NoError(t, err)
+	defer f.Close()
+
+	want, err := json.Read(f)
+	require.NoError(t, err)
+
+	// the spreadsheet example states that the Jena package files were not
+	// analyzed, which the JSON example leaves out, and gives the type of the
+	// Acme reference without the document namespace
+	for _, pkg := range want.Packages {
+		if pkg.PackageSPDXIdentifier == "fromDoap-0" {
+			pkg.FilesAnalyzed = false
+			pkg.IsFilesAnalyzedTagPresent = true
+		}
+		for _, ref := range pkg.PackageExternalReferences {
+			ref.RefType = strings.TrimPrefix(ref.RefType, want.DocumentNamespace+"#")
+		}
+	}
+
+	spdxlib.Canonicalize(want)
+	spdxlib.Canonicalize(got)
+	require.Equal(t, want, got)
+}
+
+func Test_ReadInto(t *testing.T) {
+	f, err := os.Open("../examples/sample-docs/xls/SPDXSpreadsheetExample-v2.2.xlsx")
+	require.NoError(t, err)
+	defer f.Close()
+
+	doc := v2_2.Document{}
+	require.NoError(t, ReadInto(f, &doc))
+	require.Equal(t, v2_2.Version, doc.SPDXVersion)
+	// the creation date is a date cell
+	require.Equal(t, "2010-01-29T18:30:22Z", doc.CreationInfo.Created)
+
+	require.Error(t, ReadInto(bytes.NewReader(nil), doc))
+}
+
+func Test_ReadErrors(t *testing.T) {
+	write := func(sheets ...*sheet) *bytes.Reader {
+		buf := new(bytes.Buffer)
+		require.NoError(t, writeWorkbook(buf, sheets))
+		return bytes.NewReader(buf.Bytes())
+	}
+	info := func(rows ...[]string) *sheet {
+		return &sheet{name: documentInfoSheet, rows: append([][]string{{"SPDX Version", "SPDX Identifier", "Creator"}}, rows...)}
+	}
+
+	tests := []struct {
+		name    string
+		content *bytes.Reader
+	}{
+		{"not a zip file", bytes.NewReader([]byte("SPDXVersion: SPDX-2.2"))},
+		{"no document info", write(&sheet{name: packageInfoSheet})},
+		{"unsupported version", write(info([]string{"SPDX-2.1", "SPDXRef-DOCUMENT"}))},
+		{"invalid identifier", write(info([]string{"SPDX-2.3", "DOCUMENT"}))},
+		{"invalid creator", write(info([]string{"SPDX-2.3", "SPDXRef-DOCUMENT", "Jane Doe"}))},
+		{"invalid files analyzed", write(info([]string{"SPDX-2.3", "SPDXRef-DOCUMENT"}), &sheet{
+			name: packageInfoSheet,
+			rows: [][]string{{"SPDX Identifier", "Files Analyzed"}, {"SPDXRef-Package", "maybe"}},
+		})},
+		{"unknown package", write(info([]string{"SPDX-2.3", "SPDXRef-DOCUMENT"}), &sheet{
+			name: externalRefsSheet,
+			rows: [][]string{{"Package ID", "Category"}, {"SPDXRef-Package", "OTHER"}},
+		})},
+		{"invalid range", write(info([]string{"SPDX-2.3", "SPDXRef-DOCUMENT"}), &sheet{
+			name: snippetsSheet,
+			rows: [][]string{{"ID", "From File ID", "Byte Range"}, {"SPDXRef-Snippet", "SPDXRef-File", "310"}},
+		})},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Read(test.content)
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_Columns(t *testing.T) {
+	for i, name := range []string{"A", "Z", "AA", "AZ", "BA", "ZZ", "AAA"} {
+		col := []int{0, 25, 26, 51, 52, 701, 702}[i]
+		require.Equal(t, name, columnName(col))
+		got, err := column(name + "12")
+		require.NoError(t, err)
+		require.Equal(t, col, got)
+	}
+	_, err := column("12")
+	require.Error(t, err)
+}
+
+func Test_Escape(t *testing.T) {
+	text := "a\x01b_x0041_c\n"
+	require.Equal(t, "a_x0001_b_x005F_x0041_c\n", escape(text))
+	require.Equal(t, text, unescape(escape(text)))
+}
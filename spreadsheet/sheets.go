@@ -0,0 +1,300 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v2common "github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// the sheets of the SPDX spreadsheet format, with the headers of their
+// columns in the order they are written. Columns are read by their headers,
+// so they may be in any order, and other columns are ignored.
+const (
+	documentInfoSheet     = "Document Info"
+	packageInfoSheet      = "Package Info"
+	externalRefsSheet     = "External Refs"
+	extractedLicenseSheet = "Extracted License Info"
+	perFileInfoSheet      = "Per File Info"
+	relationshipsSheet    = "Relationships"
+	annotationsSheet      = "Annotations"
+	snippetsSheet         = "Snippets"
+	reviewersSheet        = "Reviewers"
+)
+
+var documentInfoColumns = []string{
+	"Spreadsheet Version",
+	"SPDX Version",
+	"Data License",
+	"SPDX Identifier",
+	"License List Version",
+	"Document Name",
+	"Document Namespace",
+	"Document Contents",
+	"External Document References",
+	"Document Comment",
+	"Creator",
+	"Created",
+	"Creator Comment",
+}
+
+var packageInfoColumns = []string{
+	"Package Name",
+	"SPDX Identifier",
+	"Package Version",
+	"Package FileName",
+	"Package Supplier",
+	"Package Originator",
+	"Home Page",
+	"Package Download Location",
+	"Package Checksum",
+	"Package Verification Code",
+	"Verification Code Excluded Files",
+	"Source Info",
+	"License Declared",
+	"License Concluded",
+	"License Info From Files",
+	"License Comments",
+	"Package Copyright Text",
+	"Summary",
+	"Description",
+	"Attribution Text",
+	"Files Analyzed",
+	"Comments",
+}
+
+// packageInfoColumnsV2_3 are the columns of the Package Info sheet for the
+// package properties added in SPDX 2.3
+var packageInfoColumnsV2_3 = []string{
+	"Primary Package Purpose",
+	"Release Date",
+	"Built Date",
+	"Valid Until Date",
+}
+
+var externalRefsColumns = []string{
+	"Package ID",
+	"Category",
+	"Type",
+	"Locator",
+	"Comment",
+}
+
+var extractedLicenseColumns = []string{
+	"Identifier",
+	"Extracted Text",
+	"License Name",
+	"Cross Reference URLs",
+	"Comment",
+}
+
+var perFileInfoColumns = []string{
+	"File Name",
+	"SPDX Identifier",
+	"Package Identifier",
+	"File Type(s)",
+	"File Checksum(s)",
+	"License Concluded",
+	"License Info in File",
+	"License Comments",
+	"File Copyright Text",
+	"Notice Text",
+	"Artifact of Project",
+	"Artifact of Homepage",
+	"Artifact of URL",
+	"Contributors",
+	"File Comment",
+	"File Dependencies",
+	"Attribution Text",
+}
+
+var relationshipsColumns = []string{
+	"SPDX Identifier A",
+	"Relationship",
+	"SPDX Identifier B",
+	"Relationship Comment",
+}
+
+var annotationsColumns = []string{
+	"SPDX Identifier being Annotated",
+	"Annotation Comment",
+	"Annotation Date",
+	"Annotator",
+	"Annotation Type",
+}
+
+var snippetsColumns = []string{
+	"ID",
+	"Name",
+	"From File ID",
+	"Byte Range",
+	"Line Range",
+	"License Concluded",
+	"License Info in Snippet",
+	"License Comments",
+	"Snippet Copyright Text",
+	"Comment",
+	"Attribution Text",
+}
+
+var reviewersColumns = []string{
+	"Reviewer",
+	"Review Date",
+	"Reviewer Comment",
+}
+
+// splitList returns the values of a comma separated list
+func splitList(text string) []string {
+	var values []string
+	for _, v := range strings.Split(text, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func joinList(values []string) string {
+	return strings.Join(values, ", ")
+}
+
+// splitLines returns the values of a list with a value on each line
+func splitLines(text string) []string {
+	var values []string
+	for _, v := range strings.Split(text, "\n") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func joinLines(values []string) string {
+	return strings.Join(values, "\n")
+}
+
+// splitQuoted returns the values of a comma separated list of quoted text,
+// which is used for values that may contain commas, such as attribution
+// texts. Text that is not a quoted list is read as one value.
+func splitQuoted(text string) []string {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	r := csv.NewReader(strings.NewReader(text))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil || len(records) != 1 {
+		return []string{text}
+	}
+	var values []string
+	for _, v := range records[0] {
+		if v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func joinQuoted(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = `"` + strings.ReplaceAll(v, `"`, `""`) + `"`
+	}
+	return strings.Join(quoted, ",")
+}
+
+// splitTyped returns the type and value of text in the form "Type: value",
+// such as "Person: Jane Doe"
+func splitTyped(text string) (string, string, error) {
+	fields := strings.SplitN(strings.TrimSpace(text), ": ", 2)
+	if len(fields) != 2 {
+		return "", "", fmt.Errorf("failed to parse '%s': expected 'Type: value'", text)
+	}
+	return fields[0], fields[1], nil
+}
+
+func joinTyped(typ string, value string) string {
+	if typ == "" {
+		return value
+	}
+	return typ + ": " + value
+}
+
+// parseChecksums reads checksums in the form "SHA1: value", one on each line
+func parseChecksums(text string) ([]v2common.Checksum, error) {
+	var checksums []v2common.Checksum
+	for _, line := range splitLines(text) {
+		algorithm, value, err := splitTyped(line)
+		if err != nil {
+			return nil, err
+		}
+		checksums = append(checksums, v2common.Checksum{
+			Algorithm: v2common.ChecksumAlgorithm(algorithm),
+			Value:     value,
+		})
+	}
+	return checksums, nil
+}
+
+func formatChecksums(checksums []v2common.Checksum) string {
+	lines := make([]string, len(checksums))
+	for i, c := range checksums {
+		lines[i] = joinTyped(string(c.Algorithm), c.Value)
+	}
+	return joinLines(lines)
+}
+
+// parseElementID reads an SPDXRef- identifier
+func parseElementID(text string) (v2common.ElementID, error) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "SPDXRef-") {
+		return "", fmt.Errorf("failed to parse SPDX identifier '%s'", text)
+	}
+	return v2common.ElementID(strings.TrimPrefix(text, "SPDXRef-")), nil
+}
+
+// parseDocElementID reads an identifier which may be in another document,
+// or the special values NONE and NOASSERTION
+func parseDocElementID(text string) (v2common.DocElementID, error) {
+	text = strings.TrimSpace(text)
+	if text == "NONE" || text == "NOASSERTION" {
+		return v2common.MakeDocElementSpecial(text), nil
+	}
+	docRef := ""
+	if strings.HasPrefix(text, "DocumentRef-") {
+		fields := strings.SplitN(strings.TrimPrefix(text, "DocumentRef-"), ":", 2)
+		if len(fields) != 2 {
+			return v2common.DocElementID{}, fmt.Errorf("failed to parse SPDX identifier '%s'", text)
+		}
+		docRef, text = fields[0], fields[1]
+	}
+	id, err := parseElementID(text)
+	if err != nil {
+		return v2common.DocElementID{}, err
+	}
+	return v2common.MakeDocElementID(docRef, string(id)), nil
+}
+
+// parseRange reads a range in the form "start:end"
+func parseRange(text string) (int, int, error) {
+	fields := strings.SplitN(text, ":", 2)
+	if len(fields) == 2 {
+		start, err := strconv.Atoi(strings.TrimSpace(fields[0]))
+		if err == nil {
+			end, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+			if err == nil {
+				return start, end, nil
+			}
+		}
+	}
+	return 0, 0, fmt.Errorf("failed to parse range '%s': expected 'start:end'", text)
+}
+
+func formatRange(start int, end int) string {
+	return fmt.Sprintf("%d:%d", start, end)
+}
@@ -0,0 +1,404 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sheet is a worksheet of a workbook, as the text of its cells by row and
+// column
+type sheet struct {
+	name string
+	rows [][]string
+}
+
+// workbook is the worksheets of an XLSX file
+type workbook struct {
+	sheets []*sheet
+	// date1904 is set when dates are counted from 1904 rather than 1900
+	date1904 bool
+}
+
+// sheet returns the worksheet with the name, or nil
+func (wb *workbook) sheet(name string) *sheet {
+	for _, s := range wb.sheets {
+		if s.name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// date returns the time of a date stored as a number of days, or false if the
+// text is not a number
+func (wb *workbook) date(text string) (time.Time, bool) {
+	days, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	epoch := time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+	if wb.date1904 {
+		epoch = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	d := time.Duration(days * float64(24*time.Hour))
+	return epoch.Add(d).Round(time.Second), true
+}
+
+const (
+	relationshipsNamespace = "http://schemas.openxmlformats.org/officeDocument/2006/relationships"
+	officeDocumentType     = relationshipsNamespace + "/officeDocument"
+	worksheetType          = relationshipsNamespace + "/worksheet"
+	sharedStringsType      = relationshipsNamespace + "/sharedStrings"
+	stylesType             = relationshipsNamespace + "/styles"
+	spreadsheetNamespace   = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
+	packageRelsNamespace   = "http://schemas.openxmlformats.org/package/2006/relationships"
+	contentTypesNamespace  = "http://schemas.openxmlformats.org/package/2006/content-types"
+)
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Type   string `xml:"Type,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxWorkbook struct {
+	Properties struct {
+		Date1904 string `xml:"date1904,attr"`
+	} `xml:"workbookPr"`
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+		ID   string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+// xlsxString is a shared or inline string: either plain text, or runs of
+// formatted text
+type xlsxString struct {
+	T    *string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (s *xlsxString) text() string {
+	var b strings.Builder
+	if s.T != nil {
+		b.WriteString(*s.T)
+	}
+	for _, r := range s.Runs {
+		b.WriteString(r.T)
+	}
+	return unescape(b.String())
+}
+
+type xlsxSharedStrings struct {
+	Strings []xlsxString `xml:"si"`
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R      string      `xml:"r,attr"`
+			T      string      `xml:"t,attr"`
+			V      string      `xml:"v"`
+			Inline *xlsxString `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// readWorkbook reads the worksheets of an XLSX file
+func readWorkbook(data []byte) (*workbook, error) {
+	z, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("not a valid XLSX file: %v", err)
+	}
+	parts := map[string]*zip.File{}
+	for _, f := range z.File {
+		parts[f.Name] = f
+	}
+	readPart := func(name string, v interface{}) error {
+		f, ok := parts[name]
+		if !ok {
+			return fmt.Errorf("XLSX file does not contain %s", name)
+		}
+		r, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		if err = xml.NewDecoder(r).Decode(v); err != nil {
+			return fmt.Errorf("failed to read %s: %v", name, err)
+		}
+		return nil
+	}
+
+	// the package relationships give the workbook part
+	workbookName := "xl/workbook.xml"
+	var rels xlsxRelationships
+	if err = readPart("_rels/.rels", &rels); err != nil {
+		return nil, err
+	}
+	for _, rel := range rels.Relationships {
+		if rel.Type == officeDocumentType {
+			workbookName = target("", rel.Target)
+		}
+	}
+
+	var xwb xlsxWorkbook
+	if err = readPart(workbookName, &xwb); err != nil {
+		return nil, err
+	}
+	dir := path.Dir(workbookName)
+	relsName := path.Join(dir, "_rels", path.Base(workbookName)+".rels")
+	if err = readPart(relsName, &rels); err != nil {
+		return nil, err
+	}
+	targets := map[string]string{}
+	var sharedStrings []string
+	for _, rel := range rels.Relationships {
+		targets[rel.ID] = target(dir, rel.Target)
+		if rel.Type == sharedStringsType {
+			var sst xlsxSharedStrings
+			if err = readPart(target(dir, rel.Target), &sst); err != nil {
+				return nil, err
+			}
+			for i := range sst.Strings {
+				sharedStrings = append(sharedStrings, sst.Strings[i].text())
+			}
+		}
+	}
+
+	wb := &workbook{date1904: xwb.Properties.Date1904 == "true" || xwb.Properties.Date1904 == "1"}
+	for _, s := range xwb.Sheets {
+		var ws xlsxWorksheet
+		if err = readPart(targets[s.ID], &ws); err != nil {
+			return nil, err
+		}
+		sh := &sheet{name: s.Name}
+		for i, row := range ws.Rows {
+			r := i
+			if row.R > 0 {
+				r = row.R - 1
+			}
+			for r >= len(sh.rows) {
+				sh.rows = append(sh.rows, nil)
+			}
+			for j, c := range row.Cells {
+				col := j
+				if c.R != "" {
+					if col, err = column(c.R); err != nil {
+						return nil, err
+					}
+				}
+				text := c.V
+				switch c.T {
+				case "s":
+					n, err := strconv.Atoi(strings.TrimSpace(c.V))
+					if err != nil || n < 0 || n >= len(sharedStrings) {
+						return nil, fmt.Errorf("invalid shared string in cell %s of sheet %s", c.R, s.Name)
+					}
+					text = sharedStrings[n]
+				case "inlineStr":
+					text = ""
+					if c.Inline != nil {
+						text = c.Inline.text()
+					}
+				case "b":
+					text = strconv.FormatBool(strings.TrimSpace(c.V) == "1")
+				case "str":
+					text = unescape(c.V)
+				}
+				for col >= len(sh.rows[r]) {
+					sh.rows[r] = append(sh.rows[r], "")
+				}
+				sh.rows[r][col] = text
+			}
+		}
+		wb.sheets = append(wb.sheets, sh)
+	}
+	return wb, nil
+}
+
+// target returns the name of the part a relationship of a part in dir
+// targets
+func target(dir string, t string) string {
+	if strings.HasPrefix(t, "/") {
+		return strings.TrimPrefix(t, "/")
+	}
+	return path.Join(dir, t)
+}
+
+// column returns the index of the column of a cell reference, such as 1 for
+// "B3"
+func column(ref string) (int, error) {
+	col := 0
+	i := 0
+	for ; i < len(ref) && ref[i] >= 'A' && ref[i] <= 'Z'; i++ {
+		col = col*26 + int(ref[i]-'A') + 1
+	}
+	if i == 0 {
+		return 0, fmt.Errorf("invalid cell reference %s", ref)
+	}
+	return col - 1, nil
+}
+
+// columnName returns the letters of the column with the index, such as "AA"
+// for 26
+func columnName(col int) string {
+	name := ""
+	for col++; col > 0; col = (col - 1) / 26 {
+		name = string(rune('A'+(col-1)%26)) + name
+	}
+	return name
+}
+
+var escaped = regexp.MustCompile(`_x[0-9A-Fa-f]{4}_`)
+
+// unescape returns text with the characters that XLSX escapes as _xHHHH_
+// restored
+func unescape(text string) string {
+	if !strings.Contains(text, "_x") {
+		return text
+	}
+	return escaped.ReplaceAllStringFunc(text, func(s string) string {
+		r, _ := strconv.ParseUint(s[2:6], 16, 16)
+		return string(rune(r))
+	})
+}
+
+// escape returns text with the characters that XML cannot hold, and text
+// that would be unescaped, escaped as _xHHHH_
+func escape(text string) string {
+	var b strings.Builder
+	for i, r := range text {
+		switch {
+		case r == '_' && escaped.MatchString(text[i:min(i+7, len(text))]):
+			b.WriteString("_x005F_")
+		case r < 0x20 && r != '\t' && r != '\n' && r != '\r', r == 0xFFFE, r == 0xFFFF:
+			fmt.Fprintf(&b, "_x%04X_", r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// xmlText returns text escaped for XML character data or attribute values
+func xmlText(text string) string {
+	var b bytes.Buffer
+	_ = xml.EscapeText(&b, []byte(text))
+	// line breaks need no escaping in character data, and keep cells readable
+	return strings.ReplaceAll(b.String(), "&#xA;", "\n")
+}
+
+// writeWorkbook writes the worksheets as an XLSX file, with the first row of
+// each sheet in bold as its header
+func writeWorkbook(w io.Writer, sheets []*sheet) error {
+	z := zip.NewWriter(w)
+	writePart := func(name string, content string) error {
+		// parts have no modification time, so the same sheets are written
+		// as the same bytes
+		f, err := z.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate})
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(f, xml.Header+content)
+		return err
+	}
+
+	var contentTypes, workbook, workbookRels strings.Builder
+	contentTypes.WriteString(`<Types xmlns="` + contentTypesNamespace + `">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	workbook.WriteString(`<workbook xmlns="` + spreadsheetNamespace + `" xmlns:r="` + relationshipsNamespace + `"><sheets>`)
+	workbookRels.WriteString(`<Relationships xmlns="` + packageRelsNamespace + `">`)
+	for i, s := range sheets {
+		n := strconv.Itoa(i + 1)
+		contentTypes.WriteString(`<Override PartName="/xl/worksheets/sheet` + n + `.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`)
+		workbook.WriteString(`<sheet name="` + xmlText(s.name) + `" sheetId="` + n + `" r:id="rId` + n + `"/>`)
+		workbookRels.WriteString(`<Relationship Id="rId` + n + `" Type="` + worksheetType + `" Target="worksheets/sheet` + n + `.xml"/>`)
+	}
+	contentTypes.WriteString(`</Types>`)
+	workbook.WriteString(`</sheets></workbook>`)
+	workbookRels.WriteString(`<Relationship Id="rId` + strconv.Itoa(len(sheets)+1) + `" Type="` + stylesType + `" Target="styles.xml"/></Relationships>`)
+
+	err := writePart("[Content_Types].xml", contentTypes.String())
+	if err == nil {
+		err = writePart("_rels/.rels", `<Relationships xmlns="`+packageRelsNamespace+`">`+
+			`<Relationship Id="rId1" Type="`+officeDocumentType+`" Target="xl/workbook.xml"/></Relationships>`)
+	}
+	if err == nil {
+		err = writePart("xl/workbook.xml", workbook.String())
+	}
+	if err == nil {
+		err = writePart("xl/_rels/workbook.xml.rels", workbookRels.String())
+	}
+	if err == nil {
+		err = writePart("xl/styles.xml", styles)
+	}
+	for i, s := range sheets {
+		if err != nil {
+			break
+		}
+		err = writePart(fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), worksheet(s))
+	}
+	if err != nil {
+		return err
+	}
+	return z.Close()
+}
+
+// styles has the default cell style, and a bold style for headers
+const styles = `<styleSheet xmlns="` + spreadsheetNamespace + `">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+	`</styleSheet>`
+
+// worksheet returns the XML of a sheet, with its text as inline strings
+func worksheet(s *sheet) string {
+	var b strings.Builder
+	b.WriteString(`<worksheet xmlns="` + spreadsheetNamespace + `"><sheetData>`)
+	for i, row := range s.rows {
+		r := strconv.Itoa(i + 1)
+		b.WriteString(`<row r="` + r + `">`)
+		for j, text := range row {
+			if text == "" {
+				continue
+			}
+			b.WriteString(`<c r="` + columnName(j) + r + `" t="inlineStr"`)
+			if i == 0 {
+				b.WriteString(` s="1"`)
+			}
+			b.WriteString(`><is><t xml:space="preserve">` + xmlText(escape(text)) + `</t></is></c>`)
+		}
+		b.WriteString(`</row>`)
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
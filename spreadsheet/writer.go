@@ -0,0 +1,385 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spreadsheet
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spdx/tools-golang/convert"
+	"github.com/spdx/tools-golang/spdx/common"
+	v2common "github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+type WriteOption func(*writer)
+
+type writer struct {
+	canonical bool
+}
+
+// Canonical writes the document as returned by spdxlib.Canonical
+func Canonical() WriteOption {
+	return func(w *writer) {
+		w.canonical = true
+	}
+}
+
+// Write takes an SPDX 2.2 or 2.3 Document and an io.Writer, and writes the
+// document to the writer as an XLSX workbook with the sheets of the SPDX
+// spreadsheet format.
+func Write(doc common.AnyDocument, w io.Writer, opts ...WriteOption) error {
+	if doc == nil {
+		return fmt.Errorf("nil document")
+	}
+	wr := &writer{}
+	for _, opt := range opts {
+		opt(wr)
+	}
+	if wr.canonical {
+		var err error
+		if doc, err = spdxlib.Canonical(doc); err != nil {
+			return err
+		}
+	}
+
+	var version string
+	switch convert.FromPtr(doc).(type) {
+	case v2_2.Document:
+		version = v2_2.Version
+	case v2_3.Document:
+		version = v2_3.Version
+	default:
+		return fmt.Errorf("unsupported document type for spreadsheet: %s", convert.Describe(doc))
+	}
+
+	// the sheets are written from the latest model, with the columns of the
+	// version of the document
+	latest := v2_3.Document{}
+	if err := convert.Document(doc, &latest); err != nil {
+		return err
+	}
+	return writeWorkbook(w, sheets(&latest, version))
+}
+
+// table builds a sheet from rows given by the headers of their columns
+type table struct {
+	sheet   *sheet
+	columns map[string]int
+}
+
+func newTable(name string, columns ...[]string) *table {
+	t := &table{sheet: &sheet{name: name, rows: [][]string{nil}}, columns: map[string]int{}}
+	for _, c := range columns {
+		for _, header := range c {
+			t.columns[header] = len(t.sheet.rows[0])
+			t.sheet.rows[0] = append(t.sheet.rows[0], header)
+		}
+	}
+	return t
+}
+
+// add adds a row; cells for columns the sheet does not have are not written
+func (t *table) add(cells map[string]string) {
+	row := make([]string, len(t.sheet.rows[0]))
+	for header, text := range cells {
+		if i, ok := t.columns[header]; ok {
+			row[i] = text
+		}
+	}
+	t.sheet.rows = append(t.sheet.rows, row)
+}
+
+// sheets returns the sheets of a document in the SPDX spreadsheet format of
+// the version
+func sheets(doc *v2_3.Document, version string) []*sheet {
+	docID := v2common.MakeDocElementID("", string(doc.SPDXIdentifier))
+	packages := map[v2common.ElementID]bool{}
+	for _, p := range doc.Packages {
+		packages[p.PackageSPDXIdentifier] = true
+	}
+
+	// the described elements are listed in the Document Contents column,
+	// and the package that contains a file in its Package Identifier column
+	var described []string
+	containedBy := map[v2common.ElementID]v2common.ElementID{}
+	contains := func(pkg v2common.DocElementID, file v2common.DocElementID) {
+		if pkg.DocumentRefID == "" && file.DocumentRefID == "" && packages[pkg.ElementRefID] {
+			if _, ok := containedBy[file.ElementRefID]; !ok {
+				containedBy[file.ElementRefID] = pkg.ElementRefID
+			}
+		}
+	}
+	for _, r := range doc.Relationships {
+		switch {
+		case r.Relationship == v2common.TypeRelationshipDescribe && r.RefA == docID:
+			described = append(described, v2common.RenderDocElementID(r.RefB))
+		case r.Relationship == v2common.TypeRelationshipDescribeBy && r.RefB == docID:
+			described = append(described, v2common.RenderDocElementID(r.RefA))
+		case r.Relationship == v2common.TypeRelationshipContains:
+			contains(r.RefA, r.RefB)
+		case r.Relationship == v2common.TypeRelationshipContainedBy:
+			contains(r.RefB, r.RefA)
+		}
+	}
+
+	info := newTable(documentInfoSheet, documentInfoColumns)
+	var references, creators []string
+	for _, ref := range doc.ExternalDocumentReferences {
+		references = append(references, fmt.Sprintf("%s %s %s", ref.DocumentRefID, ref.URI,
+			joinTyped(string(ref.Checksum.Algorithm), ref.Checksum.Value)))
+	}
+	creationInfo := doc.CreationInfo
+	if creationInfo == nil {
+		creationInfo = &v2_3.CreationInfo{}
+	}
+	for _, c := range creationInfo.Creators {
+		creators = append(creators, joinTyped(c.CreatorType, c.Creator))
+	}
+	for i := 0; i == 0 || i < len(described) || i < len(references) || i < len(creators); i++ {
+		cells := map[string]string{
+			"Document Contents":            at(described, i),
+			"External Document References": at(references, i),
+			"Creator":                      at(creators, i),
+		}
+		if i == 0 {
+			cells["Spreadsheet Version"] = strings.TrimPrefix(version, "SPDX-") + ".0"
+			cells["SPDX Version"] = version
+			cells["Data License"] = doc.DataLicense
+			cells["SPDX Identifier"] = v2common.RenderElementID(doc.SPDXIdentifier)
+			cells["License List Version"] = creationInfo.LicenseListVersion
+			cells["Document Name"] = doc.DocumentName
+			cells["Document Namespace"] = doc.DocumentNamespace
+			cells["Document Comment"] = doc.DocumentComment
+			cells["Created"] = creationInfo.Created
+			cells["Creator Comment"] = creationInfo.CreatorComment
+		}
+		info.add(cells)
+	}
+
+	packageColumns := [][]string{packageInfoColumns}
+	if version == v2_3.Version {
+		packageColumns = append(packageColumns, packageInfoColumnsV2_3)
+	}
+	pkgs := newTable(packageInfoSheet, packageColumns...)
+	refs := newTable(externalRefsSheet, externalRefsColumns)
+	files := newTable(perFileInfoSheet, perFileInfoColumns)
+	annotations := newTable(annotationsSheet, annotationsColumns)
+	snippets := newTable(snippetsSheet, snippetsColumns)
+
+	for _, a := range doc.Annotations {
+		id := a.AnnotationSPDXIdentifier
+		if id == (v2common.DocElementID{}) {
+			id = docID
+		}
+		addAnnotation(annotations, id, *a)
+	}
+
+	snippetIDs := map[v2common.ElementID]bool{}
+	for _, s := range doc.Snippets {
+		snippetIDs[s.SnippetSPDXIdentifier] = true
+	}
+
+	addFile := func(f *v2_3.File, pkg v2common.ElementID) {
+		cells := map[string]string{
+			"File Name":            f.FileName,
+			"SPDX Identifier":      v2common.RenderElementID(f.FileSPDXIdentifier),
+			"File Type(s)":         joinList(f.FileTypes),
+			"File Checksum(s)":     formatChecksums(f.Checksums),
+			"License Concluded":    f.LicenseConcluded,
+			"License Info in File": joinList(f.LicenseInfoInFiles),
+			"License Comments":     f.LicenseComments,
+			"File Copyright Text":  f.FileCopyrightText,
+			"Notice Text":          f.FileNotice,
+			"Contributors":         joinQuoted(f.FileContributors),
+			"File Comment":         f.FileComment,
+			"File Dependencies":    joinList(f.FileDependencies),
+			"Attribution Text":     joinQuoted(f.FileAttributionTexts),
+		}
+		if pkg == "" {
+			pkg = containedBy[f.FileSPDXIdentifier]
+		}
+		if pkg != "" {
+			cells["Package Identifier"] = v2common.RenderElementID(pkg)
+		}
+		var names, homePages, uris []string
+		for _, a := range f.ArtifactOfProjects {
+			names = append(names, a.Name)
+			homePages = append(homePages, a.HomePage)
+			uris = append(uris, a.URI)
+		}
+		cells["Artifact of Project"] = joinList(names)
+		cells["Artifact of Homepage"] = joinList(homePages)
+		cells["Artifact of URL"] = joinList(uris)
+		files.add(cells)
+
+		id := v2common.MakeDocElementID("", string(f.FileSPDXIdentifier))
+		for _, a := range f.Annotations {
+			addAnnotation(annotations, id, a)
+		}
+
+		// snippets of the file which are not snippets of the document
+		var ids []string
+		for sid := range f.Snippets {
+			if !snippetIDs[sid] {
+				ids = append(ids, string(sid))
+			}
+		}
+		sort.Strings(ids)
+		for _, sid := range ids {
+			addSnippet(snippets, f.Snippets[v2common.ElementID(sid)])
+		}
+	}
+
+	for _, p := range doc.Packages {
+		cells := map[string]string{
+			"Package Name":              p.PackageName,
+			"SPDX Identifier":           v2common.RenderElementID(p.PackageSPDXIdentifier),
+			"Package Version":           p.PackageVersion,
+			"Package FileName":          p.PackageFileName,
+			"Home Page":                 p.PackageHomePage,
+			"Package Download Location": p.PackageDownloadLocation,
+			"Package Checksum":          formatChecksums(p.PackageChecksums),
+			"Source Info":               p.PackageSourceInfo,
+			"License Declared":          p.PackageLicenseDeclared,
+			"License Concluded":         p.PackageLicenseConcluded,
+			"License Info From Files":   joinList(p.PackageLicenseInfoFromFiles),
+			"License Comments":          p.PackageLicenseComments,
+			"Package Copyright Text":    p.PackageCopyrightText,
+			"Summary":                   p.PackageSummary,
+			"Description":               p.PackageDescription,
+			"Attribution Text":          joinQuoted(p.PackageAttributionTexts),
+			"Comments":                  p.PackageComment,
+			"Primary Package Purpose":   p.PrimaryPackagePurpose,
+			"Release Date":              p.ReleaseDate,
+			"Built Date":                p.BuiltDate,
+			"Valid Until Date":          p.ValidUntilDate,
+		}
+		if p.PackageSupplier != nil {
+			cells["Package Supplier"] = joinTyped(p.PackageSupplier.SupplierType, p.PackageSupplier.Supplier)
+		}
+		if p.PackageOriginator != nil {
+			cells["Package Originator"] = joinTyped(p.PackageOriginator.OriginatorType, p.PackageOriginator.Originator)
+		}
+		if p.PackageVerificationCode != nil {
+			cells["Package Verification Code"] = p.PackageVerificationCode.Value
+			cells["Verification Code Excluded Files"] = joinList(p.PackageVerificationCode.ExcludedFiles)
+		}
+		// FilesAnalyzed is written when it was given, or is not the default
+		if p.IsFilesAnalyzedTagPresent || !p.FilesAnalyzed {
+			cells["Files Analyzed"] = strconv.FormatBool(p.FilesAnalyzed)
+		}
+		pkgs.add(cells)
+
+		for _, ref := range p.PackageExternalReferences {
+			refs.add(map[string]string{
+				"Package ID": v2common.RenderElementID(p.PackageSPDXIdentifier),
+				// categories are written as in the official spreadsheet,
+				// such as PACKAGE_MANAGER
+				"Category": strings.ReplaceAll(ref.Category, "-", "_"),
+				"Type":     ref.RefType,
+				"Locator":  ref.Locator,
+				"Comment":  ref.ExternalRefComment,
+			})
+		}
+
+		id := v2common.MakeDocElementID("", string(p.PackageSPDXIdentifier))
+		for _, a := range p.Annotations {
+			addAnnotation(annotations, id, a)
+		}
+	}
+
+	for _, f := range doc.Files {
+		addFile(f, "")
+	}
+	for _, p := range doc.Packages {
+		for _, f := range p.Files {
+			addFile(f, p.PackageSPDXIdentifier)
+		}
+	}
+	for i := range doc.Snippets {
+		addSnippet(snippets, &doc.Snippets[i])
+	}
+
+	licenses := newTable(extractedLicenseSheet, extractedLicenseColumns)
+	for _, l := range doc.OtherLicenses {
+		licenses.add(map[string]string{
+			"Identifier":           l.LicenseIdentifier,
+			"Extracted Text":       l.ExtractedText,
+			"License Name":         l.LicenseName,
+			"Cross Reference URLs": joinList(l.LicenseCrossReferences),
+			"Comment":              l.LicenseComment,
+		})
+	}
+
+	relationships := newTable(relationshipsSheet, relationshipsColumns)
+	for _, r := range doc.Relationships {
+		relationships.add(map[string]string{
+			"SPDX Identifier A":    v2common.RenderDocElementID(r.RefA),
+			"Relationship":         r.Relationship,
+			"SPDX Identifier B":    v2common.RenderDocElementID(r.RefB),
+			"Relationship Comment": r.RelationshipComment,
+		})
+	}
+
+	reviewers := newTable(reviewersSheet, reviewersColumns)
+	for _, r := range doc.Reviews {
+		reviewers.add(map[string]string{
+			"Reviewer":         joinTyped(r.ReviewerType, r.Reviewer),
+			"Review Date":      r.ReviewDate,
+			"Reviewer Comment": r.ReviewComment,
+		})
+	}
+
+	return []*sheet{
+		info.sheet,
+		pkgs.sheet,
+		refs.sheet,
+		licenses.sheet,
+		files.sheet,
+		relationships.sheet,
+		annotations.sheet,
+		snippets.sheet,
+		reviewers.sheet,
+	}
+}
+
+func addAnnotation(t *table, id v2common.DocElementID, a v2_3.Annotation) {
+	t.add(map[string]string{
+		"SPDX Identifier being Annotated": v2common.RenderDocElementID(id),
+		"Annotation Comment":              a.AnnotationComment,
+		"Annotation Date":                 a.AnnotationDate,
+		"Annotator":                       joinTyped(a.Annotator.AnnotatorType, a.Annotator.Annotator),
+		"Annotation Type":                 a.AnnotationType,
+	})
+}
+
+func addSnippet(t *table, s *v2_3.Snippet) {
+	var bytes, lines []string
+	for _, r := range s.Ranges {
+		if r.StartPointer.Offset != 0 || r.EndPointer.Offset != 0 {
+			bytes = append(bytes, formatRange(r.StartPointer.Offset, r.EndPointer.Offset))
+		}
+		if r.StartPointer.LineNumber != 0 || r.EndPointer.LineNumber != 0 {
+			lines = append(lines, formatRange(r.StartPointer.LineNumber, r.EndPointer.LineNumber))
+		}
+	}
+	t.add(map[string]string{
+		"ID":                      v2common.RenderElementID(s.SnippetSPDXIdentifier),
+		"Name":                    s.SnippetName,
+		"From File ID":            v2common.RenderElementID(s.SnippetFromFileSPDXIdentifier),
+		"Byte Range":              joinList(bytes),
+		"Line Range":              joinList(lines),
+		"License Concluded":       s.SnippetLicenseConcluded,
+		"License Info in Snippet": joinList(s.LicenseInfoInSnippet),
+		"License Comments":        s.SnippetLicenseComments,
+		"Snippet Copyright Text":  s.SnippetCopyrightText,
+		"Comment":                 s.SnippetComment,
+		"Attribution Text":        joinQuoted(s.SnippetAttributionTexts),
+	})
+}
@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package spreadsheet_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+	"github.com/spdx/tools-golang/spreadsheet"
+)
+
+func Test_Write(t *testing.T) {
+	doc := example.Copy()
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, spreadsheet.Write(&doc, buf))
+
+	got, err := spreadsheet.Read(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, &doc, got)
+}
+
+func Test_WritePackageFiles(t *testing.T) {
+	doc := v2_3.Document{
+		SPDXVersion:    v2_3.Version,
+		SPDXIdentifier: "DOCUMENT",
+		DocumentName:   "a, \"b\" & <c>\nd",
+		Packages: []*v2_3.Package{
+			{
+				PackageSPDXIdentifier:     "Package",
+				FilesAnalyzed:             true,
+				PackageAttributionTexts:   []string{"a, b", `"c"`},
+				PackageExternalReferences: []*v2_3.PackageExternalReference{{Category: common.CategoryPackageManager, RefType: "purl", Locator: "pkg:a"}},
+				Files: []*v2_3.File{
+					{
+						FileSPDXIdentifier: "File",
+						Snippets: map[common.ElementID]*v2_3.Snippet{
+							"Snippet": {SnippetSPDXIdentifier: "Snippet", SnippetFromFileSPDXIdentifier: "File"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, spreadsheet.Write(doc, buf))
+
+	got, err := spreadsheet.Read(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, doc.DocumentName, got.DocumentName)
+	require.Equal(t, doc.Packages[0].PackageAttributionTexts, got.Packages[0].PackageAttributionTexts)
+	require.Equal(t, doc.Packages[0].PackageExternalReferences, got.Packages[0].PackageExternalReferences)
+
+	// files of packages are read as files of the document, contained by the
+	// package, and their snippets as snippets of the document
+	require.Len(t, got.Files, 1)
+	require.Equal(t, common.ElementID("File"), got.Files[0].FileSPDXIdentifier)
+	require.Equal(t, []*v2_3.Relationship{{
+		RefA:         common.MakeDocElementID("", "Package"),
+		RefB:         common.MakeDocElementID("", "File"),
+		Relationship: common.TypeRelationshipContains,
+	}}, got.Relationships)
+	require.Len(t, got.Snippets, 1)
+	require.Equal(t, common.ElementID("Snippet"), got.Snippets[0].SnippetSPDXIdentifier)
+}
+
+func Test_WriteV2_2(t *testing.T) {
+	doc := v2_2.Document{
+		SPDXVersion:    v2_2.Version,
+		SPDXIdentifier: "DOCUMENT",
+		DocumentName:   "SPDX-Tools-v2.0",
+		CreationInfo: &v2_2.CreationInfo{
+			Creators: []common.Creator{{CreatorType: "Tool", Creator: "a"}, {CreatorType: "Person", Creator: "b"}},
+			Created:  "2010-01-29T18:30:22Z",
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, spreadsheet.Write(doc, buf))
+
+	got := v2_2.Document{}
+	require.NoError(t, spreadsheet.ReadInto(bytes.NewReader(buf.Bytes()), &got))
+	require.Equal(t, doc, got)
+}
+
+func Test_WriteCanonical(t *testing.T) {
+	doc := example.Copy()
+	reordered := example.Copy()
+	reordered.Packages[0], reordered.Packages[1] = reordered.Packages[1], reordered.Packages[0]
+
+	want := new(bytes.Buffer)
+	require.NoError(t, spreadsheet.Write(doc, want, spreadsheet.Canonical()))
+	got := new(bytes.Buffer)
+	require.NoError(t, spreadsheet.Write(&reordered, got, spreadsheet.Canonical()))
+	require.Equal(t, want.Bytes(), got.Bytes())
+}
+
+func Test_WriteErrors(t *testing.T) {
+	require.Error(t, spreadsheet.Write(nil, new(bytes.Buffer)))
+	require.Error(t, spreadsheet.Write(v2_1.Document{SPDXVersion: v2_1.Version}, new(bytes.Buffer)))
+}
@@ -15,12 +15,37 @@ import (
 	v2_2_writer "github.com/spdx/tools-golang/spdx/v2/v2_2/tagvalue/writer"
 	"github.com/spdx/tools-golang/spdx/v2/v2_3"
 	v2_3_writer "github.com/spdx/tools-golang/spdx/v2/v2_3/tagvalue/writer"
+	"github.com/spdx/tools-golang/spdxlib"
 )
 
+type WriteOption func(*writer)
+
+type writer struct {
+	canonical bool
+}
+
+// Canonical writes the document as returned by spdxlib.Canonical
+func Canonical() WriteOption {
+	return func(w *writer) {
+		w.canonical = true
+	}
+}
+
 // Write takes an io.Writer and an SPDX Document,
 // and writes it to the writer in tag-value format. It returns error
 // if any error is encountered.
-func Write(doc common.AnyDocument, w io.Writer) error {
+func Write(doc common.AnyDocument, w io.Writer, opts ...WriteOption) error {
+	wr := &writer{}
+	for _, opt := range opts {
+		opt(wr)
+	}
+	if wr.canonical {
+		var err error
+		if doc, err = spdxlib.Canonical(doc); err != nil {
+			return err
+		}
+	}
+
 	doc = convert.FromPtr(doc)
 	switch doc := doc.(type) {
 	case v2_1.Document:
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package tagvalue_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+	"github.com/spdx/tools-golang/spdxlib"
+	"github.com/spdx/tools-golang/tagvalue"
+)
+
+func Test_WriteCanonical(t *testing.T) {
+	doc := example.Copy()
+	reordered := example.Copy()
+	reordered.Packages[0], reordered.Packages[1] = reordered.Packages[1], reordered.Packages[0]
+	reordered.Relationships[0], reordered.Relationships[1] = reordered.Relationships[1], reordered.Relationships[0]
+	reordered.Files[0].Checksums[0].Value = strings.ToUpper(reordered.Files[0].Checksums[0].Value)
+
+	want := &bytes.Buffer{}
+	require.NoError(t, tagvalue.Write(&doc, want, tagvalue.Canonical()))
+	got := &bytes.Buffer{}
+	require.NoError(t, tagvalue.Write(&reordered, got, tagvalue.Canonical()))
+	require.Equal(t, want.String(), got.String())
+
+	// the output is that of the canonical document
+	canonical, err := spdxlib.Canonical(&reordered)
+	require.NoError(t, err)
+	plain := &bytes.Buffer{}
+	require.NoError(t, tagvalue.Write(canonical, plain))
+	require.Equal(t, plain.String(), got.String())
+
+	// the document passed in is not changed
+	require.Equal(t, common.ElementID("fromDoap-1"), reordered.Packages[0].PackageSPDXIdentifier)
+}
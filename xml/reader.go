@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package xml
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/spdx/tools-golang/convert"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+// Read takes an io.Reader and returns a fully-parsed current model SPDX Document
+// or an error if any error is encountered.
+func Read(content io.Reader) (*spdx.Document, error) {
+	doc := spdx.Document{}
+	err := ReadInto(content, &doc)
+	return &doc, err
+}
+
+// ReadInto takes an io.Reader, reads in the SPDX document at the version provided
+// and converts to the doc version
+func ReadInto(content io.Reader, doc common.AnyDocument) error {
+	if !convert.IsPtr(doc) {
+		return fmt.Errorf("doc to read into must be a pointer")
+	}
+
+	root, err := parse(content)
+	if err != nil {
+		return err
+	}
+
+	version := root.child("spdxVersion")
+	if version == nil {
+		return fmt.Errorf("XML document does not contain spdxVersion field")
+	}
+
+	var data interface{}
+	switch version.text {
+	case v2_2.Version:
+		var doc v2_2.Document
+		err = unmarshal(root, &doc)
+		if err != nil {
+			return err
+		}
+		data = doc
+	case v2_3.Version:
+		var doc v2_3.Document
+		err = unmarshal(root, &doc)
+		if err != nil {
+			return err
+		}
+		data = doc
+	default:
+		return fmt.Errorf("unsupported SDPX version: %s", version.text)
+	}
+
+	return convert.Document(data, doc)
+}
+
+// element is an element of an XML document, with either text or child
+// elements
+type element struct {
+	name     string
+	text     string
+	children []*element
+}
+
+// child returns the first child element with the name, or nil
+func (e *element) child(name string) *element {
+	for _, c := range e.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// parse reads the root element of an XML document
+func parse(content io.Reader) (*element, error) {
+	dec := xml.NewDecoder(content)
+	var stack []*element
+	var text strings.Builder
+	for {
+		token, err := dec.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("not a valid SPDX XML document")
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			e := &element{name: token.Name.Local}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, e)
+			}
+			stack = append(stack, e)
+			text.Reset()
+		case xml.CharData:
+			text.Write(token)
+		case xml.EndElement:
+			e := stack[len(stack)-1]
+			if len(e.children) == 0 {
+				e.text = text.String()
+			}
+			text.Reset()
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return e, nil
+			}
+		}
+	}
+}
+
+// lists are the properties read by the UnmarshalJSON methods of the document
+// and its packages which are not fields of the model: documentDescribes and
+// hasFiles are turned into DESCRIBES and CONTAINS relationships
+var lists = map[string]bool{
+	"documentDescribes": true,
+	"hasFiles":          true,
+}
+
+// unmarshal reads the document from its XML elements. The SPDX XML format
+// has the properties of the JSON format, with the values of lists as repeated
+// elements, so the document is read by its JSON unmarshalling.
+func unmarshal(root *element, doc interface{}) error {
+	data, err := json.Marshal(toJSON(root, reflect.TypeOf(doc).Elem()))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, doc)
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// toJSON returns the JSON value of an element read into a value of type t.
+// The type tells lists from single values, and the JSON type of text.
+func toJSON(e *element, t reflect.Type) interface{} {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if len(e.children) == 0 {
+		if t == nil {
+			return e.text
+		}
+		if t.Kind() == reflect.Struct && !reflect.PtrTo(t).Implements(unmarshalerType) {
+			return map[string]interface{}{}
+		}
+		return scalar(e.text, t)
+	}
+
+	fields := map[string]reflect.Type{}
+	if t != nil && t.Kind() == reflect.Struct {
+		fields = jsonFields(t)
+	}
+
+	object := map[string]interface{}{}
+	values := map[string][]interface{}{}
+	for _, c := range e.children {
+		ft, known := fields[c.name]
+		list := lists[c.name]
+		if known && ft.Kind() == reflect.Slice {
+			ft = ft.Elem()
+			list = true
+		}
+		values[c.name] = append(values[c.name], toJSON(c, ft))
+		if list {
+			object[c.name] = values[c.name]
+		} else {
+			// a single value given more than once is read as the last one
+			object[c.name] = values[c.name][len(values[c.name])-1]
+		}
+	}
+	return object
+}
+
+// scalar returns the JSON value of text read into a value of type t
+func scalar(text string, t reflect.Type) interface{} {
+	switch t.Kind() {
+	case reflect.Bool:
+		if b, err := strconv.ParseBool(strings.TrimSpace(text)); err == nil {
+			return b
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		if _, err := strconv.ParseFloat(strings.TrimSpace(text), 64); err == nil {
+			return json.Number(strings.TrimSpace(text))
+		}
+	}
+	return text
+}
+
+// jsonFields returns the types of the fields of a struct by their JSON names
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package xml
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/json"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+func Test_ReadSample(t *testing.T) {
+	f, err := os.Open("../examples/sample-docs/xml/SPDXXMLExample-v2.2.spdx.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	got, err := Read(f)
+	require.NoError(t, err)
+
+	f, err = os.Open("../examples/sample-docs/json/SPDXJSONExample-v2.2.spdx.json")
+	require.NoError(t, err)
+	defer f.Close()
+
+	want, err := json.Read(f)
+	require.NoError(t, err)
+
+	// the XML example states that the Jena package files were not analyzed,
+	// which the JSON example leaves out
+	for _, pkg := range want.Packages {
+		if pkg.PackageSPDXIdentifier == "fromDoap-0" {
+			pkg.FilesAnalyzed = false
+			pkg.IsFilesAnalyzedTagPresent = true
+		}
+	}
+
+	spdxlib.Canonicalize(want)
+	spdxlib.Canonicalize(got)
+	require.Equal(t, want, got)
+}
+
+func Test_ReadDescribesAndHasFiles(t *testing.T) {
+	doc := v2_2.Document{}
+	err := ReadInto(strings.NewReader(`<?xml version="1.0" encoding="UTF-8"?>
+<Document>
+  <spdxVersion>SPDX-2.2</spdxVersion>
+  <SPDXID>SPDXRef-DOCUMENT</SPDXID>
+  <documentDescribes>SPDXRef-Package</documentDescribes>
+  <packages>
+    <SPDXID>SPDXRef-Package</SPDXID>
+    <hasFiles>SPDXRef-a</hasFiles>
+    <hasFiles>SPDXRef-b</hasFiles>
+  </packages>
+  <relationships>
+    <spdxElementId>SPDXRef-Package</spdxElementId>
+    <relatedSpdxElement>SPDXRef-a</relatedSpdxElement>
+    <relationshipType>CONTAINS</relationshipType>
+  </relationships>
+</Document>`), &doc)
+	require.NoError(t, err)
+
+	require.Equal(t, []*v2_2.Relationship{
+		{
+			RefA:         common.MakeDocElementID("", "Package"),
+			RefB:         common.MakeDocElementID("", "a"),
+			Relationship: common.TypeRelationshipContains,
+		},
+		{
+			RefA:         common.MakeDocElementID("", "DOCUMENT"),
+			RefB:         common.MakeDocElementID("", "Package"),
+			Relationship: common.TypeRelationshipDescribe,
+		},
+		{
+			RefA:         common.MakeDocElementID("", "Package"),
+			RefB:         common.MakeDocElementID("", "b"),
+			Relationship: common.TypeRelationshipContains,
+		},
+	}, doc.Relationships)
+
+	// filesAnalyzed defaults to true, as in JSON
+	require.True(t, doc.Packages[0].FilesAnalyzed)
+	require.False(t, doc.Packages[0].IsFilesAnalyzedTagPresent)
+}
+
+func Test_ReadErrors(t *testing.T) {
+	for _, in := range []string{
+		``,
+		`<Document><name>x</name></Document>`,
+		`<Document><spdxVersion>SPDX-2.1</spdxVersion></Document>`,
+		`<Document><spdxVersion>SPDX-2.3</spdxVersion>`,
+		`<Document><spdxVersion>SPDX-2.3</spdxVersion><SPDXID>DOCUMENT</SPDXID></Document>`,
+	} {
+		_, err := Read(strings.NewReader(in))
+		require.Error(t, err, in)
+	}
+
+	doc := spdx.Document{}
+	require.Error(t, ReadInto(strings.NewReader(`<Document/>`), doc))
+}
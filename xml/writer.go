@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package xml
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/spdx/tools-golang/convert"
+	"github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+type WriteOption func(*writer)
+
+type writer struct {
+	canonical bool
+}
+
+// Canonical writes the document as returned by spdxlib.Canonical
+func Canonical() WriteOption {
+	return func(w *writer) {
+		w.canonical = true
+	}
+}
+
+// Write takes an SPDX 2.2 or 2.3 Document and an io.Writer, and writes the
+// document to the writer in XML format: the properties of the JSON format, in
+// a Document element, with the values of lists as repeated elements.
+func Write(doc common.AnyDocument, w io.Writer, opts ...WriteOption) error {
+	if doc == nil {
+		return fmt.Errorf("nil document")
+	}
+	wr := &writer{}
+	for _, opt := range opts {
+		opt(wr)
+	}
+	if wr.canonical {
+		var err error
+		if doc, err = spdxlib.Canonical(doc); err != nil {
+			return err
+		}
+	}
+
+	switch convert.FromPtr(doc).(type) {
+	case v2_2.Document, v2_3.Document:
+	default:
+		return fmt.Errorf("unsupported document type for XML: %s", convert.Describe(doc))
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	if _, err = io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err = writeValue(enc, dec, "Document"); err != nil {
+		return err
+	}
+	if err = enc.Flush(); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// writeValue writes the next JSON value as elements with the name: an element
+// for an object or a single value, and an element per value for a list
+func writeValue(enc *xml.Encoder, dec *json.Decoder, name string) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	switch token := token.(type) {
+	case json.Delim:
+		if token == '[' {
+			for dec.More() {
+				if err := writeValue(enc, dec, name); err != nil {
+					return err
+				}
+			}
+			_, err = dec.Token()
+			return err
+		}
+		if err := enc.EncodeToken(start); err != nil {
+			return err
+		}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			if err := writeValue(enc, dec, key.(string)); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		return enc.EncodeToken(start.End())
+	case nil:
+		return nil
+	default:
+		// text is written as character data, which keeps line breaks
+		// unescaped
+		for _, t := range []xml.Token{start, xml.CharData(fmt.Sprint(token)), start.End()} {
+			if err := enc.EncodeToken(t); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
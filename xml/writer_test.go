@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+package xml_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spdx/tools-golang/spdx/v2/v2_1"
+	"github.com/spdx/tools-golang/spdx/v2/v2_2"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3/example"
+	"github.com/spdx/tools-golang/xml"
+)
+
+func Test_Write(t *testing.T) {
+	doc := example.Copy()
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, xml.Write(&doc, buf))
+	require.True(t, strings.HasPrefix(buf.String(), `<?xml version="1.0" encoding="UTF-8"?>
+<Document>
+  <spdxVersion>SPDX-2.3</spdxVersion>
+`))
+
+	// we always output FilesAnalyzed, even though we handle reading files where it is omitted
+	for _, p := range doc.Packages {
+		p.IsFilesAnalyzedTagPresent = true
+	}
+
+	got, err := xml.Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, &doc, got)
+}
+
+func Test_WriteV2_2(t *testing.T) {
+	doc := v2_2.Document{
+		SPDXVersion:    v2_2.Version,
+		SPDXIdentifier: "DOCUMENT",
+		DocumentName:   "a <b> & c\nd",
+	}
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, xml.Write(doc, buf))
+	require.Contains(t, buf.String(), "<name>a &lt;b&gt; &amp; c\nd</name>")
+
+	got := v2_2.Document{}
+	require.NoError(t, xml.ReadInto(buf, &got))
+	require.Equal(t, doc.DocumentName, got.DocumentName)
+}
+
+func Test_WriteCanonical(t *testing.T) {
+	doc := example.Copy()
+	reordered := example.Copy()
+	reordered.Packages[0], reordered.Packages[1] = reordered.Packages[1], reordered.Packages[0]
+
+	want := new(bytes.Buffer)
+	require.NoError(t, xml.Write(doc, want, xml.Canonical()))
+	got := new(bytes.Buffer)
+	require.NoError(t, xml.Write(&reordered, got, xml.Canonical()))
+	require.Equal(t, want.String(), got.String())
+}
+
+func Test_WriteErrors(t *testing.T) {
+	require.Error(t, xml.Write(nil, new(bytes.Buffer)))
+	require.Error(t, xml.Write(v2_1.Document{SPDXVersion: v2_1.Version}, new(bytes.Buffer)))
+}
@@ -8,10 +8,35 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/spdx/tools-golang/spdx/common"
+	"github.com/spdx/tools-golang/spdxlib"
 )
 
+type WriteOption func(*writer)
+
+type writer struct {
+	canonical bool
+}
+
+// Canonical writes the document as returned by spdxlib.Canonical
+func Canonical() WriteOption {
+	return func(w *writer) {
+		w.canonical = true
+	}
+}
+
 // Write takes an SPDX Document and an io.Writer, and writes the document to the writer in YAML format.
-func Write(doc common.AnyDocument, w io.Writer) error {
+func Write(doc common.AnyDocument, w io.Writer, opts ...WriteOption) error {
+	wr := &writer{}
+	for _, opt := range opts {
+		opt(wr)
+	}
+	if wr.canonical {
+		var err error
+		if doc, err = spdxlib.Canonical(doc); err != nil {
+			return err
+		}
+	}
+
 	buf, err := yaml.Marshal(doc)
 	if err != nil {
 		return err